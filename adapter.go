@@ -10,4 +10,22 @@ type Adapter interface {
 
 	FetchAlbum(ctx context.Context, id string) (*Entity, error)
 	SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error)
+
+	FetchArtist(ctx context.Context, id string) (*Entity, error)
+	SearchArtist(ctx context.Context, artistName string) (*Entity, error)
+
+	FetchPlaylist(ctx context.Context, id string) (*Entity, error)
+}
+
+// IdentifierSearcher is implemented by adapters able to look up entities by industry identifiers.
+type IdentifierSearcher interface {
+	SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error)
+	SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error)
+}
+
+// CandidateSearcher is implemented by adapters able to return several search results
+// ordered by the provider's own relevance, so they can be ranked by confidence.
+type CandidateSearcher interface {
+	SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error)
+	SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error)
 }
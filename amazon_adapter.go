@@ -0,0 +1,262 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/amazon"
+)
+
+type AmazonAdapter struct {
+	client amazon.Client
+}
+
+func newAmazonAdapter(client amazon.Client) *AmazonAdapter {
+	return &AmazonAdapter{
+		client: client,
+	}
+}
+
+func (a *AmazonAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	ck := amazon.CompositeKey{}
+	if err := ck.Unmarshal(id); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal track id: %w", err)
+	}
+
+	track, err := a.client.FetchTrack(ctx, ck.ASIN, ck.Region)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get track from amazon: %w", err)
+	}
+
+	return a.adaptTrack(track, ck.Region), nil
+}
+
+func (a *AmazonAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	track, err := a.client.SearchTrack(ctx, artistName, trackName)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track on amazon: %w", err)
+	}
+
+	return a.adaptTrack(track, amazon.DefaultRegion), nil
+}
+
+func (a *AmazonAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := a.client.SearchTracks(ctx, artistName, trackName, limit)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search tracks on amazon: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track, amazon.DefaultRegion))
+	}
+	return candidates, nil
+}
+
+func (a *AmazonAdapter) SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error) {
+	track, err := a.client.SearchTrackByISRC(ctx, isrc)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track by isrc on amazon: %w", err)
+	}
+
+	return a.adaptTrack(track, amazon.DefaultRegion), nil
+}
+
+func (a *AmazonAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	ck := amazon.CompositeKey{}
+	if err := ck.Unmarshal(id); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal album id: %w", err)
+	}
+
+	album, err := a.client.FetchAlbum(ctx, ck.ASIN, ck.Region)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get album from amazon: %w", err)
+	}
+
+	return a.adaptAlbum(album, ck.Region), nil
+}
+
+func (a *AmazonAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	album, err := a.client.SearchAlbum(ctx, artistName, albumName)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album on amazon: %w", err)
+	}
+
+	return a.adaptAlbum(album, amazon.DefaultRegion), nil
+}
+
+func (a *AmazonAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := a.client.SearchAlbums(ctx, artistName, albumName, limit)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search albums on amazon: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album, amazon.DefaultRegion))
+	}
+	return candidates, nil
+}
+
+func (a *AmazonAdapter) SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error) {
+	album, err := a.client.SearchAlbumByUPC(ctx, upc)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album by upc on amazon: %w", err)
+	}
+
+	return a.adaptAlbum(album, amazon.DefaultRegion), nil
+}
+
+func (a *AmazonAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	ck := amazon.CompositeKey{}
+	if err := ck.Unmarshal(id); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal artist id: %w", err)
+	}
+
+	artist, err := a.client.FetchArtist(ctx, ck.ASIN, ck.Region)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from amazon: %w", err)
+	}
+
+	return a.adaptArtist(artist, ck.Region), nil
+}
+
+func (a *AmazonAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	artist, err := a.client.SearchArtist(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search artist on amazon: %w", err)
+	}
+
+	return a.adaptArtist(artist, amazon.DefaultRegion), nil
+}
+
+func (a *AmazonAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	ck := amazon.CompositeKey{}
+	if err := ck.Unmarshal(id); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal playlist id: %w", err)
+	}
+
+	playlist, err := a.client.FetchPlaylist(ctx, ck.ASIN, ck.Region)
+	if err != nil {
+		if errors.Is(err, amazon.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from amazon: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist, ck.Region), nil
+}
+
+func (a *AmazonAdapter) adaptTrack(track *amazon.Track, region string) *Entity {
+	ck := amazon.CompositeKey{ASIN: track.ID, Region: region}
+	entity := Entity{
+		ID:       ck.Marshal(),
+		Title:    track.Title,
+		URL:      track.URL(region),
+		Provider: Amazon,
+		Type:     Track,
+		ISRC:     track.ISRC,
+		Artists:  a.artistNames(track.Artists),
+		Duration: time.Duration(track.Duration) * time.Second,
+		Explicit: track.Explicit,
+	}
+	if len(track.Artists) > 0 {
+		entity.Artist = track.Artists[0].Name
+	}
+	if track.Album != nil {
+		entity.Album = track.Album.Title
+		entity.Artwork = track.Album.ImageURL()
+		entity.ReleaseDate = track.Album.ReleaseDate
+	}
+	return &entity
+}
+
+func (a *AmazonAdapter) adaptAlbum(album *amazon.Album, region string) *Entity {
+	ck := amazon.CompositeKey{ASIN: album.ID, Region: region}
+	entity := Entity{
+		ID:          ck.Marshal(),
+		Title:       album.Title,
+		URL:         album.URL(region),
+		Provider:    Amazon,
+		Type:        Album,
+		UPC:         album.UPC,
+		Artists:     a.artistNames(album.Artists),
+		ReleaseDate: album.ReleaseDate,
+		Artwork:     album.ImageURL(),
+		Explicit:    album.Explicit,
+	}
+	if len(album.Artists) > 0 {
+		entity.Artist = album.Artists[0].Name
+	}
+	return &entity
+}
+
+func (a *AmazonAdapter) artistNames(artists []amazon.Artist) []string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return names
+}
+
+func (a *AmazonAdapter) adaptArtist(artist *amazon.Artist, region string) *Entity {
+	ck := amazon.CompositeKey{ASIN: artist.ID, Region: region}
+	return &Entity{
+		ID:       ck.Marshal(),
+		Title:    artist.Name,
+		Artist:   artist.Name,
+		URL:      artist.URL(region),
+		Provider: Amazon,
+		Type:     Artist,
+	}
+}
+
+func (a *AmazonAdapter) adaptPlaylist(playlist *amazon.Playlist, region string) *Entity {
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		tracks = append(tracks, a.adaptTrack(track, region))
+	}
+
+	ck := amazon.CompositeKey{ASIN: playlist.ID, Region: region}
+	return &Entity{
+		ID:       ck.Marshal(),
+		Title:    playlist.Title,
+		Artist:   playlist.Curator,
+		URL:      playlist.URL(region),
+		Provider: Amazon,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
+}
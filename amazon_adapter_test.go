@@ -0,0 +1,379 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/amazon"
+
+	"github.com/stretchr/testify/require"
+)
+
+type amazonClientMock struct {
+	fetchTrack    map[string]*amazon.Track
+	fetchAlbum    map[string]*amazon.Album
+	searchTracks  map[string]map[string][]*amazon.Track
+	searchAlbums  map[string]map[string][]*amazon.Album
+	fetchArtist   map[string]*amazon.Artist
+	searchArtist  map[string]*amazon.Artist
+	fetchPlaylist map[string]*amazon.Playlist
+
+	searchTrackByISRC map[string]*amazon.Track
+	searchAlbumByUPC  map[string]*amazon.Album
+}
+
+func (c *amazonClientMock) FetchTrack(_ context.Context, asin, _ string) (*amazon.Track, error) {
+	track, ok := c.fetchTrack[asin]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *amazonClientMock) SearchTrack(ctx context.Context, artistName, trackName string) (*amazon.Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *amazonClientMock) SearchTracks(_ context.Context, artistName, trackName string, limit int) ([]*amazon.Track, error) {
+	tracks, ok := c.searchTracks[artistName][trackName]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (c *amazonClientMock) SearchTrackByISRC(_ context.Context, isrc string) (*amazon.Track, error) {
+	track, ok := c.searchTrackByISRC[isrc]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *amazonClientMock) FetchAlbum(_ context.Context, asin, _ string) (*amazon.Album, error) {
+	album, ok := c.fetchAlbum[asin]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *amazonClientMock) SearchAlbum(ctx context.Context, artistName, albumName string) (*amazon.Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *amazonClientMock) SearchAlbums(_ context.Context, artistName, albumName string, limit int) ([]*amazon.Album, error) {
+	albums, ok := c.searchAlbums[artistName][albumName]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *amazonClientMock) SearchAlbumByUPC(_ context.Context, upc string) (*amazon.Album, error) {
+	album, ok := c.searchAlbumByUPC[upc]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *amazonClientMock) FetchArtist(_ context.Context, asin, _ string) (*amazon.Artist, error) {
+	artist, ok := c.fetchArtist[asin]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *amazonClientMock) SearchArtist(_ context.Context, artistName string) (*amazon.Artist, error) {
+	artist, ok := c.searchArtist[artistName]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *amazonClientMock) FetchPlaylist(_ context.Context, asin, _ string) (*amazon.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[asin]
+	if !ok {
+		return nil, amazon.NotFoundError
+	}
+	return playlist, nil
+}
+
+func TestAmazonAdapter_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		clientMock    *amazonClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ID",
+			id:   "gb-B0BDHX1B2S",
+			clientMock: &amazonClientMock{
+				fetchTrack: map[string]*amazon.Track{
+					"B0BDHX1B2S": {
+						ID:       "B0BDHX1B2S",
+						Title:    "Anti-Hero",
+						ISRC:     "USUG12205736",
+						Duration: 200,
+						Artists:  []amazon.Artist{{ID: "B000QJPTJ2", Name: "Taylor Swift"}},
+						Album: &amazon.Album{
+							ID:          "B0BDHWDR3R",
+							Title:       "Midnights",
+							ReleaseDate: "2022-10-21",
+							Images: []amazon.Image{
+								{URL: "https://m.media-amazon.com/images/I/small.jpg", Width: 300, Height: 300},
+								{URL: "https://m.media-amazon.com/images/I/large.jpg", Width: 1000, Height: 1000},
+							},
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "gb-B0BDHX1B2S",
+				Title:       "Anti-Hero",
+				Artist:      "Taylor Swift",
+				URL:         "https://music.amazon.co.uk/albums/B0BDHWDR3R?trackAsin=B0BDHX1B2S",
+				Provider:    Amazon,
+				Type:        Track,
+				ISRC:        "USUG12205736",
+				Artists:     []string{"Taylor Swift"},
+				Album:       "Midnights",
+				Duration:    200 * time.Second,
+				ReleaseDate: "2022-10-21",
+				Artwork:     "https://m.media-amazon.com/images/I/large.jpg",
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "us-B000000000",
+			clientMock:  &amazonClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+		{
+			name:        "invalid ID",
+			id:          "B0BDHX1B2S",
+			clientMock:  &amazonClientMock{},
+			expectedErr: amazon.CompositeKeyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newAmazonAdapter(tt.clientMock)
+			result, err := a.FetchTrack(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestAmazonAdapter_SearchTrackCandidates(t *testing.T) {
+	clientMock := &amazonClientMock{
+		searchTracks: map[string]map[string][]*amazon.Track{
+			"Taylor Swift": {
+				"Anti-Hero": {
+					{ID: "B0BDHX1B2S", Title: "Anti-Hero", Artists: []amazon.Artist{{Name: "Taylor Swift"}}},
+					{ID: "B0BGZ4X6K8", Title: "Anti-Hero (Acoustic)", Artists: []amazon.Artist{{Name: "Taylor Swift"}}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newAmazonAdapter(clientMock)
+	result, err := a.SearchTrackCandidates(ctx, "Taylor Swift", "Anti-Hero", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:       "us-B0BDHX1B2S",
+			Title:    "Anti-Hero",
+			Artist:   "Taylor Swift",
+			URL:      "https://music.amazon.com/tracks/B0BDHX1B2S",
+			Provider: Amazon,
+			Type:     Track,
+			Artists:  []string{"Taylor Swift"},
+		},
+		{
+			ID:       "us-B0BGZ4X6K8",
+			Title:    "Anti-Hero (Acoustic)",
+			Artist:   "Taylor Swift",
+			URL:      "https://music.amazon.com/tracks/B0BGZ4X6K8",
+			Provider: Amazon,
+			Type:     Track,
+			Artists:  []string{"Taylor Swift"},
+		},
+	}, result)
+
+	_, err = a.SearchTrackCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestAmazonAdapter_FetchAlbum(t *testing.T) {
+	clientMock := &amazonClientMock{
+		fetchAlbum: map[string]*amazon.Album{
+			"B0BDHWDR3R": {
+				ID:          "B0BDHWDR3R",
+				Title:       "Midnights",
+				UPC:         "00602448438034",
+				ReleaseDate: "2022-10-21",
+				Images:      []amazon.Image{{URL: "https://m.media-amazon.com/images/I/large.jpg", Width: 1000}},
+				Artists:     []amazon.Artist{{ID: "B000QJPTJ2", Name: "Taylor Swift"}},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newAmazonAdapter(clientMock)
+	result, err := a.FetchAlbum(ctx, "de-B0BDHWDR3R")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:          "de-B0BDHWDR3R",
+		Title:       "Midnights",
+		Artist:      "Taylor Swift",
+		URL:         "https://music.amazon.de/albums/B0BDHWDR3R",
+		Provider:    Amazon,
+		Type:        Album,
+		UPC:         "00602448438034",
+		Artists:     []string{"Taylor Swift"},
+		ReleaseDate: "2022-10-21",
+		Artwork:     "https://m.media-amazon.com/images/I/large.jpg",
+	}, result)
+
+	_, err = a.FetchAlbum(ctx, "de-B000000000")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestAmazonAdapter_SearchByIdentifier(t *testing.T) {
+	clientMock := &amazonClientMock{
+		searchTrackByISRC: map[string]*amazon.Track{
+			"USUG12205736": {ID: "B0BDHX1B2S", Title: "Anti-Hero", ISRC: "USUG12205736"},
+		},
+		searchAlbumByUPC: map[string]*amazon.Album{
+			"00602448438034": {ID: "B0BDHWDR3R", Title: "Midnights", UPC: "00602448438034"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newAmazonAdapter(clientMock)
+
+	track, err := a.SearchTrackByISRC(ctx, "USUG12205736")
+	require.NoError(t, err)
+	require.Equal(t, "us-B0BDHX1B2S", track.ID)
+	require.Equal(t, "USUG12205736", track.ISRC)
+
+	album, err := a.SearchAlbumByUPC(ctx, "00602448438034")
+	require.NoError(t, err)
+	require.Equal(t, "us-B0BDHWDR3R", album.ID)
+	require.Equal(t, "00602448438034", album.UPC)
+
+	_, err = a.SearchTrackByISRC(ctx, "unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+	_, err = a.SearchAlbumByUPC(ctx, "unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestAmazonAdapter_FetchArtist(t *testing.T) {
+	clientMock := &amazonClientMock{
+		fetchArtist: map[string]*amazon.Artist{
+			"B000QJPTJ2": {ID: "B000QJPTJ2", Name: "Taylor Swift"},
+		},
+		searchArtist: map[string]*amazon.Artist{
+			"Taylor Swift": {ID: "B000QJPTJ2", Name: "Taylor Swift"},
+		},
+	}
+	expected := &Entity{
+		ID:       "us-B000QJPTJ2",
+		Title:    "Taylor Swift",
+		Artist:   "Taylor Swift",
+		URL:      "https://music.amazon.com/artists/B000QJPTJ2",
+		Provider: Amazon,
+		Type:     Artist,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newAmazonAdapter(clientMock)
+
+	result, err := a.FetchArtist(ctx, "us-B000QJPTJ2")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	result, err = a.SearchArtist(ctx, "Taylor Swift")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	_, err = a.FetchArtist(ctx, "us-B000000000")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestAmazonAdapter_FetchPlaylist(t *testing.T) {
+	clientMock := &amazonClientMock{
+		fetchPlaylist: map[string]*amazon.Playlist{
+			"B07QNZ6J4K": {
+				ID:      "B07QNZ6J4K",
+				Title:   "sample playlist",
+				Curator: "Amazon Music",
+				Tracks: []*amazon.Track{
+					{ID: "B0BDHX1B2S", Title: "first", Artists: []amazon.Artist{{Name: "sample artist"}}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newAmazonAdapter(clientMock)
+	result, err := a.FetchPlaylist(ctx, "jp-B07QNZ6J4K")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "jp-B07QNZ6J4K",
+		Title:    "sample playlist",
+		Artist:   "Amazon Music",
+		URL:      "https://music.amazon.co.jp/playlists/B07QNZ6J4K",
+		Provider: Amazon,
+		Type:     Playlist,
+		Tracks: []*Entity{
+			{
+				ID:       "jp-B0BDHX1B2S",
+				Title:    "first",
+				Artist:   "sample artist",
+				URL:      "https://music.amazon.co.jp/tracks/B0BDHX1B2S",
+				Provider: Amazon,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+	}, result)
+
+	_, err = a.FetchPlaylist(ctx, "jp-B000000000")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/GeorgeGorbanev/streamnx/internal/apple"
 )
@@ -54,6 +55,41 @@ func (a *AppleAdapter) SearchTrack(ctx context.Context, artistName, trackName st
 	return res, nil
 }
 
+func (a *AppleAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := a.client.SearchTracks(ctx, artistName, trackName, limit)
+	if err != nil {
+		if errors.Is(err, apple.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search tracks from apple: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		res, err := a.adaptTrack(track)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, res)
+	}
+	return candidates, nil
+}
+
+func (a *AppleAdapter) SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error) {
+	track, err := a.client.SearchTrackByISRC(ctx, isrc)
+	if err != nil {
+		if errors.Is(err, apple.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track by isrc from apple: %w", err)
+	}
+	res, err := a.adaptTrack(track)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (a *AppleAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
 	ck := apple.CompositeKey{}
 	if err := ck.Unmarshal(id); err != nil {
@@ -90,6 +126,98 @@ func (a *AppleAdapter) SearchAlbum(ctx context.Context, artistName, albumName st
 	return res, nil
 }
 
+func (a *AppleAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := a.client.SearchAlbums(ctx, artistName, albumName, limit)
+	if err != nil {
+		if errors.Is(err, apple.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search albums from apple: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		res, err := a.adaptAlbum(album)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, res)
+	}
+	return candidates, nil
+}
+
+func (a *AppleAdapter) SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error) {
+	album, err := a.client.SearchAlbumByUPC(ctx, upc)
+	if err != nil {
+		if errors.Is(err, apple.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album by upc from apple: %w", err)
+	}
+	res, err := a.adaptAlbum(album)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a *AppleAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	ck := apple.CompositeKey{}
+	if err := ck.Unmarshal(id); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal artist id: %w", err)
+	}
+
+	artist, err := a.client.FetchArtist(ctx, ck.ID, ck.Storefront)
+	if err != nil {
+		if errors.Is(err, apple.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from apple: %w", err)
+	}
+
+	res, err := a.adaptArtist(artist)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a *AppleAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	artist, err := a.client.SearchArtist(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, apple.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search artist from apple: %w", err)
+	}
+	res, err := a.adaptArtist(artist)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a *AppleAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	ck := apple.CompositeKey{}
+	if err := ck.Unmarshal(id); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal playlist id: %w", err)
+	}
+
+	playlist, err := a.client.FetchPlaylist(ctx, ck.ID, ck.Storefront)
+	if err != nil {
+		if errors.Is(err, apple.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from apple: %w", err)
+	}
+
+	res, err := a.adaptPlaylist(playlist)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (a *AppleAdapter) adaptTrack(track *apple.Entity) (*Entity, error) {
 	ck := apple.CompositeKey{}
 	if err := ck.ParseFromTrackURL(track.Attributes.URL); err != nil {
@@ -97,12 +225,21 @@ func (a *AppleAdapter) adaptTrack(track *apple.Entity) (*Entity, error) {
 	}
 
 	return &Entity{
-		ID:       ck.Marshal(),
-		Title:    track.Attributes.Name,
-		Artist:   track.Attributes.ArtistName,
-		URL:      track.Attributes.URL,
-		Provider: Apple,
-		Type:     Track,
+		ID:          ck.Marshal(),
+		Title:       track.Attributes.Name,
+		Artist:      track.Attributes.ArtistName,
+		URL:         track.Attributes.URL,
+		Provider:    Apple,
+		Type:        Track,
+		ISRC:        track.Attributes.ISRC,
+		Artists:     []string{track.Attributes.ArtistName},
+		Album:       track.Attributes.AlbumName,
+		Duration:    time.Duration(track.Attributes.DurationInMillis) * time.Millisecond,
+		ReleaseDate: track.Attributes.ReleaseDate,
+		Artwork:     track.Attributes.ArtworkURL(),
+		Explicit:    track.Attributes.IsExplicit(),
+		TrackNumber: track.Attributes.TrackNumber,
+		DiscNumber:  track.Attributes.DiscNumber,
 	}, nil
 }
 
@@ -112,12 +249,59 @@ func (a *AppleAdapter) adaptAlbum(album *apple.Entity) (*Entity, error) {
 		return nil, err
 	}
 
+	return &Entity{
+		ID:          ck.Marshal(),
+		Title:       album.Attributes.Name,
+		Artist:      album.Attributes.ArtistName,
+		URL:         album.Attributes.URL,
+		Provider:    Apple,
+		Type:        Album,
+		UPC:         album.Attributes.UPC,
+		Artists:     []string{album.Attributes.ArtistName},
+		ReleaseDate: album.Attributes.ReleaseDate,
+		Artwork:     album.Attributes.ArtworkURL(),
+		Explicit:    album.Attributes.IsExplicit(),
+	}, nil
+}
+
+func (a *AppleAdapter) adaptArtist(artist *apple.Entity) (*Entity, error) {
+	ck := apple.CompositeKey{}
+	if err := ck.ParseFromArtistURL(artist.Attributes.URL); err != nil {
+		return nil, err
+	}
+
+	return &Entity{
+		ID:       ck.Marshal(),
+		Title:    artist.Attributes.Name,
+		Artist:   artist.Attributes.Name,
+		URL:      artist.Attributes.URL,
+		Provider: Apple,
+		Type:     Artist,
+	}, nil
+}
+
+func (a *AppleAdapter) adaptPlaylist(playlist *apple.Playlist) (*Entity, error) {
+	ck := apple.CompositeKey{}
+	if err := ck.ParseFromPlaylistURL(playlist.Attributes.URL); err != nil {
+		return nil, err
+	}
+
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		res, err := a.adaptTrack(track)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, res)
+	}
+
 	return &Entity{
 		ID:       ck.Marshal(),
-		Title:    album.Attributes.Name,
-		Artist:   album.Attributes.ArtistName,
-		URL:      album.Attributes.URL,
+		Title:    playlist.Attributes.Name,
+		Artist:   playlist.Attributes.CuratorName,
+		URL:      playlist.Attributes.URL,
 		Provider: Apple,
-		Type:     Album,
+		Type:     Playlist,
+		Tracks:   tracks,
 	}, nil
 }
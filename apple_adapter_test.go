@@ -11,10 +11,18 @@ import (
 )
 
 type appleClientMock struct {
-	fetchTrack  map[string]*apple.Entity
-	fetchAlbum  map[string]*apple.Entity
-	searchTrack map[string]map[string]*apple.Entity
-	searchAlbum map[string]map[string]*apple.Entity
+	fetchTrack    map[string]*apple.Entity
+	fetchAlbum    map[string]*apple.Entity
+	searchTrack   map[string]map[string]*apple.Entity
+	searchAlbum   map[string]map[string]*apple.Entity
+	searchTracks  map[string]map[string][]*apple.Entity
+	searchAlbums  map[string]map[string][]*apple.Entity
+	fetchArtist   map[string]*apple.Entity
+	searchArtist  map[string]*apple.Entity
+	fetchPlaylist map[string]*apple.Playlist
+
+	searchTrackByISRC map[string]*apple.Entity
+	searchAlbumByUPC  map[string]*apple.Entity
 }
 
 func (c *appleClientMock) FetchTrack(_ context.Context, id, storefront string) (*apple.Entity, error) {
@@ -36,6 +44,14 @@ func (c *appleClientMock) SearchTrack(_ context.Context, artistName, trackName s
 	return nil, apple.NotFoundError
 }
 
+func (c *appleClientMock) SearchTracks(_ context.Context, artistName, trackName string, limit int) ([]*apple.Entity, error) {
+	tracks, ok := c.searchTracks[artistName][trackName]
+	if !ok {
+		return nil, apple.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
 func (c *appleClientMock) FetchAlbum(_ context.Context, id, storefront string) (*apple.Entity, error) {
 	album, ok := c.fetchAlbum[storefront+"-"+id]
 	if !ok {
@@ -55,6 +71,54 @@ func (c *appleClientMock) SearchAlbum(_ context.Context, artistName, albumName s
 	return nil, apple.NotFoundError
 }
 
+func (c *appleClientMock) SearchAlbums(_ context.Context, artistName, albumName string, limit int) ([]*apple.Entity, error) {
+	albums, ok := c.searchAlbums[artistName][albumName]
+	if !ok {
+		return nil, apple.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *appleClientMock) FetchArtist(_ context.Context, id, storefront string) (*apple.Entity, error) {
+	artist, ok := c.fetchArtist[storefront+"-"+id]
+	if !ok {
+		return nil, apple.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *appleClientMock) SearchArtist(_ context.Context, artistName string) (*apple.Entity, error) {
+	artist, ok := c.searchArtist[artistName]
+	if !ok {
+		return nil, apple.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *appleClientMock) SearchTrackByISRC(_ context.Context, isrc string) (*apple.Entity, error) {
+	track, ok := c.searchTrackByISRC[isrc]
+	if !ok {
+		return nil, apple.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *appleClientMock) SearchAlbumByUPC(_ context.Context, upc string) (*apple.Entity, error) {
+	album, ok := c.searchAlbumByUPC[upc]
+	if !ok {
+		return nil, apple.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *appleClientMock) FetchPlaylist(_ context.Context, id, storefront string) (*apple.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[storefront+"-"+id]
+	if !ok {
+		return nil, apple.NotFoundError
+	}
+	return playlist, nil
+}
+
 func TestAppleAdapter_FetchTrack(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -85,6 +149,50 @@ func TestAppleAdapter_FetchTrack(t *testing.T) {
 				URL:      "https://music.apple.com/ru/album/song-name/1234567890?i=123",
 				Provider: Apple,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+		{
+			name: "found ID with full metadata",
+			id:   "ru-123",
+			clientMock: &appleClientMock{
+				fetchTrack: map[string]*apple.Entity{
+					"ru-123": {
+						ID: "ru-123",
+						Attributes: apple.Attributes{
+							ArtistName:       "sample artist",
+							Name:             "sample name",
+							URL:              "https://music.apple.com/ru/album/song-name/1234567890?i=123",
+							AlbumName:        "sample album",
+							DurationInMillis: 215000,
+							ReleaseDate:      "2017-06-23",
+							Artwork: &apple.Artwork{
+								URL:    "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/{w}x{h}bb.jpg",
+								Width:  3000,
+								Height: 3000,
+							},
+							ContentRating: "explicit",
+							TrackNumber:   3,
+							DiscNumber:    1,
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "ru-123",
+				Title:       "sample name",
+				Artist:      "sample artist",
+				URL:         "https://music.apple.com/ru/album/song-name/1234567890?i=123",
+				Provider:    Apple,
+				Type:        Track,
+				Artists:     []string{"sample artist"},
+				Album:       "sample album",
+				Duration:    215 * time.Second,
+				ReleaseDate: "2017-06-23",
+				Artwork:     "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/{w}x{h}bb.jpg",
+				Explicit:    true,
+				TrackNumber: 3,
+				DiscNumber:  1,
 			},
 		},
 		{
@@ -147,6 +255,7 @@ func TestAppleAdapter_SearchTrack(t *testing.T) {
 				URL:      "https://music.apple.com/ru/album/song-name/1234567890?i=123",
 				Provider: Apple,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -176,6 +285,54 @@ func TestAppleAdapter_SearchTrack(t *testing.T) {
 	}
 }
 
+func TestAppleAdapter_SearchTrackCandidates(t *testing.T) {
+	clientMock := &appleClientMock{
+		searchTracks: map[string]map[string][]*apple.Entity{
+			"sample artist": {
+				"sample name": {
+					{
+						ID: "123",
+						Attributes: apple.Attributes{
+							ArtistName: "sample artist",
+							Name:       "sample name",
+							URL:        "https://music.apple.com/us/album/song-name/1234567890?i=123",
+						},
+					},
+					{
+						ID: "456",
+						Attributes: apple.Attributes{
+							ArtistName: "sample artist",
+							Name:       "sample name (Live)",
+							URL:        "https://music.apple.com/us/album/song-name/1234567890?i=456",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newAppleAdapter(clientMock)
+	result, err := a.SearchTrackCandidates(ctx, "sample artist", "sample name", 1)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:       "us-123",
+			Title:    "sample name",
+			Artist:   "sample artist",
+			URL:      "https://music.apple.com/us/album/song-name/1234567890?i=123",
+			Provider: Apple,
+			Type:     Track,
+			Artists:  []string{"sample artist"},
+		},
+	}, result)
+
+	_, err = a.SearchTrackCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
 func TestAppleAdapter_FetchAlbum(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -208,6 +365,7 @@ func TestAppleAdapter_FetchAlbum(t *testing.T) {
 				URL:      "https://music.apple.com/ru/album/name/456",
 				Provider: Apple,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -271,6 +429,7 @@ func TestAppleAdapter_SearchAlbum(t *testing.T) {
 				URL:      "https://music.apple.com/ru/album/name/456",
 				Provider: Apple,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -299,3 +458,318 @@ func TestAppleAdapter_SearchAlbum(t *testing.T) {
 		})
 	}
 }
+
+func TestAppleAdapter_FetchArtist(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		clientMock     *appleClientMock
+		expectedArtist *Entity
+		expectedErr    error
+	}{
+		{
+			name: "found ID",
+			id:   "us-657515",
+			clientMock: &appleClientMock{
+				fetchArtist: map[string]*apple.Entity{
+					"us-657515": {
+						ID: "657515",
+						Attributes: apple.Attributes{
+							Name: "sample artist",
+							URL:  "https://music.apple.com/us/artist/sample-artist/657515",
+						},
+					},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "us-657515",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://music.apple.com/us/artist/sample-artist/657515",
+				Provider: Apple,
+				Type:     Artist,
+			},
+		},
+		{
+			name:           "not found ID",
+			id:             "us-404",
+			clientMock:     &appleClientMock{},
+			expectedArtist: nil,
+			expectedErr:    EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newAppleAdapter(tt.clientMock)
+			result, err := a.FetchArtist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestAppleAdapter_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name           string
+		artistName     string
+		clientMock     *appleClientMock
+		expectedArtist *Entity
+		expectedErr    error
+	}{
+		{
+			name:       "found query",
+			artistName: "sample artist",
+			clientMock: &appleClientMock{
+				searchArtist: map[string]*apple.Entity{
+					"sample artist": {
+						ID: "657515",
+						Attributes: apple.Attributes{
+							Name: "sample artist",
+							URL:  "https://music.apple.com/us/artist/sample-artist/657515",
+						},
+					},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "us-657515",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://music.apple.com/us/artist/sample-artist/657515",
+				Provider: Apple,
+				Type:     Artist,
+			},
+		},
+		{
+			name:           "not found query",
+			artistName:     "not found artist",
+			clientMock:     &appleClientMock{},
+			expectedArtist: nil,
+			expectedErr:    EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newAppleAdapter(tt.clientMock)
+			result, err := a.SearchArtist(ctx, tt.artistName)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestAppleAdapter_FetchPlaylist(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		clientMock       *appleClientMock
+		expectedPlaylist *Entity
+		expectedErr      error
+	}{
+		{
+			name: "found ID",
+			id:   "us-pl.f4d106fed2bd41149aaacabb233eb5eb",
+			clientMock: &appleClientMock{
+				fetchPlaylist: map[string]*apple.Playlist{
+					"us-pl.f4d106fed2bd41149aaacabb233eb5eb": {
+						Entity: &apple.Entity{
+							ID: "pl.f4d106fed2bd41149aaacabb233eb5eb",
+							Attributes: apple.Attributes{
+								Name:        "sample playlist",
+								CuratorName: "sample curator",
+								URL:         "https://music.apple.com/us/playlist/sample-playlist/pl.f4d106fed2bd41149aaacabb233eb5eb",
+							},
+						},
+						Tracks: []*apple.Entity{
+							{
+								ID: "1234567890",
+								Attributes: apple.Attributes{
+									ArtistName: "sample artist",
+									Name:       "sample name",
+									URL:        "https://music.apple.com/us/album/sample-album/123?i=1234567890",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPlaylist: &Entity{
+				ID:       "us-pl.f4d106fed2bd41149aaacabb233eb5eb",
+				Title:    "sample playlist",
+				Artist:   "sample curator",
+				URL:      "https://music.apple.com/us/playlist/sample-playlist/pl.f4d106fed2bd41149aaacabb233eb5eb",
+				Provider: Apple,
+				Type:     Playlist,
+				Tracks: []*Entity{
+					{
+						ID:       "us-1234567890",
+						Title:    "sample name",
+						Artist:   "sample artist",
+						URL:      "https://music.apple.com/us/album/sample-album/123?i=1234567890",
+						Provider: Apple,
+						Type:     Track,
+						Artists:  []string{"sample artist"},
+					},
+				},
+			},
+		},
+		{
+			name:             "not found ID",
+			id:               "us-pl.notfound",
+			clientMock:       &appleClientMock{},
+			expectedPlaylist: nil,
+			expectedErr:      EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newAppleAdapter(tt.clientMock)
+			result, err := a.FetchPlaylist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPlaylist, result)
+			}
+		})
+	}
+}
+
+func TestAppleAdapter_SearchTrackByISRC(t *testing.T) {
+	tests := []struct {
+		name          string
+		isrc          string
+		clientMock    *appleClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ISRC",
+			isrc: "USUM71703861",
+			clientMock: &appleClientMock{
+				searchTrackByISRC: map[string]*apple.Entity{
+					"USUM71703861": {
+						ID: "1234567890",
+						Attributes: apple.Attributes{
+							ArtistName: "sample artist",
+							Name:       "sample name",
+							URL:        "https://music.apple.com/us/album/sample-album/123?i=1234567890",
+							ISRC:       "USUM71703861",
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:       "us-1234567890",
+				Title:    "sample name",
+				Artist:   "sample artist",
+				URL:      "https://music.apple.com/us/album/sample-album/123?i=1234567890",
+				Provider: Apple,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+				ISRC:     "USUM71703861",
+			},
+		},
+		{
+			name:        "not found ISRC",
+			isrc:        "notFoundISRC",
+			clientMock:  &appleClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newAppleAdapter(tt.clientMock)
+			result, err := a.SearchTrackByISRC(ctx, tt.isrc)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestAppleAdapter_SearchAlbumByUPC(t *testing.T) {
+	tests := []struct {
+		name          string
+		upc           string
+		clientMock    *appleClientMock
+		expectedAlbum *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found UPC",
+			upc:  "00602557382549",
+			clientMock: &appleClientMock{
+				searchAlbumByUPC: map[string]*apple.Entity{
+					"00602557382549": {
+						ID: "1234567890",
+						Attributes: apple.Attributes{
+							ArtistName: "sample artist",
+							Name:       "sample name",
+							URL:        "https://music.apple.com/us/album/sample-album/1234567890",
+							UPC:        "00602557382549",
+						},
+					},
+				},
+			},
+			expectedAlbum: &Entity{
+				ID:       "us-1234567890",
+				Title:    "sample name",
+				Artist:   "sample artist",
+				URL:      "https://music.apple.com/us/album/sample-album/1234567890",
+				Provider: Apple,
+				Type:     Album,
+				Artists:  []string{"sample artist"},
+				UPC:      "00602557382549",
+			},
+		},
+		{
+			name:        "not found UPC",
+			upc:         "notFoundUPC",
+			clientMock:  &appleClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newAppleAdapter(tt.clientMock)
+			result, err := a.SearchAlbumByUPC(ctx, tt.upc)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAlbum, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package streamnx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/translator"
+)
+
+// artistMatcher tells whether an artist found on a provider is the queried one, also when
+// the provider spells a Cyrillic name that the query has transliterated or translated.
+// Without a translator only transliterated names are matched, as well as when the translator
+// has no translation or fails: its failures are reported by Registry.Health.
+type artistMatcher struct {
+	translator translator.Translator
+}
+
+func newArtistMatcher(t translator.Translator) *artistMatcher {
+	return &artistMatcher{
+		translator: t,
+	}
+}
+
+func (m *artistMatcher) match(ctx context.Context, found, query string) (bool, error) {
+	lcFound := strings.ToLower(found)
+	lcQuery := strings.ToLower(query)
+	if lcQuery == lcFound {
+		return true, nil
+	}
+
+	translitedFoundArtist := translator.TranslitCyrToLat(lcFound)
+	if lcQuery == translitedFoundArtist {
+		return true, nil
+	}
+
+	if m.translator != nil && translator.HasCyrillic(found) {
+		translatedArtist, err := m.translator.TranslateEnToRu(ctx, lcQuery)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return false, fmt.Errorf("failed to translate artist name: %w", ctxErr)
+			}
+			return false, nil
+		}
+		if strings.ToLower(translatedArtist) == lcFound {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// matchArtist keeps the items whose artist, as returned by artistName, matches the query.
+func matchArtist[T any](ctx context.Context, m *artistMatcher, items []T, query string, artistName func(T) string) ([]T, error) {
+	matched := make([]T, 0, len(items))
+	for _, item := range items {
+		artistMatch, err := m.match(ctx, artistName(item), query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check artist match: %w", err)
+		}
+		if artistMatch {
+			matched = append(matched, item)
+		}
+	}
+	return matched, nil
+}
+
+// searchMatching searches for the artist and title and keeps the results of the queried artist.
+// Cyrillic titles are searched once more with the artist transliterated to Cyrillic, since the
+// catalog may list the artist under the Cyrillic name only. Nothing found is an empty result.
+func searchMatching[T any](
+	ctx context.Context,
+	m *artistMatcher,
+	artist, title string,
+	search func(query string) ([]T, error),
+	artistName func(T) string,
+) ([]T, error) {
+	items, err := search(entityFullTitle(artist, title))
+	if err != nil {
+		return nil, err
+	}
+	matched, err := matchArtist(ctx, m, items, artist, artistName)
+	if err != nil || len(matched) > 0 {
+		return matched, err
+	}
+
+	if translator.HasCyrillic(title) {
+		translited := translator.TranslitLatToCyr(artist)
+		return search(entityFullTitle(translited, title))
+	}
+	return nil, nil
+}
@@ -0,0 +1,164 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtistMatcher_match(t *testing.T) {
+	tests := []struct {
+		name           string
+		found          string
+		query          string
+		translatorMock translatorMock
+		want           bool
+	}{
+		{
+			name:  "same name in different case",
+			found: "Radiohead",
+			query: "radiohead",
+			want:  true,
+		},
+		{
+			name:  "transliterated name",
+			found: "Земфира",
+			query: "Zemfira",
+			want:  true,
+		},
+		{
+			name:  "translated name",
+			found: "Ленинград",
+			query: "Leningrad Cord",
+			translatorMock: translatorMock{
+				enToRu: map[string]string{"leningrad cord": "ленинград"},
+			},
+			want: true,
+		},
+		{
+			name:  "different artist",
+			found: "Сплин",
+			query: "Zemfira",
+			want:  false,
+		},
+		{
+			name:  "failing translator falls back to transliteration",
+			found: "Земфира",
+			query: "Zemfira",
+			translatorMock: translatorMock{
+				err: errors.New("translator is down"),
+			},
+			want: true,
+		},
+		{
+			name:  "failing translator",
+			found: "Ленинград",
+			query: "Leningrad Cord",
+			translatorMock: translatorMock{
+				err: errors.New("translator is down"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			m := newArtistMatcher(&tt.translatorMock)
+			result, err := m.match(ctx, tt.found, tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestArtistMatcher_matchWithoutTranslator(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	m := newArtistMatcher(nil)
+
+	result, err := m.match(ctx, "Земфира", "Zemfira")
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = m.match(ctx, "Ленинград", "Leningrad Cord")
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestRegistry_artistDictionary(t *testing.T) {
+	ctx := context.Background()
+	yandexMatcher := func(t *testing.T, opts ...RegistryOption) *artistMatcher {
+		registry, err := NewRegistry(ctx, Credentials{}, append(opts, WithoutTranslator())...)
+		require.NoError(t, err)
+		return registry.adapters[Yandex.сode].(*YandexAdapter).matcher
+	}
+
+	t.Run("built-in and added names", func(t *testing.T) {
+		m := yandexMatcher(t, WithArtistDictionary(strings.NewReader("Sample Band\tСэмпл Бэнд\n")))
+
+		result, err := m.match(ctx, "Ленинград", "Leningrad Cord")
+		require.NoError(t, err)
+		require.True(t, result)
+
+		result, err = m.match(ctx, "Сэмпл Бэнд", "Sample Band")
+		require.NoError(t, err)
+		require.True(t, result)
+
+		result, err = m.match(ctx, "Сплин", "Sample Band")
+		require.NoError(t, err)
+		require.False(t, result)
+	})
+
+	t.Run("without dictionary", func(t *testing.T) {
+		m := yandexMatcher(t, WithoutArtistDictionary())
+
+		result, err := m.match(ctx, "Ленинград", "Leningrad Cord")
+		require.NoError(t, err)
+		require.False(t, result)
+	})
+
+	t.Run("missing dictionary file", func(t *testing.T) {
+		_, err := NewRegistry(ctx, Credentials{}, WithArtistDictionaryFile(filepath.Join(t.TempDir(), "missing.tsv")))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestSearchMatching(t *testing.T) {
+	catalog := map[string][]string{
+		"Zemfira – Хочешь?": {"Сплин", "Zemfira Tribute Band"},
+		"Земфира – Хочешь?": {"Земфира"},
+		"Zemfira – Ariva":   {"Other Artist", "Zemfira"},
+	}
+	search := func(query string) ([]string, error) {
+		return catalog[query], nil
+	}
+	artistName := func(artist string) string {
+		return artist
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	m := newArtistMatcher(&translatorMock{})
+
+	result, err := searchMatching(ctx, m, "Zemfira", "Ariva", search, artistName)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Zemfira"}, result)
+
+	result, err = searchMatching(ctx, m, "Zemfira", "Хочешь?", search, artistName)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Земфира"}, result)
+
+	result, err = searchMatching(ctx, m, "Zemfira", "Unknown", search, artistName)
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
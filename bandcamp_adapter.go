@@ -0,0 +1,178 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/bandcamp"
+)
+
+type BandcampAdapter struct {
+	client bandcamp.Client
+}
+
+func newBandcampAdapter(client bandcamp.Client) *BandcampAdapter {
+	return &BandcampAdapter{
+		client: client,
+	}
+}
+
+func (a *BandcampAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	track, err := a.client.FetchTrack(ctx, id)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get track from bandcamp: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *BandcampAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	track, err := a.client.SearchTrack(ctx, artistName, trackName)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track on bandcamp: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *BandcampAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := a.client.SearchTracks(ctx, artistName, trackName, limit)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search tracks on bandcamp: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
+func (a *BandcampAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	album, err := a.client.FetchAlbum(ctx, id)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get album from bandcamp: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *BandcampAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	album, err := a.client.SearchAlbum(ctx, artistName, albumName)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album on bandcamp: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *BandcampAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := a.client.SearchAlbums(ctx, artistName, albumName, limit)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search albums on bandcamp: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album))
+	}
+	return candidates, nil
+}
+
+func (a *BandcampAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	artist, err := a.client.FetchArtist(ctx, id)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from bandcamp: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *BandcampAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	artist, err := a.client.SearchArtist(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, bandcamp.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search artist on bandcamp: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+// Bandcamp has no playlists.
+func (a *BandcampAdapter) FetchPlaylist(_ context.Context, _ string) (*Entity, error) {
+	return nil, InvalidEntityTypeError
+}
+
+func (a *BandcampAdapter) adaptTrack(track *bandcamp.Track) *Entity {
+	entity := Entity{
+		ID:          track.ID,
+		Title:       track.Title,
+		Artist:      track.Artist,
+		URL:         track.URL(),
+		Provider:    Bandcamp,
+		Type:        Track,
+		ISRC:        track.ISRC,
+		Album:       track.Album,
+		Duration:    track.Duration,
+		ReleaseDate: track.ReleaseDate,
+		Artwork:     track.ImageURL,
+		TrackNumber: track.TrackNumber,
+	}
+	if track.Artist != "" {
+		entity.Artists = []string{track.Artist}
+	}
+	return &entity
+}
+
+func (a *BandcampAdapter) adaptAlbum(album *bandcamp.Album) *Entity {
+	entity := Entity{
+		ID:          album.ID,
+		Title:       album.Title,
+		Artist:      album.Artist,
+		URL:         album.URL(),
+		Provider:    Bandcamp,
+		Type:        Album,
+		UPC:         album.UPC,
+		ReleaseDate: album.ReleaseDate,
+		Artwork:     album.ImageURL,
+	}
+	if album.Artist != "" {
+		entity.Artists = []string{album.Artist}
+	}
+	return &entity
+}
+
+func (a *BandcampAdapter) adaptArtist(artist *bandcamp.Artist) *Entity {
+	return &Entity{
+		ID:       artist.ID,
+		Title:    artist.Name,
+		Artist:   artist.Name,
+		URL:      artist.URL(),
+		Provider: Bandcamp,
+		Type:     Artist,
+	}
+}
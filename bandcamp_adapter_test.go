@@ -0,0 +1,216 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/bandcamp"
+
+	"github.com/stretchr/testify/require"
+)
+
+type bandcampClientMock struct {
+	fetchTrack   map[string]*bandcamp.Track
+	fetchAlbum   map[string]*bandcamp.Album
+	searchTracks map[string]map[string][]*bandcamp.Track
+	searchAlbums map[string]map[string][]*bandcamp.Album
+	fetchArtist  map[string]*bandcamp.Artist
+	searchArtist map[string]*bandcamp.Artist
+}
+
+func (c *bandcampClientMock) FetchTrack(_ context.Context, id string) (*bandcamp.Track, error) {
+	track, ok := c.fetchTrack[id]
+	if !ok {
+		return nil, bandcamp.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *bandcampClientMock) SearchTrack(ctx context.Context, artistName, trackName string) (*bandcamp.Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *bandcampClientMock) SearchTracks(_ context.Context, artistName, trackName string, limit int) ([]*bandcamp.Track, error) {
+	tracks, ok := c.searchTracks[artistName][trackName]
+	if !ok {
+		return nil, bandcamp.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (c *bandcampClientMock) FetchAlbum(_ context.Context, id string) (*bandcamp.Album, error) {
+	album, ok := c.fetchAlbum[id]
+	if !ok {
+		return nil, bandcamp.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *bandcampClientMock) SearchAlbum(ctx context.Context, artistName, albumName string) (*bandcamp.Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *bandcampClientMock) SearchAlbums(_ context.Context, artistName, albumName string, limit int) ([]*bandcamp.Album, error) {
+	albums, ok := c.searchAlbums[artistName][albumName]
+	if !ok {
+		return nil, bandcamp.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *bandcampClientMock) FetchArtist(_ context.Context, id string) (*bandcamp.Artist, error) {
+	artist, ok := c.fetchArtist[id]
+	if !ok {
+		return nil, bandcamp.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *bandcampClientMock) SearchArtist(_ context.Context, artistName string) (*bandcamp.Artist, error) {
+	artist, ok := c.searchArtist[artistName]
+	if !ok {
+		return nil, bandcamp.NotFoundError
+	}
+	return artist, nil
+}
+
+func TestBandcampAdapter_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		clientMock    *bandcampClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ID",
+			id:   "tychomusic.bandcamp.com/track/awake",
+			clientMock: &bandcampClientMock{
+				fetchTrack: map[string]*bandcamp.Track{
+					"tychomusic.bandcamp.com/track/awake": {
+						ID:          "tychomusic.bandcamp.com/track/awake",
+						Title:       "Awake",
+						Artist:      "Tycho",
+						Album:       "Awake",
+						ISRC:        "USGHO1400001",
+						Duration:    283 * time.Second,
+						ReleaseDate: "2014-03-18",
+						ImageURL:    "https://f4.bcbits.com/img/a1_10.jpg",
+						TrackNumber: 1,
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "tychomusic.bandcamp.com/track/awake",
+				Title:       "Awake",
+				Artist:      "Tycho",
+				URL:         "https://tychomusic.bandcamp.com/track/awake",
+				Provider:    Bandcamp,
+				Type:        Track,
+				ISRC:        "USGHO1400001",
+				Artists:     []string{"Tycho"},
+				Album:       "Awake",
+				Duration:    283 * time.Second,
+				ReleaseDate: "2014-03-18",
+				Artwork:     "https://f4.bcbits.com/img/a1_10.jpg",
+				TrackNumber: 1,
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "tychomusic.bandcamp.com/track/unknown",
+			clientMock:  &bandcampClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newBandcampAdapter(tt.clientMock)
+			result, err := a.FetchTrack(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestBandcampAdapter_SearchAlbumCandidates(t *testing.T) {
+	clientMock := &bandcampClientMock{
+		searchAlbums: map[string]map[string][]*bandcamp.Album{
+			"Tycho": {
+				"Awake": {
+					{ID: "tychomusic.bandcamp.com/album/awake", Title: "Awake", Artist: "Tycho"},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newBandcampAdapter(clientMock)
+	result, err := a.SearchAlbumCandidates(ctx, "Tycho", "Awake", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:       "tychomusic.bandcamp.com/album/awake",
+			Title:    "Awake",
+			Artist:   "Tycho",
+			URL:      "https://tychomusic.bandcamp.com/album/awake",
+			Provider: Bandcamp,
+			Type:     Album,
+			Artists:  []string{"Tycho"},
+		},
+	}, result)
+
+	_, err = a.SearchAlbumCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestBandcampAdapter_FetchArtist(t *testing.T) {
+	clientMock := &bandcampClientMock{
+		fetchArtist: map[string]*bandcamp.Artist{
+			"tychomusic.bandcamp.com": {ID: "tychomusic.bandcamp.com", Name: "Tycho"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newBandcampAdapter(clientMock)
+	result, err := a.FetchArtist(ctx, "tychomusic.bandcamp.com")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "tychomusic.bandcamp.com",
+		Title:    "Tycho",
+		Artist:   "Tycho",
+		URL:      "https://tychomusic.bandcamp.com",
+		Provider: Bandcamp,
+		Type:     Artist,
+	}, result)
+
+	_, err = a.SearchArtist(ctx, "Unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestBandcampAdapter_FetchPlaylist(t *testing.T) {
+	a := newBandcampAdapter(&bandcampClientMock{})
+	_, err := a.FetchPlaylist(context.Background(), "any")
+	require.ErrorIs(t, err, InvalidEntityTypeError)
+}
@@ -0,0 +1,150 @@
+package streamnx
+
+import (
+	"container/list"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores registry responses by key. Values are entities, candidate lists or
+// EntityNotFoundError for negative caching. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (value any, ok bool)
+	Set(key string, value any, ttl time.Duration)
+}
+
+type registryCache struct {
+	store       Cache
+	ttl         time.Duration
+	notFoundTTL time.Duration
+}
+
+// LRUCache is an in-memory Cache evicting the least recently used entries above its size.
+type LRUCache struct {
+	size    int
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+func (c *LRUCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*lruEntry)
+	if c.now().After(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+func (c *LRUCache) Set(key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// Len returns the number of stored entries including expired ones not evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *LRUCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*lruEntry).key)
+}
+
+// cached returns the value stored by key or loads and stores it. EntityNotFoundError is
+// stored with the not found TTL, other errors are never cached. Entities are copied in and
+// out of the store, so callers are free to change the entities they get.
+func cached[T any](c *registryCache, key string, load func() (T, error)) (T, error) {
+	if c == nil {
+		return load()
+	}
+
+	if value, ok := c.store.Get(key); ok {
+		if err, ok := value.(error); ok {
+			var zero T
+			return zero, err
+		}
+		if typed, ok := value.(T); ok {
+			return copyCached(typed), nil
+		}
+	}
+
+	value, err := load()
+	switch {
+	case err == nil:
+		c.store.Set(key, copyCached(value), c.ttl)
+	case errors.Is(err, EntityNotFoundError) && c.notFoundTTL > 0:
+		c.store.Set(key, EntityNotFoundError, c.notFoundTTL)
+	}
+	return value, err
+}
+
+func copyCached[T any](value T) T {
+	switch v := any(value).(type) {
+	case *Entity:
+		return any(v.clone()).(T)
+	case []*Entity:
+		return any(cloneEntities(v)).(T)
+	}
+	return value
+}
+
+// cacheKey prefixes every part with its length, so parts containing the separator can't
+// make keys of different parts equal.
+func cacheKey(parts ...string) string {
+	var key strings.Builder
+	for _, part := range parts {
+		key.WriteString(strconv.Itoa(len(part)))
+		key.WriteByte(':')
+		key.WriteString(part)
+		key.WriteByte('|')
+	}
+	return key.String()
+}
+
+// normalizeQuery makes search cache keys insensitive to case and extra whitespace.
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
@@ -0,0 +1,160 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type countingAdapterMock struct {
+	adapterMock
+	fetchTrackCalls  int
+	searchTrackCalls int
+	fetchTrackErr    error
+}
+
+func (a *countingAdapterMock) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	a.fetchTrackCalls++
+	if a.fetchTrackErr != nil {
+		return nil, a.fetchTrackErr
+	}
+	return a.adapterMock.FetchTrack(ctx, id)
+}
+
+func (a *countingAdapterMock) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	a.searchTrackCalls++
+	return a.adapterMock.SearchTrack(ctx, artistName, trackName)
+}
+
+func TestLRUCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewLRUCache(2)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", 1, time.Minute)
+	cache.Set("b", 2, time.Minute)
+
+	value, ok := cache.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 1, value)
+
+	cache.Set("c", 3, time.Minute)
+	require.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	require.False(t, ok, "least recently used entry should be evicted")
+
+	cache.Set("a", 10, time.Second)
+	value, ok = cache.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 10, value)
+
+	now = now.Add(2 * time.Second)
+	_, ok = cache.Get("a")
+	require.False(t, ok, "expired entry should not be returned")
+	require.Equal(t, 1, cache.Len())
+
+	value, ok = cache.Get("c")
+	require.True(t, ok)
+	require.Equal(t, 3, value)
+}
+
+func Test_cacheKey(t *testing.T) {
+	require.Equal(t, cacheKey("search", "a"), cacheKey("search", "a"))
+	require.NotEqual(t, cacheKey("a|b", "c"), cacheKey("a", "b|c"))
+	require.NotEqual(t, cacheKey("a", ""), cacheKey("a"))
+}
+
+func TestRegistry_WithCache(t *testing.T) {
+	ctx := context.Background()
+	adapter := &countingAdapterMock{
+		adapterMock: adapterMock{
+			fetchTrack: map[string]*Entity{
+				"1": {ID: "1"},
+			},
+			searchTrack: map[string]map[string]*Entity{
+				"Artist": {"Name": {ID: "2"}},
+			},
+		},
+	}
+
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithProviderAdapter(Apple, adapter),
+		WithProviderAdapter(Spotify, &adapterMock{}),
+		WithProviderAdapter(Yandex, &adapterMock{}),
+		WithProviderAdapter(Youtube, &adapterMock{}),
+		WithProviderAdapter(Deezer, &adapterMock{}),
+		WithProviderAdapter(SoundCloud, &adapterMock{}),
+		WithProviderAdapter(Tidal, &adapterMock{}),
+		WithProviderAdapter(Bandcamp, &adapterMock{}),
+		WithProviderAdapter(Amazon, &adapterMock{}),
+		WithProviderAdapter(VK, &adapterMock{}),
+		WithProviderAdapter(Zvuk, &adapterMock{}),
+		WithProviderAdapter(YoutubeMusic, &adapterMock{}),
+		WithCache(NewLRUCache(10), time.Hour, time.Minute),
+	)
+	require.NoError(t, err)
+
+	t.Run("fetch cached by provider, type and id", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			entity, err := registry.Fetch(ctx, Apple, Track, "1")
+			require.NoError(t, err)
+			require.Equal(t, &Entity{ID: "1"}, entity)
+		}
+		require.Equal(t, 1, adapter.fetchTrackCalls)
+
+		_, err := registry.Fetch(ctx, Apple, Album, "1")
+		require.ErrorIs(t, err, EntityNotFoundError)
+		require.Equal(t, 1, adapter.fetchTrackCalls)
+	})
+
+	t.Run("cached entities are copies", func(t *testing.T) {
+		entity, err := registry.Fetch(ctx, Apple, Track, "1")
+		require.NoError(t, err)
+		entity.Title = "changed"
+		entity.Tracks = append(entity.Tracks, &Entity{ID: "2"})
+
+		entity, err = registry.Fetch(ctx, Apple, Track, "1")
+		require.NoError(t, err)
+		require.Equal(t, &Entity{ID: "1"}, entity)
+	})
+
+	t.Run("not found cached", func(t *testing.T) {
+		adapter.fetchTrackCalls = 0
+		for i := 0; i < 2; i++ {
+			_, err := registry.Fetch(ctx, Apple, Track, "missing")
+			require.ErrorIs(t, err, EntityNotFoundError)
+		}
+		require.Equal(t, 1, adapter.fetchTrackCalls)
+	})
+
+	t.Run("other errors not cached", func(t *testing.T) {
+		adapter.fetchTrackCalls = 0
+		adapter.fetchTrackErr = errors.New("upstream failure")
+		defer func() { adapter.fetchTrackErr = nil }()
+
+		for i := 0; i < 2; i++ {
+			_, err := registry.Fetch(ctx, Apple, Track, "failing")
+			require.Error(t, err)
+		}
+		require.Equal(t, 2, adapter.fetchTrackCalls)
+	})
+
+	t.Run("search cached by normalized query", func(t *testing.T) {
+		entity, err := registry.Search(ctx, Apple, Track, "Artist", "Name")
+		require.NoError(t, err)
+		require.Equal(t, &Entity{ID: "2"}, entity)
+
+		entity, err = registry.Search(ctx, Apple, Track, " artist ", "NAME")
+		require.NoError(t, err)
+		require.Equal(t, &Entity{ID: "2"}, entity)
+
+		require.Equal(t, 1, adapter.searchTrackCalls)
+	})
+}
@@ -0,0 +1,200 @@
+package streamnx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const playlistConversionConcurrency = 5
+
+type Conversion struct {
+	Link    *Link
+	Source  *Entity
+	Results []*ConversionResult
+}
+
+type ConversionResult struct {
+	Provider *Provider
+	Entity   *Entity
+	Err      error
+	Elapsed  time.Duration
+}
+
+// Convert parses the link, fetches the source entity and searches for it on the target provider.
+func (r *Registry) Convert(ctx context.Context, url string, target *Provider) (*Conversion, error) {
+	if r.adapter(target) == nil {
+		return nil, InvalidProviderError
+	}
+	return r.convert(ctx, url, []*Provider{target})
+}
+
+// ConvertAll parses the link, fetches the source entity and concurrently searches for it
+// on every registered provider except the source one.
+func (r *Registry) ConvertAll(ctx context.Context, url string) (*Conversion, error) {
+	return r.convert(ctx, url, nil)
+}
+
+type PlaylistConversion struct {
+	Link     *Link
+	Playlist *Entity
+	Provider *Provider
+	Tracks   []*PlaylistTrackResult
+}
+
+type PlaylistTrackResult struct {
+	Source *Entity
+	Entity *Entity
+	Err    error
+}
+
+// ConvertPlaylist fetches the playlist behind the link and searches for each of its tracks
+// on the target provider, preserving the original track order.
+func (r *Registry) ConvertPlaylist(ctx context.Context, url string, target *Provider) (*PlaylistConversion, error) {
+	if r.adapter(target) == nil {
+		return nil, InvalidProviderError
+	}
+
+	link, err := r.ParseLink(url)
+	if err != nil {
+		return nil, err
+	}
+	if link.EntityType != Playlist {
+		return nil, InvalidEntityTypeError
+	}
+
+	playlist, err := r.Fetch(ctx, link.Provider, Playlist, link.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch source playlist: %w", err)
+	}
+
+	conversion := PlaylistConversion{
+		Link:     link,
+		Playlist: playlist,
+		Provider: target,
+		Tracks:   make([]*PlaylistTrackResult, len(playlist.Tracks)),
+	}
+
+	wg := sync.WaitGroup{}
+	semaphore := make(chan struct{}, playlistConversionConcurrency)
+	for i, track := range playlist.Tracks {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, track *Entity) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			conversion.Tracks[i] = r.convertPlaylistTrack(ctx, link, track, target)
+		}(i, track)
+	}
+	wg.Wait()
+
+	return &conversion, nil
+}
+
+// Found returns the number of playlist tracks found on the target provider.
+func (c *PlaylistConversion) Found() int {
+	found := 0
+	for _, track := range c.Tracks {
+		if track.Err == nil && track.Entity != nil {
+			found++
+		}
+	}
+	return found
+}
+
+// Total returns the number of tracks in the source playlist.
+func (c *PlaylistConversion) Total() int {
+	return len(c.Tracks)
+}
+
+// Result returns the conversion result for the given provider or nil if it was not a target.
+func (c *Conversion) Result(p *Provider) *ConversionResult {
+	for _, result := range c.Results {
+		if result.Provider == p {
+			return result
+		}
+	}
+	return nil
+}
+
+func (r *Registry) convert(ctx context.Context, url string, targets []*Provider) (*Conversion, error) {
+	link, err := r.ParseLink(url)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := r.Fetch(ctx, link.Provider, link.EntityType, link.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch source entity: %w", err)
+	}
+
+	if targets == nil {
+		targets = r.targetProviders(link.Provider)
+	}
+
+	conversion := Conversion{
+		Link:    link,
+		Source:  source,
+		Results: make([]*ConversionResult, len(targets)),
+	}
+
+	wg := sync.WaitGroup{}
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target *Provider) {
+			defer wg.Done()
+			conversion.Results[i] = r.convertTo(ctx, link, source, target)
+		}(i, target)
+	}
+	wg.Wait()
+
+	return &conversion, nil
+}
+
+func (r *Registry) convertTo(ctx context.Context, link *Link, source *Entity, target *Provider) *ConversionResult {
+	if link.Provider == target {
+		return &ConversionResult{
+			Provider: target,
+			Entity:   source,
+		}
+	}
+
+	startedAt := time.Now()
+	entity, err := r.match(ctx, target, link.EntityType, source)
+	return &ConversionResult{
+		Provider: target,
+		Entity:   entity,
+		Err:      err,
+		Elapsed:  time.Since(startedAt),
+	}
+}
+
+func (r *Registry) convertPlaylistTrack(ctx context.Context, link *Link, track *Entity, target *Provider) *PlaylistTrackResult {
+	if link.Provider == target {
+		return &PlaylistTrackResult{
+			Source: track,
+			Entity: track,
+		}
+	}
+
+	entity, err := r.match(ctx, target, Track, track)
+	return &PlaylistTrackResult{
+		Source: track,
+		Entity: entity,
+		Err:    err,
+	}
+}
+
+func (r *Registry) targetProviders(source *Provider) []*Provider {
+	providers := r.EnabledProviders()
+	targets := make([]*Provider, 0, len(providers))
+	for _, provider := range providers {
+		if provider != source {
+			targets = append(targets, provider)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,310 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_Convert(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		target      *Provider
+		appleMock   adapterMock
+		spotifyMock adapterMock
+		want        *Entity
+		wantErr     error
+		wantResErr  error
+	}{
+		{
+			name:   "track converted",
+			url:    "https://music.apple.com/us/album/song-name/1234567890?i=987654321",
+			target: Spotify,
+			appleMock: adapterMock{
+				fetchTrack: map[string]*Entity{
+					"us-987654321": {ID: "us-987654321", Artist: "artist", Title: "name"},
+				},
+			},
+			spotifyMock: adapterMock{
+				searchTrack: map[string]map[string]*Entity{
+					"artist": {"name": {ID: "spotifyID", Artist: "artist", Title: "name"}},
+				},
+			},
+			want: &Entity{ID: "spotifyID", Artist: "artist", Title: "name", Confidence: 1},
+		},
+		{
+			name:   "album converted",
+			url:    "https://music.apple.com/us/album/album-name/1234567890",
+			target: Spotify,
+			appleMock: adapterMock{
+				fetchAlbum: map[string]*Entity{
+					"us-1234567890": {ID: "us-1234567890", Artist: "artist", Title: "name"},
+				},
+			},
+			spotifyMock: adapterMock{
+				searchAlbum: map[string]map[string]*Entity{
+					"artist": {"name": {ID: "spotifyID", Artist: "artist", Title: "name (Deluxe)"}},
+				},
+			},
+			want: &Entity{ID: "spotifyID", Artist: "artist", Title: "name (Deluxe)", Confidence: 1},
+		},
+		{
+			name:   "track converted by isrc",
+			url:    "https://music.apple.com/us/album/song-name/1234567890?i=987654321",
+			target: Spotify,
+			appleMock: adapterMock{
+				fetchTrack: map[string]*Entity{
+					"us-987654321": {ID: "us-987654321", Artist: "artist", Title: "Intro", ISRC: "USUM71703861"},
+				},
+			},
+			spotifyMock: adapterMock{
+				searchTrackByISRC: map[string]*Entity{
+					"USUM71703861": {ID: "spotifyISRCID"},
+				},
+				searchTrack: map[string]map[string]*Entity{
+					"artist": {"Intro": {ID: "spotifyTextID"}},
+				},
+			},
+			want: &Entity{ID: "spotifyISRCID", Confidence: 1},
+		},
+		{
+			name:   "target entity not found",
+			url:    "https://music.apple.com/us/album/song-name/1234567890?i=987654321",
+			target: Spotify,
+			appleMock: adapterMock{
+				fetchTrack: map[string]*Entity{
+					"us-987654321": {ID: "us-987654321", Artist: "artist", Title: "name"},
+				},
+			},
+			wantResErr: EntityNotFoundError,
+		},
+		{
+			name:    "source entity not found",
+			url:     "https://music.apple.com/us/album/song-name/1234567890?i=987654321",
+			target:  Spotify,
+			wantErr: EntityNotFoundError,
+		},
+		{
+			name:    "unknown link",
+			url:     "https://example.com/track/123456789",
+			target:  Spotify,
+			wantErr: UnknownLinkError,
+		},
+		{
+			name:    "invalid target provider",
+			url:     "https://music.apple.com/us/album/song-name/1234567890?i=987654321",
+			target:  &Provider{},
+			wantErr: InvalidProviderError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			registry, err := NewRegistry(
+				ctx,
+				Credentials{},
+				WithTranslator(&translatorMock{}),
+				WithProviderAdapter(Apple, &tt.appleMock),
+				WithProviderAdapter(Spotify, &tt.spotifyMock),
+				WithProviderAdapter(Yandex, &adapterMock{}),
+				WithProviderAdapter(Youtube, &adapterMock{}),
+				WithProviderAdapter(Deezer, &adapterMock{}),
+				WithProviderAdapter(SoundCloud, &adapterMock{}),
+				WithProviderAdapter(Tidal, &adapterMock{}),
+				WithProviderAdapter(Bandcamp, &adapterMock{}),
+				WithProviderAdapter(Amazon, &adapterMock{}),
+				WithProviderAdapter(VK, &adapterMock{}),
+				WithProviderAdapter(Zvuk, &adapterMock{}),
+				WithProviderAdapter(YoutubeMusic, &adapterMock{}),
+			)
+			require.NoError(t, err)
+
+			result, err := registry.Convert(ctx, tt.url, tt.target)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result.Results, 1)
+
+			converted := result.Result(tt.target)
+			require.NotNil(t, converted)
+			if tt.wantResErr != nil {
+				require.ErrorIs(t, converted.Err, tt.wantResErr)
+			} else {
+				require.NoError(t, converted.Err)
+				require.Equal(t, tt.want, converted.Entity)
+			}
+		})
+	}
+}
+
+func TestRegistry_ConvertAll(t *testing.T) {
+	ctx := context.Background()
+	found := &Entity{ID: "found"}
+
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithProviderAdapter(Apple, &adapterMock{
+			fetchTrack: map[string]*Entity{
+				"us-987654321": {ID: "us-987654321", Artist: "artist", Title: "name"},
+			},
+		}),
+		WithProviderAdapter(Spotify, &adapterMock{
+			searchTrack: map[string]map[string]*Entity{
+				"artist": {"name": found},
+			},
+		}),
+		WithProviderAdapter(Yandex, &adapterMock{
+			searchTrack: map[string]map[string]*Entity{
+				"artist": {"name": found},
+			},
+		}),
+		WithProviderAdapter(Youtube, &adapterMock{}),
+		WithProviderAdapter(Deezer, &adapterMock{}),
+		WithProviderAdapter(SoundCloud, &adapterMock{}),
+		WithProviderAdapter(Tidal, &adapterMock{}),
+		WithProviderAdapter(Bandcamp, &adapterMock{}),
+		WithProviderAdapter(Amazon, &adapterMock{}),
+		WithProviderAdapter(VK, &adapterMock{}),
+		WithProviderAdapter(Zvuk, &adapterMock{}),
+		WithProviderAdapter(YoutubeMusic, &adapterMock{}),
+	)
+	require.NoError(t, err)
+
+	result, err := registry.ConvertAll(ctx, "https://music.apple.com/us/album/song-name/1234567890?i=987654321")
+	require.NoError(t, err)
+
+	require.Equal(t, Apple, result.Link.Provider)
+	require.Equal(t, "us-987654321", result.Source.ID)
+	require.Len(t, result.Results, 11)
+	require.Nil(t, result.Result(Apple))
+
+	require.Equal(t, found, result.Result(Spotify).Entity)
+	require.Equal(t, found, result.Result(Yandex).Entity)
+	require.ErrorIs(t, result.Result(Youtube).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(Deezer).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(SoundCloud).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(Tidal).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(Bandcamp).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(Amazon).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(VK).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(Zvuk).Err, EntityNotFoundError)
+	require.ErrorIs(t, result.Result(YoutubeMusic).Err, EntityNotFoundError)
+}
+
+func TestRegistry_ConvertPlaylist(t *testing.T) {
+	playlistURL := "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M"
+	spotifyMock := adapterMock{
+		fetchPlaylist: map[string]*Entity{
+			"37i9dQZF1DXcBWIGoYBM5M": {
+				ID:    "37i9dQZF1DXcBWIGoYBM5M",
+				Title: "playlist",
+				Type:  Playlist,
+				Tracks: []*Entity{
+					{ID: "1", Artist: "artist", Title: "first"},
+					{ID: "2", Artist: "artist", Title: "second"},
+					{ID: "3", Artist: "artist", Title: "third"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		url        string
+		target     *Provider
+		appleMock  adapterMock
+		wantFound  int
+		wantTotal  int
+		wantErrs   []error
+		wantErr    error
+		wantTracks []*Entity
+	}{
+		{
+			name:   "tracks converted",
+			url:    playlistURL,
+			target: Apple,
+			appleMock: adapterMock{
+				searchTrack: map[string]map[string]*Entity{
+					"artist": {
+						"first": {ID: "apple1"},
+						"third": {ID: "apple3"},
+					},
+				},
+			},
+			wantFound:  2,
+			wantTotal:  3,
+			wantErrs:   []error{nil, EntityNotFoundError, nil},
+			wantTracks: []*Entity{{ID: "apple1"}, nil, {ID: "apple3"}},
+		},
+		{
+			name:    "not a playlist link",
+			url:     "https://open.spotify.com/album/7uv632EkfwYhXoqf8rhYrg",
+			target:  Apple,
+			wantErr: InvalidEntityTypeError,
+		},
+		{
+			name:    "source playlist not found",
+			url:     "https://open.spotify.com/playlist/notFound",
+			target:  Apple,
+			wantErr: EntityNotFoundError,
+		},
+		{
+			name:    "invalid target provider",
+			url:     playlistURL,
+			target:  &Provider{},
+			wantErr: InvalidProviderError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			registry, err := NewRegistry(
+				ctx,
+				Credentials{},
+				WithTranslator(&translatorMock{}),
+				WithProviderAdapter(Apple, &tt.appleMock),
+				WithProviderAdapter(Spotify, &spotifyMock),
+				WithProviderAdapter(Yandex, &adapterMock{}),
+				WithProviderAdapter(Youtube, &adapterMock{}),
+				WithProviderAdapter(Deezer, &adapterMock{}),
+				WithProviderAdapter(SoundCloud, &adapterMock{}),
+				WithProviderAdapter(Tidal, &adapterMock{}),
+				WithProviderAdapter(Bandcamp, &adapterMock{}),
+				WithProviderAdapter(Amazon, &adapterMock{}),
+				WithProviderAdapter(VK, &adapterMock{}),
+				WithProviderAdapter(Zvuk, &adapterMock{}),
+				WithProviderAdapter(YoutubeMusic, &adapterMock{}),
+			)
+			require.NoError(t, err)
+
+			result, err := registry.ConvertPlaylist(ctx, tt.url, tt.target)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.target, result.Provider)
+			require.Equal(t, tt.wantFound, result.Found())
+			require.Equal(t, tt.wantTotal, result.Total())
+			for i, track := range result.Tracks {
+				require.Equal(t, spotifyMock.fetchPlaylist["37i9dQZF1DXcBWIGoYBM5M"].Tracks[i], track.Source)
+				require.Equal(t, tt.wantTracks[i], track.Entity)
+				if tt.wantErrs[i] != nil {
+					require.ErrorIs(t, track.Err, tt.wantErrs[i])
+				} else {
+					require.NoError(t, track.Err)
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package streamnx
 
 import (
+	"github.com/GeorgeGorbanev/streamnx/internal/amazon"
 	"github.com/GeorgeGorbanev/streamnx/internal/spotify"
+	"github.com/GeorgeGorbanev/streamnx/internal/tidal"
 	"github.com/GeorgeGorbanev/streamnx/internal/translator"
 )
 
@@ -11,6 +13,13 @@ type Credentials struct {
 	YoutubeAPIKey              string
 	SpotifyClientID            string
 	SpotifyClientSecret        string
+	SoundCloudClientID         string
+	TidalClientID              string
+	TidalClientSecret          string
+	AmazonClientID             string
+	AmazonClientSecret         string
+	AmazonAPIKey               string
+	VKAccessToken              string
 }
 
 func (c Credentials) google() *translator.GoogleCredentials {
@@ -26,3 +35,38 @@ func (c Credentials) spotify() *spotify.Credentials {
 		ClientSecret: c.SpotifyClientSecret,
 	}
 }
+
+func (c Credentials) tidal() *tidal.Credentials {
+	return &tidal.Credentials{
+		ClientID:     c.TidalClientID,
+		ClientSecret: c.TidalClientSecret,
+	}
+}
+
+func (c Credentials) amazon() *amazon.Credentials {
+	return &amazon.Credentials{
+		ClientID:     c.AmazonClientID,
+		ClientSecret: c.AmazonClientSecret,
+		APIKey:       c.AmazonAPIKey,
+	}
+}
+
+// provided tells whether the credentials the provider requires are set. Providers needing
+// no credentials are always provided.
+func (c Credentials) provided(p *Provider) bool {
+	switch p {
+	case Spotify:
+		return c.SpotifyClientID != "" && c.SpotifyClientSecret != ""
+	case Youtube, YoutubeMusic:
+		return c.YoutubeAPIKey != ""
+	case SoundCloud:
+		return c.SoundCloudClientID != ""
+	case Tidal:
+		return c.TidalClientID != "" && c.TidalClientSecret != ""
+	case Amazon:
+		return c.AmazonClientID != "" && c.AmazonClientSecret != "" && c.AmazonAPIKey != ""
+	case VK:
+		return c.VKAccessToken != ""
+	}
+	return true
+}
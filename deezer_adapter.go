@@ -0,0 +1,242 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/deezer"
+)
+
+type DeezerAdapter struct {
+	client deezer.Client
+}
+
+func newDeezerAdapter(client deezer.Client) *DeezerAdapter {
+	return &DeezerAdapter{
+		client: client,
+	}
+}
+
+func (a *DeezerAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	track, err := a.client.FetchTrack(ctx, id)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get track from deezer: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *DeezerAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	track, err := a.client.SearchTrack(ctx, artistName, trackName)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track on deezer: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *DeezerAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := a.client.SearchTracks(ctx, artistName, trackName, limit)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search tracks on deezer: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
+func (a *DeezerAdapter) SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error) {
+	track, err := a.client.SearchTrackByISRC(ctx, isrc)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track by isrc on deezer: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *DeezerAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	album, err := a.client.FetchAlbum(ctx, id)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get album from deezer: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *DeezerAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	album, err := a.client.SearchAlbum(ctx, artistName, albumName)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album on deezer: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *DeezerAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := a.client.SearchAlbums(ctx, artistName, albumName, limit)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search albums on deezer: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album))
+	}
+	return candidates, nil
+}
+
+func (a *DeezerAdapter) SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error) {
+	album, err := a.client.SearchAlbumByUPC(ctx, upc)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album by upc on deezer: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *DeezerAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	artist, err := a.client.FetchArtist(ctx, id)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from deezer: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *DeezerAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	artist, err := a.client.SearchArtist(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search artist on deezer: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *DeezerAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.FetchPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, deezer.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from deezer: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist), nil
+}
+
+func (a *DeezerAdapter) adaptTrack(track *deezer.Track) *Entity {
+	entity := Entity{
+		ID:          strconv.Itoa(track.ID),
+		Title:       track.Title,
+		Artist:      track.Artist.Name,
+		URL:         track.URL(),
+		Provider:    Deezer,
+		Type:        Track,
+		ISRC:        track.ISRC,
+		Artists:     a.artistNames(track.Artists()),
+		Duration:    time.Duration(track.Duration) * time.Second,
+		ReleaseDate: track.ReleaseDate,
+		Explicit:    track.ExplicitLyrics,
+		TrackNumber: track.TrackPosition,
+		DiscNumber:  track.DiskNumber,
+	}
+	if track.Album != nil {
+		entity.Album = track.Album.Title
+		entity.Artwork = track.Album.CoverXL
+		if entity.ReleaseDate == "" {
+			entity.ReleaseDate = track.Album.ReleaseDate
+		}
+	}
+	return &entity
+}
+
+func (a *DeezerAdapter) adaptAlbum(album *deezer.Album) *Entity {
+	entity := Entity{
+		ID:          strconv.Itoa(album.ID),
+		Title:       album.Title,
+		URL:         album.URL(),
+		Provider:    Deezer,
+		Type:        Album,
+		UPC:         album.UPC,
+		Artists:     a.artistNames(album.Artists()),
+		ReleaseDate: album.ReleaseDate,
+		Artwork:     album.CoverXL,
+		Explicit:    album.ExplicitLyrics,
+	}
+	if album.Artist != nil {
+		entity.Artist = album.Artist.Name
+	}
+	return &entity
+}
+
+func (a *DeezerAdapter) artistNames(artists []deezer.Artist) []string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return names
+}
+
+func (a *DeezerAdapter) adaptArtist(artist *deezer.Artist) *Entity {
+	return &Entity{
+		ID:       strconv.Itoa(artist.ID),
+		Title:    artist.Name,
+		Artist:   artist.Name,
+		URL:      artist.URL(),
+		Provider: Deezer,
+		Type:     Artist,
+	}
+}
+
+func (a *DeezerAdapter) adaptPlaylist(playlist *deezer.Playlist) *Entity {
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		tracks = append(tracks, a.adaptTrack(track))
+	}
+
+	return &Entity{
+		ID:       strconv.Itoa(playlist.ID),
+		Title:    playlist.Title,
+		Artist:   playlist.Creator.Name,
+		URL:      playlist.URL(),
+		Provider: Deezer,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
+}
@@ -0,0 +1,400 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/deezer"
+
+	"github.com/stretchr/testify/require"
+)
+
+type deezerClientMock struct {
+	fetchTrack    map[string]*deezer.Track
+	fetchAlbum    map[string]*deezer.Album
+	searchTracks  map[string]map[string][]*deezer.Track
+	searchAlbums  map[string]map[string][]*deezer.Album
+	fetchArtist   map[string]*deezer.Artist
+	searchArtist  map[string]*deezer.Artist
+	fetchPlaylist map[string]*deezer.Playlist
+
+	searchTrackByISRC map[string]*deezer.Track
+	searchAlbumByUPC  map[string]*deezer.Album
+}
+
+func (c *deezerClientMock) FetchTrack(_ context.Context, id string) (*deezer.Track, error) {
+	track, ok := c.fetchTrack[id]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *deezerClientMock) SearchTrack(ctx context.Context, artistName, trackName string) (*deezer.Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *deezerClientMock) SearchTracks(_ context.Context, artistName, trackName string, limit int) ([]*deezer.Track, error) {
+	tracks, ok := c.searchTracks[artistName][trackName]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (c *deezerClientMock) SearchTrackByISRC(_ context.Context, isrc string) (*deezer.Track, error) {
+	track, ok := c.searchTrackByISRC[isrc]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *deezerClientMock) FetchAlbum(_ context.Context, id string) (*deezer.Album, error) {
+	album, ok := c.fetchAlbum[id]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *deezerClientMock) SearchAlbum(ctx context.Context, artistName, albumName string) (*deezer.Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *deezerClientMock) SearchAlbums(_ context.Context, artistName, albumName string, limit int) ([]*deezer.Album, error) {
+	albums, ok := c.searchAlbums[artistName][albumName]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *deezerClientMock) SearchAlbumByUPC(_ context.Context, upc string) (*deezer.Album, error) {
+	album, ok := c.searchAlbumByUPC[upc]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *deezerClientMock) FetchArtist(_ context.Context, id string) (*deezer.Artist, error) {
+	artist, ok := c.fetchArtist[id]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *deezerClientMock) SearchArtist(_ context.Context, artistName string) (*deezer.Artist, error) {
+	artist, ok := c.searchArtist[artistName]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *deezerClientMock) FetchPlaylist(_ context.Context, id string) (*deezer.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[id]
+	if !ok {
+		return nil, deezer.NotFoundError
+	}
+	return playlist, nil
+}
+
+func TestDeezerAdapter_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		clientMock    *deezerClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ID",
+			id:   "3135556",
+			clientMock: &deezerClientMock{
+				fetchTrack: map[string]*deezer.Track{
+					"3135556": {
+						ID:             3135556,
+						Title:          "Harder, Better, Faster, Stronger",
+						ISRC:           "GBDUW0000059",
+						Duration:       224,
+						TrackPosition:  4,
+						DiskNumber:     1,
+						ExplicitLyrics: true,
+						Artist:         deezer.Artist{ID: 27, Name: "Daft Punk"},
+						Album: &deezer.Album{
+							ID:          302127,
+							Title:       "Discovery",
+							CoverXL:     "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000.jpg",
+							ReleaseDate: "2001-03-07",
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "3135556",
+				Title:       "Harder, Better, Faster, Stronger",
+				Artist:      "Daft Punk",
+				URL:         "https://www.deezer.com/track/3135556",
+				Provider:    Deezer,
+				Type:        Track,
+				ISRC:        "GBDUW0000059",
+				Artists:     []string{"Daft Punk"},
+				Album:       "Discovery",
+				Duration:    224 * time.Second,
+				ReleaseDate: "2001-03-07",
+				Artwork:     "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000.jpg",
+				Explicit:    true,
+				TrackNumber: 4,
+				DiscNumber:  1,
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "0",
+			clientMock:  &deezerClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newDeezerAdapter(tt.clientMock)
+			result, err := a.FetchTrack(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestDeezerAdapter_SearchTrackCandidates(t *testing.T) {
+	clientMock := &deezerClientMock{
+		searchTracks: map[string]map[string][]*deezer.Track{
+			"Daft Punk": {
+				"One More Time": {
+					{ID: 1, Title: "One More Time (Radio Edit)", Artist: deezer.Artist{Name: "Daft Punk"}},
+					{ID: 2, Title: "One More Time", Artist: deezer.Artist{Name: "Daft Punk"}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newDeezerAdapter(clientMock)
+	result, err := a.SearchTrackCandidates(ctx, "Daft Punk", "One More Time", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:       "1",
+			Title:    "One More Time (Radio Edit)",
+			Artist:   "Daft Punk",
+			URL:      "https://www.deezer.com/track/1",
+			Provider: Deezer,
+			Type:     Track,
+			Artists:  []string{"Daft Punk"},
+		},
+		{
+			ID:       "2",
+			Title:    "One More Time",
+			Artist:   "Daft Punk",
+			URL:      "https://www.deezer.com/track/2",
+			Provider: Deezer,
+			Type:     Track,
+			Artists:  []string{"Daft Punk"},
+		},
+	}, result)
+
+	_, err = a.SearchTrackCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestDeezerAdapter_FetchAlbum(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		clientMock    *deezerClientMock
+		expectedAlbum *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ID",
+			id:   "302127",
+			clientMock: &deezerClientMock{
+				fetchAlbum: map[string]*deezer.Album{
+					"302127": {
+						ID:          302127,
+						Title:       "Discovery",
+						UPC:         "724384960650",
+						CoverXL:     "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000.jpg",
+						ReleaseDate: "2001-03-07",
+						Artist:      &deezer.Artist{ID: 27, Name: "Daft Punk"},
+					},
+				},
+			},
+			expectedAlbum: &Entity{
+				ID:          "302127",
+				Title:       "Discovery",
+				Artist:      "Daft Punk",
+				URL:         "https://www.deezer.com/album/302127",
+				Provider:    Deezer,
+				Type:        Album,
+				UPC:         "724384960650",
+				Artists:     []string{"Daft Punk"},
+				ReleaseDate: "2001-03-07",
+				Artwork:     "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000.jpg",
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "0",
+			clientMock:  &deezerClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newDeezerAdapter(tt.clientMock)
+			result, err := a.FetchAlbum(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAlbum, result)
+			}
+		})
+	}
+}
+
+func TestDeezerAdapter_SearchByIdentifier(t *testing.T) {
+	clientMock := &deezerClientMock{
+		searchTrackByISRC: map[string]*deezer.Track{
+			"GBDUW0000059": {ID: 3135556, Title: "Harder, Better, Faster, Stronger", ISRC: "GBDUW0000059"},
+		},
+		searchAlbumByUPC: map[string]*deezer.Album{
+			"724384960650": {ID: 302127, Title: "Discovery", UPC: "724384960650"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newDeezerAdapter(clientMock)
+
+	track, err := a.SearchTrackByISRC(ctx, "GBDUW0000059")
+	require.NoError(t, err)
+	require.Equal(t, "3135556", track.ID)
+	require.Equal(t, "GBDUW0000059", track.ISRC)
+
+	album, err := a.SearchAlbumByUPC(ctx, "724384960650")
+	require.NoError(t, err)
+	require.Equal(t, "302127", album.ID)
+	require.Equal(t, "724384960650", album.UPC)
+
+	_, err = a.SearchTrackByISRC(ctx, "unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+	_, err = a.SearchAlbumByUPC(ctx, "unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestDeezerAdapter_FetchArtist(t *testing.T) {
+	clientMock := &deezerClientMock{
+		fetchArtist: map[string]*deezer.Artist{
+			"27": {ID: 27, Name: "Daft Punk"},
+		},
+		searchArtist: map[string]*deezer.Artist{
+			"Daft Punk": {ID: 27, Name: "Daft Punk"},
+		},
+	}
+	expected := &Entity{
+		ID:       "27",
+		Title:    "Daft Punk",
+		Artist:   "Daft Punk",
+		URL:      "https://www.deezer.com/artist/27",
+		Provider: Deezer,
+		Type:     Artist,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newDeezerAdapter(clientMock)
+
+	result, err := a.FetchArtist(ctx, "27")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	result, err = a.SearchArtist(ctx, "Daft Punk")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	_, err = a.FetchArtist(ctx, "0")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestDeezerAdapter_FetchPlaylist(t *testing.T) {
+	clientMock := &deezerClientMock{
+		fetchPlaylist: map[string]*deezer.Playlist{
+			"908622995": {
+				ID:      908622995,
+				Title:   "sample playlist",
+				Creator: deezer.Creator{Name: "sample user"},
+				Tracks: []*deezer.Track{
+					{ID: 1, Title: "first", Artist: deezer.Artist{Name: "sample artist"}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newDeezerAdapter(clientMock)
+	result, err := a.FetchPlaylist(ctx, "908622995")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "908622995",
+		Title:    "sample playlist",
+		Artist:   "sample user",
+		URL:      "https://www.deezer.com/playlist/908622995",
+		Provider: Deezer,
+		Type:     Playlist,
+		Tracks: []*Entity{
+			{
+				ID:       "1",
+				Title:    "first",
+				Artist:   "sample artist",
+				URL:      "https://www.deezer.com/track/1",
+				Provider: Deezer,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+	}, result)
+
+	_, err = a.FetchPlaylist(ctx, "0")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
@@ -1,8 +1,18 @@
 package streamnx
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
 const (
-	Track EntityType = "track"
-	Album EntityType = "album"
+	Track    EntityType = "track"
+	Album    EntityType = "album"
+	Artist   EntityType = "artist"
+	Playlist EntityType = "playlist"
 )
 
 type EntityType string
@@ -14,8 +24,118 @@ type Entity struct {
 	URL      string
 	Provider *Provider
 	Type     EntityType
+	Tracks   []*Entity
+	ISRC     string
+	UPC      string
+
+	Artists     []string
+	Album       string
+	Duration    time.Duration
+	ReleaseDate string
+	Artwork     string
+	Explicit    bool
+	TrackNumber int
+	DiscNumber  int
+
+	// Confidence is the score in [0, 1] of entities matched by Registry.Match and conversions.
+	Confidence float64
+}
+
+// clone copies the entity along with its tracks and artists.
+func (e *Entity) clone() *Entity {
+	if e == nil {
+		return nil
+	}
+	c := *e
+	c.Tracks = cloneEntities(e.Tracks)
+	if e.Artists != nil {
+		c.Artists = append([]string{}, e.Artists...)
+	}
+	return &c
+}
+
+func cloneEntities(entities []*Entity) []*Entity {
+	if entities == nil {
+		return nil
+	}
+	clones := make([]*Entity, 0, len(entities))
+	for _, entity := range entities {
+		clones = append(clones, entity.clone())
+	}
+	return clones
+}
+
+// ReleaseYear returns the year of the release date or zero when it is unknown.
+func (e *Entity) ReleaseYear() int {
+	if len(e.ReleaseDate) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(e.ReleaseDate[:4])
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
+// ArtworkURL returns the artwork URL with {w} and {h} placeholders replaced by the given size.
+// Artwork of providers without size templating is returned as is.
+func (e *Entity) ArtworkURL(width, height int) string {
+	replacer := strings.NewReplacer("{w}", strconv.Itoa(width), "{h}", strconv.Itoa(height))
+	return replacer.Replace(e.Artwork)
+}
+
+// DeepLink returns the link opening the entity in the native app of the provider. Entities
+// of providers whose apps have no URI scheme of their own are linked by the web URL.
+func (e *Entity) DeepLink() string {
+	switch e.Provider {
+	case Spotify:
+		return fmt.Sprintf("spotify:%s:%s", e.Type, e.ID)
+	case Apple:
+		return withScheme(e.URL, "music", true)
+	case Yandex:
+		return withScheme(e.URL, "yandexmusic", false)
+	case Youtube:
+		if e.Type == Track {
+			return "vnd.youtube:" + e.ID
+		}
+		return withScheme(e.URL, "youtube", true)
+	case YoutubeMusic:
+		return withScheme(e.URL, "youtubemusic", true)
+	case Deezer:
+		return withScheme(e.URL, "deezer", true)
+	case Tidal:
+		return fmt.Sprintf("tidal://%s/%s", e.Type, e.ID)
+	default:
+		return e.URL
+	}
 }
 
 func entityFullTitle(artist, title string) string {
 	return artist + " – " + title
 }
+
+func firstName(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return names[0]
+}
+
+// withScheme replaces the scheme of the web URL, dropping the host when the app expects
+// the path right after the scheme.
+func withScheme(rawURL, scheme string, keepHost bool) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	if keepHost {
+		u.Scheme = scheme
+		return u.String()
+	}
+
+	link := scheme + "://" + strings.TrimPrefix(u.Path, "/")
+	if u.RawQuery != "" {
+		link += "?" + u.RawQuery
+	}
+	return link
+}
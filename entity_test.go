@@ -0,0 +1,140 @@
+package streamnx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntity_ReleaseYear(t *testing.T) {
+	tests := []struct {
+		name        string
+		releaseDate string
+		want        int
+	}{
+		{name: "full date", releaseDate: "2017-06-23", want: 2017},
+		{name: "month precision", releaseDate: "1981-12", want: 1981},
+		{name: "year precision", releaseDate: "1981", want: 1981},
+		{name: "empty", releaseDate: "", want: 0},
+		{name: "invalid", releaseDate: "unknown", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entity := Entity{ReleaseDate: tt.releaseDate}
+			require.Equal(t, tt.want, entity.ReleaseYear())
+		})
+	}
+}
+
+func TestEntity_ArtworkURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		artwork string
+		want    string
+	}{
+		{
+			name:    "apple template",
+			artwork: "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/{w}x{h}bb.jpg",
+			want:    "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/600x400bb.jpg",
+		},
+		{
+			name:    "yandex template",
+			artwork: "https://avatars.yandex.net/get-music-content/sample/{w}x{h}",
+			want:    "https://avatars.yandex.net/get-music-content/sample/600x400",
+		},
+		{
+			name:    "fixed size artwork",
+			artwork: "https://i.scdn.co/image/sample",
+			want:    "https://i.scdn.co/image/sample",
+		},
+		{
+			name:    "no artwork",
+			artwork: "",
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entity := Entity{Artwork: tt.artwork}
+			require.Equal(t, tt.want, entity.ArtworkURL(600, 400))
+		})
+	}
+}
+
+func TestEntity_DeepLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		entity Entity
+		want   string
+	}{
+		{
+			name:   "Spotify track",
+			entity: Entity{ID: "7uv632EkfwYhXoqf8rhYrg", Type: Track, Provider: Spotify},
+			want:   "spotify:track:7uv632EkfwYhXoqf8rhYrg",
+		},
+		{
+			name: "Apple album",
+			entity: Entity{
+				ID:       "us-1234567890",
+				Type:     Album,
+				Provider: Apple,
+				URL:      "https://music.apple.com/us/album/album-name/1234567890",
+			},
+			want: "music://music.apple.com/us/album/album-name/1234567890",
+		},
+		{
+			name: "Yandex track",
+			entity: Entity{
+				ID:       "1197793",
+				Type:     Track,
+				Provider: Yandex,
+				URL:      "https://music.yandex.com/album/3192570/track/1197793",
+			},
+			want: "yandexmusic://album/3192570/track/1197793",
+		},
+		{
+			name:   "Youtube track",
+			entity: Entity{ID: "dQw4w9WgXcQ", Type: Track, Provider: Youtube, URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ"},
+			want:   "vnd.youtube:dQw4w9WgXcQ",
+		},
+		{
+			name: "Youtube album",
+			entity: Entity{
+				ID:       "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+				Type:     Album,
+				Provider: Youtube,
+				URL:      "https://www.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			},
+			want: "youtube://www.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+		},
+		{
+			name:   "Deezer artist",
+			entity: Entity{ID: "27", Type: Artist, Provider: Deezer, URL: "https://www.deezer.com/artist/27"},
+			want:   "deezer://www.deezer.com/artist/27",
+		},
+		{
+			name:   "Tidal album",
+			entity: Entity{ID: "77646164", Type: Album, Provider: Tidal, URL: "https://tidal.com/browse/album/77646164"},
+			want:   "tidal://album/77646164",
+		},
+		{
+			name:   "provider without app scheme",
+			entity: Entity{ID: "125683251", Type: Track, Provider: Zvuk, URL: "https://zvuk.com/track/125683251"},
+			want:   "https://zvuk.com/track/125683251",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deepLink := tt.entity.DeepLink()
+			require.Equal(t, tt.want, deepLink)
+
+			link, err := ParseLink(deepLink)
+			require.NoError(t, err)
+			require.Equal(t, tt.entity.Provider, link.Provider)
+			require.Equal(t, tt.entity.Type, link.EntityType)
+		})
+	}
+}
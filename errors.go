@@ -0,0 +1,51 @@
+package streamnx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+)
+
+var (
+	RateLimitedError         = apierr.RateLimitedError
+	UnauthorizedError        = apierr.UnauthorizedError
+	UpstreamUnavailableError = apierr.UpstreamUnavailableError
+	RegionRestrictedError    = apierr.RegionRestrictedError
+	MalformedResponseError   = apierr.MalformedResponseError
+)
+
+// ProviderError is returned by the Registry when a provider request fails for any reason other
+// than the entity not being found. It wraps the classified cause, so errors.Is works with the
+// sentinel errors above, and StatusCode is zero when no HTTP response was received.
+type ProviderError struct {
+	Provider   *Provider
+	StatusCode int
+	Err        error
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Provider.Name(), e.Err)
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+func providerError(p *Provider, err error) error {
+	if err == nil || errors.Is(err, EntityNotFoundError) || errors.Is(err, InvalidEntityTypeError) {
+		return err
+	}
+
+	var pe *ProviderError
+	if errors.As(err, &pe) {
+		return err
+	}
+
+	pe = &ProviderError{Provider: p, Err: err}
+	var se *apierr.StatusError
+	if errors.As(err, &se) {
+		pe.StatusCode = se.StatusCode
+	}
+	return pe
+}
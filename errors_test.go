@@ -0,0 +1,97 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_ProviderError(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseCode   int
+		responseBody   string
+		wantErr        error
+		wantStatusCode int
+	}{
+		{
+			name:           "quota exceeded",
+			responseCode:   http.StatusForbidden,
+			responseBody:   `{"error": {"code": 403, "errors": [{"reason": "quotaExceeded"}]}}`,
+			wantErr:        RateLimitedError,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "invalid api key",
+			responseCode:   http.StatusBadRequest,
+			responseBody:   `{"error": {"code": 400, "errors": [{"reason": "keyInvalid"}]}}`,
+			wantErr:        UnauthorizedError,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "upstream unavailable",
+			responseCode:   http.StatusServiceUnavailable,
+			responseBody:   `backend error`,
+			wantErr:        UpstreamUnavailableError,
+			wantStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "malformed response",
+			responseCode: http.StatusOK,
+			responseBody: `{"items": [`,
+			wantErr:      MalformedResponseError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.responseCode)
+				_, err := w.Write([]byte(tt.responseBody))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			ctx := context.Background()
+			registry, err := NewRegistry(
+				ctx,
+				Credentials{},
+				WithTranslator(&translatorMock{}),
+				WithYoutubeAPIURL(apiServerMock.URL),
+				WithRetryDisabled(),
+			)
+			require.NoError(t, err)
+
+			_, err = registry.Fetch(ctx, Youtube, Track, "sampleID")
+			require.ErrorIs(t, err, tt.wantErr)
+
+			pe := &ProviderError{}
+			require.True(t, errors.As(err, &pe))
+			require.Equal(t, Youtube, pe.Provider)
+			require.Equal(t, tt.wantStatusCode, pe.StatusCode)
+		})
+	}
+}
+
+func TestRegistry_ProviderErrorNotFound(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer apiServerMock.Close()
+
+	ctx := context.Background()
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithYoutubeAPIURL(apiServerMock.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = registry.Fetch(ctx, Youtube, Track, "sampleID")
+	require.ErrorIs(t, err, EntityNotFoundError)
+	require.False(t, errors.As(err, new(*ProviderError)))
+}
@@ -0,0 +1,74 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/translator"
+)
+
+// Backends answering the translations of artist names, as counted by Health.TranslationAnswers.
+const (
+	DictionaryBackend = "dictionary"
+	TranslatorBackend = "translator"
+	CacheBackend      = translator.CacheBackend
+)
+
+var TranslatorNotConfiguredError = errors.New("translator is not configured")
+
+// Health describes which capabilities of the registry are available.
+type Health struct {
+	EnabledProviders  []*Provider
+	DisabledProviders []*Provider
+
+	// Translation is nil when Cyrillic artist names are matched by translation, otherwise it is
+	// the reason the matching falls back to transliteration only.
+	Translation error
+
+	// TranslationAnswers counts the artist names translated by every backend.
+	TranslationAnswers map[string]int
+
+	// QuotaExhausted lists the providers failing with QuotaExceededError until the quota is reset.
+	QuotaExhausted []*Provider
+}
+
+// Degraded reports whether any capability of the enabled providers is limited.
+func (h *Health) Degraded() bool {
+	return h.Translation != nil || len(h.QuotaExhausted) > 0
+}
+
+// Health checks the capabilities of the registry. A lazily constructed translator
+// is constructed by the check.
+func (r *Registry) Health(ctx context.Context) *Health {
+	health := Health{
+		EnabledProviders:   r.EnabledProviders(),
+		DisabledProviders:  []*Provider{},
+		QuotaExhausted:     []*Provider{},
+		Translation:        r.checkTranslator(ctx),
+		TranslationAnswers: map[string]int{},
+	}
+	if r.translations != nil {
+		health.TranslationAnswers = r.translations.Answers()
+	}
+	for _, provider := range r.Providers() {
+		if r.disabled[provider.сode] {
+			health.DisabledProviders = append(health.DisabledProviders, provider)
+		}
+	}
+	for _, provider := range health.EnabledProviders {
+		if status, ok := r.Quota(provider); ok && status.Remaining <= 0 {
+			health.QuotaExhausted = append(health.QuotaExhausted, provider)
+		}
+	}
+	return &health
+}
+
+func (r *Registry) checkTranslator(ctx context.Context) error {
+	if r.translator == nil {
+		return TranslatorNotConfiguredError
+	}
+	if checker, ok := r.translator.(interface{ Check(context.Context) error }); ok {
+		return checker.Check(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_Health(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without translator credentials", func(t *testing.T) {
+		registry, err := NewRegistry(ctx, Credentials{})
+		require.NoError(t, err)
+
+		health := registry.Health(ctx)
+		require.ErrorIs(t, health.Translation, TranslatorNotConfiguredError)
+		require.True(t, health.Degraded())
+		require.Equal(t, Providers, health.EnabledProviders)
+		require.Empty(t, health.DisabledProviders)
+		require.NoError(t, registry.Close())
+	})
+
+	t.Run("with invalid translator credentials", func(t *testing.T) {
+		registry, err := NewRegistry(ctx, Credentials{GoogleTranslatorAPIKeyJSON: "{"})
+		require.NoError(t, err)
+
+		// Names are matched by transliteration, the failure is reported by Health only.
+		m := registry.adapters[Yandex.сode].(*YandexAdapter).matcher
+		result, err := m.match(ctx, "Земфира", "Zemfira")
+		require.NoError(t, err)
+		require.True(t, result)
+		result, err = m.match(ctx, "Сэмпл Бэнд", "Sample Band")
+		require.NoError(t, err)
+		require.False(t, result)
+
+		health := registry.Health(ctx)
+		require.Error(t, health.Translation)
+		require.NotErrorIs(t, health.Translation, TranslatorNotConfiguredError)
+		require.True(t, health.Degraded())
+	})
+
+	t.Run("with translator", func(t *testing.T) {
+		registry, err := NewRegistry(
+			ctx,
+			Credentials{},
+			WithTranslator(&translatorMock{}),
+			WithProvidersDisabled(Amazon),
+		)
+		require.NoError(t, err)
+
+		health := registry.Health(ctx)
+		require.NoError(t, health.Translation)
+		require.False(t, health.Degraded())
+		require.Equal(t, []*Provider{Amazon}, health.DisabledProviders)
+		require.NotContains(t, health.EnabledProviders, Amazon)
+	})
+
+	t.Run("translation answers", func(t *testing.T) {
+		translator := &translatorMock{enToRu: map[string]string{"sample band": "сэмпл бэнд"}}
+		for _, tt := range []struct {
+			name string
+			opts []RegistryOption
+			want map[string]int
+		}{
+			{
+				name: "cached",
+				opts: []RegistryOption{WithTranslator(translator)},
+				want: map[string]int{DictionaryBackend: 1, TranslatorBackend: 1, CacheBackend: 1},
+			},
+			{
+				name: "without cache",
+				opts: []RegistryOption{WithTranslator(translator), WithTranslationCache(0, 0)},
+				want: map[string]int{DictionaryBackend: 1, TranslatorBackend: 2},
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				registry, err := NewRegistry(ctx, Credentials{}, tt.opts...)
+				require.NoError(t, err)
+				require.Empty(t, registry.Health(ctx).TranslationAnswers)
+
+				matcher := registry.adapters[Yandex.сode].(*YandexAdapter).matcher
+				for _, query := range []string{"Leningrad Cord", "Sample Band", "Sample Band"} {
+					_, err := matcher.match(ctx, "Сэмпл Бэнд", query)
+					require.NoError(t, err)
+				}
+				require.Equal(t, tt.want, registry.Health(ctx).TranslationAnswers)
+			})
+		}
+	})
+
+	t.Run("translator turned off", func(t *testing.T) {
+		registry, err := NewRegistry(ctx, Credentials{}, WithTranslator(&translatorMock{}), WithoutTranslator())
+		require.NoError(t, err)
+		require.ErrorIs(t, registry.Health(ctx).Translation, TranslatorNotConfiguredError)
+	})
+
+	t.Run("quota exhausted", func(t *testing.T) {
+		registry, err := NewRegistry(
+			ctx,
+			Credentials{},
+			WithTranslator(&translatorMock{}),
+			WithQuota(Youtube, 0, youtubeQuotaCosts, nil),
+		)
+		require.NoError(t, err)
+
+		health := registry.Health(ctx)
+		require.True(t, health.Degraded())
+		require.Equal(t, []*Provider{Youtube, YoutubeMusic}, health.QuotaExhausted)
+	})
+}
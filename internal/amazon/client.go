@@ -0,0 +1,349 @@
+package amazon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	defaultAuthURL = "https://api.amazon.com"
+	defaultAPIURL  = "https://api.music.amazon.dev"
+
+	countryHeader = "x-amz-music-country"
+	apiKeyHeader  = "x-api-key"
+
+	playlistTracksPageLimit = 100
+)
+
+var NotFoundError = errors.New("not found")
+
+type Client interface {
+	FetchTrack(ctx context.Context, asin, region string) (*Track, error)
+	SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error)
+	SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error)
+	SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error)
+	FetchAlbum(ctx context.Context, asin, region string) (*Album, error)
+	SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error)
+	SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error)
+	SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error)
+	FetchArtist(ctx context.Context, asin, region string) (*Artist, error)
+	SearchArtist(ctx context.Context, artistName string) (*Artist, error)
+	FetchPlaylist(ctx context.Context, asin, region string) (*Playlist, error)
+}
+
+// HTTPClient searches the catalog of the default region while fetches are made in the region
+// of the link, since availability of ASINs differs between regions.
+type HTTPClient struct {
+	authURL     string
+	apiURL      string
+	httpClient  *http.Client
+	credentials *Credentials
+	tokenMu     sync.Mutex
+	token       *token
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
+}
+
+type itemResponse[T any] struct {
+	Data *T `json:"data"`
+}
+
+type itemsResponse[T any] struct {
+	Data   []*T   `json:"data"`
+	Cursor string `json:"cursor"`
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+func NewHTTPClient(credentials *Credentials, opts ...ClientOption) *HTTPClient {
+	c := HTTPClient{
+		authURL:     defaultAuthURL,
+		apiURL:      defaultAPIURL,
+		credentials: credentials,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
+
+	return &c
+}
+
+func (c *HTTPClient) FetchTrack(ctx context.Context, asin, region string) (*Track, error) {
+	return fetchItem[Track](ctx, c, "/v1/tracks/"+url.PathEscape(asin), region)
+}
+
+func (c *HTTPClient) SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error) {
+	return searchItems[Track](ctx, c, "/v1/search/tracks", url.Values{
+		"keywords": []string{searchQuery(artistName, trackName)},
+		"limit":    []string{strconv.Itoa(limit)},
+	})
+}
+
+func (c *HTTPClient) SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error) {
+	tracks, err := searchItems[Track](ctx, c, "/v1/tracks", url.Values{"isrc": []string{isrc}})
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) FetchAlbum(ctx context.Context, asin, region string) (*Album, error) {
+	return fetchItem[Album](ctx, c, "/v1/albums/"+url.PathEscape(asin), region)
+}
+
+func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error) {
+	return searchItems[Album](ctx, c, "/v1/search/albums", url.Values{
+		"keywords": []string{searchQuery(artistName, albumName)},
+		"limit":    []string{strconv.Itoa(limit)},
+	})
+}
+
+func (c *HTTPClient) SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error) {
+	albums, err := searchItems[Album](ctx, c, "/v1/albums", url.Values{"upc": []string{upc}})
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) FetchArtist(ctx context.Context, asin, region string) (*Artist, error) {
+	return fetchItem[Artist](ctx, c, "/v1/artists/"+url.PathEscape(asin), region)
+}
+
+func (c *HTTPClient) SearchArtist(ctx context.Context, artistName string) (*Artist, error) {
+	artists, err := searchItems[Artist](ctx, c, "/v1/search/artists", url.Values{
+		"keywords": []string{artistName},
+		"limit":    []string{"1"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return artists[0], nil
+}
+
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, asin, region string) (*Playlist, error) {
+	path := "/v1/playlists/" + url.PathEscape(asin)
+	playlist, err := fetchItem[Playlist](ctx, c, path, region)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := ""
+	for {
+		query := url.Values{"limit": []string{strconv.Itoa(playlistTracksPageLimit)}}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		body, err := c.getAPI(ctx, path+"/tracks", query, region)
+		if err != nil {
+			return nil, err
+		}
+
+		page := itemsResponse[Track]{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+		}
+		playlist.Tracks = append(playlist.Tracks, page.Data...)
+		if page.Cursor == "" || len(page.Data) == 0 {
+			break
+		}
+		cursor = page.Cursor
+	}
+
+	return playlist, nil
+}
+
+func fetchItem[T any](ctx context.Context, c *HTTPClient, path, region string) (*T, error) {
+	body, err := c.getAPI(ctx, path, nil, region)
+	if err != nil {
+		return nil, err
+	}
+
+	response := itemResponse[T]{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if response.Data == nil {
+		return nil, NotFoundError
+	}
+	return response.Data, nil
+}
+
+func searchItems[T any](ctx context.Context, c *HTTPClient, path string, query url.Values) ([]*T, error) {
+	body, err := c.getAPI(ctx, path, query, DefaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	response := itemsResponse[T]{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(response.Data) == 0 {
+		return nil, NotFoundError
+	}
+	return response.Data, nil
+}
+
+func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values, region string) ([]byte, error) {
+	u := fmt.Sprintf("%s%s?%s", c.apiURL, path, query.Encode())
+	resp, err := c.requestWithToken(ctx, u, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		c.resetToken(resp.Request.Header.Get("Authorization"))
+		resp, err = c.requestWithToken(ctx, u, region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+		defer resp.Body.Close()
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, NotFoundError
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		er := errorResponse{}
+		if err := json.Unmarshal(body, &er); err != nil || er.Message == "" {
+			return nil, apierr.FromStatus(resp.StatusCode)
+		}
+		return nil, fmt.Errorf("unexpected API response: %s: %w", er.Message, apierr.FromStatus(resp.StatusCode))
+	}
+
+	return body, nil
+}
+
+func (c *HTTPClient) fetchToken(ctx context.Context) (*token, error) {
+	url := fmt.Sprintf("%s/auth/o2/token", c.authURL)
+	form := strings.NewReader(c.credentials.tokenForm().Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, form)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		return nil, &apierr.StatusError{StatusCode: resp.StatusCode, Err: apierr.UnauthorizedError}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	result := token{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	result.fetchedAt = time.Now()
+	return &result, nil
+}
+
+func (c *HTTPClient) requestWithToken(ctx context.Context, url, region string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	authHeader, err := c.authHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token: %w", err)
+	}
+	req.Header.Set("Authorization", authHeader)
+	req.Header.Set(apiKeyHeader, c.credentials.APIKey)
+	req.Header.Set(countryHeader, strings.ToUpper(region))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	return resp, nil
+}
+
+func searchQuery(artistName, name string) string {
+	return strings.TrimSpace(artistName + " " + name)
+}
+
+// authHeader returns the authorization header of the cached token, fetching a new token
+// when there is none or it is expired. Concurrent requests wait for a single fetch.
+func (c *HTTPClient) authHeader(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == nil || c.token.isExpired() {
+		t, err := c.fetchToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.token = t
+	}
+	return c.token.authHeader(), nil
+}
+
+// resetToken drops the cached token rejected with the authorization header, unless another
+// request has replaced it already.
+func (c *HTTPClient) resetToken(authHeader string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != nil && c.token.authHeader() == authHeader {
+		c.token = nil
+	}
+}
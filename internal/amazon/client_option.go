@@ -0,0 +1,42 @@
+package amazon
+
+import (
+	"net/http"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+type ClientOption func(client *HTTPClient)
+
+func WithAuthURL(url string) ClientOption {
+	return func(client *HTTPClient) {
+		client.authURL = url
+	}
+}
+
+func WithAPIURL(url string) ClientOption {
+	return func(client *HTTPClient) {
+		client.apiURL = url
+	}
+}
+
+func WithHTTPTransport(transport *http.Transport) ClientOption {
+	return func(client *HTTPClient) {
+		client.httpClient.Transport = transport
+	}
+}
+
+func WithRetryPolicy(policy retry.Policy) ClientOption {
+	return func(client *HTTPClient) {
+		client.retryPolicy = policy
+	}
+}
+
+// WithThrottle limits the request rate and charges the quota before every request; both are optional.
+func WithThrottle(limiter *throttle.Limiter, quota *throttle.Quota) ClientOption {
+	return func(client *HTTPClient) {
+		client.limiter = limiter
+		client.quota = quota
+	}
+}
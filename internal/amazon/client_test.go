@@ -0,0 +1,347 @@
+package amazon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sampleCredentials = Credentials{
+		ClientID:     "sampleClientID",
+		ClientSecret: "sampleClientSecret",
+		APIKey:       "sampleAPIKey",
+	}
+	sampleToken = token{
+		AccessToken: "mock_access_token",
+		TokenType:   "bearer",
+		ExpiresIn:   3600,
+	}
+)
+
+func TestHTTPClient_FetchTrack(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "Bearer mock_access_token", r.Header.Get("Authorization"))
+		require.Equal(t, "sampleAPIKey", r.Header.Get("x-api-key"))
+		require.Equal(t, "GB", r.Header.Get("x-amz-music-country"))
+		require.Equal(t, "/v1/tracks/B0BDHX1B2S", r.URL.Path)
+		_, err := w.Write([]byte(`{
+			"data": {
+				"id": "B0BDHX1B2S",
+				"title": "Anti-Hero",
+				"isrc": "USUG12205736",
+				"duration": 200,
+				"explicit": false,
+				"artists": [{"id": "B000QJPTJ2", "name": "Taylor Swift"}],
+				"album": {
+					"id": "B0BDHWDR3R",
+					"title": "Midnights",
+					"releaseDate": "2022-10-21",
+					"images": [{"url": "https://m.media-amazon.com/images/I/sample.jpg", "width": 1000, "height": 1000}]
+				}
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "B0BDHX1B2S", "gb")
+	require.NoError(t, err)
+	require.Equal(t, &Track{
+		ID:       "B0BDHX1B2S",
+		Title:    "Anti-Hero",
+		ISRC:     "USUG12205736",
+		Duration: 200,
+		Artists:  []Artist{{ID: "B000QJPTJ2", Name: "Taylor Swift"}},
+		Album: &Album{
+			ID:          "B0BDHWDR3R",
+			Title:       "Midnights",
+			ReleaseDate: "2022-10-21",
+			Images:      []Image{{URL: "https://m.media-amazon.com/images/I/sample.jpg", Width: 1000, Height: 1000}},
+		},
+	}, track)
+}
+
+func TestHTTPClient_FetchTrackNotFound(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "B000000000", "us")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, track)
+}
+
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/search/tracks", r.URL.Path)
+		require.Equal(t, "Taylor Swift Anti-Hero", r.URL.Query().Get("keywords"))
+		require.Equal(t, "2", r.URL.Query().Get("limit"))
+		require.Equal(t, "US", r.Header.Get("x-amz-music-country"))
+		_, err := w.Write([]byte(`{"data": [
+			{"id": "B0BDHX1B2S", "title": "Anti-Hero", "artists": [{"name": "Taylor Swift"}]},
+			{"id": "B0BGZ4X6K8", "title": "Anti-Hero (Acoustic)", "artists": [{"name": "Taylor Swift"}]}
+		]}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tracks, err := client.SearchTracks(ctx, "Taylor Swift", "Anti-Hero", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Track{
+		{ID: "B0BDHX1B2S", Title: "Anti-Hero", Artists: []Artist{{Name: "Taylor Swift"}}},
+		{ID: "B0BGZ4X6K8", Title: "Anti-Hero (Acoustic)", Artists: []Artist{{Name: "Taylor Swift"}}},
+	}, tracks)
+}
+
+func TestHTTPClient_SearchByIdentifier(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.URL.Path {
+		case "/v1/tracks":
+			require.Equal(t, "USUG12205736", r.URL.Query().Get("isrc"))
+			_, err = w.Write([]byte(`{"data": [{"id": "B0BDHX1B2S", "isrc": "USUG12205736"}]}`))
+		case "/v1/albums":
+			require.Equal(t, "00602448438034", r.URL.Query().Get("upc"))
+			_, err = w.Write([]byte(`{"data": []}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.SearchTrackByISRC(ctx, "USUG12205736")
+	require.NoError(t, err)
+	require.Equal(t, &Track{ID: "B0BDHX1B2S", ISRC: "USUG12205736"}, track)
+
+	album, err := client.SearchAlbumByUPC(ctx, "00602448438034")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, album)
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "DE", r.Header.Get("x-amz-music-country"))
+
+		var err error
+		switch r.URL.Path {
+		case "/v1/playlists/B07QNZ6J4K":
+			_, err = w.Write([]byte(`{"data": {"id": "B07QNZ6J4K", "title": "Sample Playlist", "curator": "Amazon Music"}}`))
+		case "/v1/playlists/B07QNZ6J4K/tracks":
+			if r.URL.Query().Get("cursor") == "" {
+				_, err = w.Write([]byte(`{"data": [{"id": "B0BDHX1B2S", "title": "first"}], "cursor": "next"}`))
+			} else {
+				_, err = w.Write([]byte(`{"data": [{"id": "B0BGZ4X6K8", "title": "second"}]}`))
+			}
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	playlist, err := client.FetchPlaylist(ctx, "B07QNZ6J4K", "de")
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{
+		ID:      "B07QNZ6J4K",
+		Title:   "Sample Playlist",
+		Curator: "Amazon Music",
+		Tracks: []*Track{
+			{ID: "B0BDHX1B2S", Title: "first"},
+			{ID: "B0BGZ4X6K8", Title: "second"},
+		},
+	}, playlist)
+}
+
+func TestHTTPClient_RefreshTokenWhenUnauthorized(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer revoked_token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.Equal(t, "Bearer mock_access_token", r.Header.Get("Authorization"))
+		_, err := w.Write([]byte(`{"data": {"id": "B000QJPTJ2", "name": "Taylor Swift"}}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+	client.token = &token{
+		fetchedAt:   time.Now(),
+		ExpiresIn:   3600,
+		AccessToken: "revoked_token",
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.FetchArtist(ctx, "B000QJPTJ2", "us")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{ID: "B000QJPTJ2", Name: "Taylor Swift"}, artist)
+}
+
+func TestHTTPClient_ConcurrentRequests(t *testing.T) {
+	var fetched atomic.Int32
+	mockAuthServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token_%d", fetched.Add(1)),
+			"token_type":   "bearer",
+			"expires_in":   3600,
+		})
+		require.NoError(t, err)
+	}))
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer token_1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(`{"data": {"id": "B000QJPTJ2", "name": "Taylor Swift"}}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.FetchArtist(ctx, "B000QJPTJ2", "us")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), fetched.Load())
+}
+
+func TestHTTPClient_InvalidCredentials(t *testing.T) {
+	mockAuthServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(`{"error": "invalid_client"}`))
+		require.NoError(t, err)
+	}))
+	defer mockAuthServer.Close()
+
+	client := NewHTTPClient(&sampleCredentials, WithAuthURL(mockAuthServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "B0BDHX1B2S", "us")
+	require.ErrorIs(t, err, apierr.UnauthorizedError)
+	require.Nil(t, track)
+}
+
+func newAuthServerMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/auth/o2/token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Equal(t, "sampleClientID", r.PostForm.Get("client_id"))
+		require.Equal(t, "sampleClientSecret", r.PostForm.Get("client_secret"))
+
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"access_token": sampleToken.AccessToken,
+			"token_type":   sampleToken.TokenType,
+			"expires_in":   sampleToken.ExpiresIn,
+		})
+		require.NoError(t, err)
+	}))
+}
@@ -0,0 +1,83 @@
+package amazon
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+const (
+	delimiter = "-"
+)
+
+var (
+	compositeKeyRe = regexp.MustCompile(
+		fmt.Sprintf(`^([a-z]{2})%s([A-Z0-9]{10})$`, delimiter),
+	)
+	CompositeKeyError = errors.New("invalid composite key")
+)
+
+// CompositeKey is the ASIN with the region of the storefront it was linked from,
+// since the catalog availability differs between regions.
+type CompositeKey struct {
+	ASIN   string
+	Region string
+}
+
+func (k *CompositeKey) ParseFromTrackURL(url string) error {
+	if matches := AlbumTrackRe.FindStringSubmatch(url); len(matches) == 3 {
+		return k.set(matches[1], matches[2])
+	}
+	if matches := TrackRe.FindStringSubmatch(url); len(matches) == 3 {
+		return k.set(matches[1], matches[2])
+	}
+	return fmt.Errorf("%w (not valid url)", CompositeKeyError)
+}
+
+func (k *CompositeKey) ParseFromAlbumURL(url string) error {
+	if AlbumTrackRe.MatchString(url) {
+		return fmt.Errorf("%w (track url)", CompositeKeyError)
+	}
+	return k.parse(AlbumRe, url)
+}
+
+func (k *CompositeKey) ParseFromArtistURL(url string) error {
+	return k.parse(ArtistRe, url)
+}
+
+func (k *CompositeKey) ParseFromPlaylistURL(url string) error {
+	return k.parse(PlaylistRe, url)
+}
+
+func (k *CompositeKey) Marshal() string {
+	return k.Region + delimiter + k.ASIN
+}
+
+func (k *CompositeKey) Unmarshal(s string) error {
+	matches := compositeKeyRe.FindStringSubmatch(s)
+	if len(matches) < 3 || !IsValidRegion(matches[1]) {
+		return fmt.Errorf("%w: %s", CompositeKeyError, s)
+	}
+
+	k.Region = matches[1]
+	k.ASIN = matches[2]
+	return nil
+}
+
+func (k *CompositeKey) parse(re *regexp.Regexp, url string) error {
+	matches := re.FindStringSubmatch(url)
+	if len(matches) != 3 {
+		return fmt.Errorf("%w (not valid url)", CompositeKeyError)
+	}
+	return k.set(matches[1], matches[2])
+}
+
+func (k *CompositeKey) set(zone, asin string) error {
+	region := regionByDomainZone(zone)
+	if region == "" {
+		return fmt.Errorf("%w (unknown domain zone)", CompositeKeyError)
+	}
+	k.Region = region
+	k.ASIN = asin
+	return nil
+}
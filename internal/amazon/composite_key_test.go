@@ -0,0 +1,49 @@
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompositeKey_Marshal(t *testing.T) {
+	ck := CompositeKey{ASIN: "B0BDHX1B2S", Region: "gb"}
+	require.Equal(t, "gb-B0BDHX1B2S", ck.Marshal())
+}
+
+func TestCompositeKey_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    CompositeKey
+		expectedErr bool
+	}{
+		{
+			name:     "valid key",
+			input:    "jp-B0BDHX1B2S",
+			expected: CompositeKey{ASIN: "B0BDHX1B2S", Region: "jp"},
+		},
+		{
+			name:        "unknown region",
+			input:       "xx-B0BDHX1B2S",
+			expectedErr: true,
+		},
+		{
+			name:        "missing region",
+			input:       "B0BDHX1B2S",
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ck := CompositeKey{}
+			err := ck.Unmarshal(tt.input)
+			if tt.expectedErr {
+				require.ErrorIs(t, err, CompositeKeyError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ck)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package amazon
+
+import (
+	"net/url"
+)
+
+// Credentials are the Login with Amazon client of the security profile and its API key.
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+	APIKey       string
+}
+
+func (c *Credentials) tokenForm() url.Values {
+	return url.Values{
+		"grant_type":    []string{"client_credentials"},
+		"client_id":     []string{c.ClientID},
+		"client_secret": []string{c.ClientSecret},
+	}
+}
@@ -0,0 +1,19 @@
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentials_tokenForm(t *testing.T) {
+	credentials := &Credentials{
+		ClientID:     "testID",
+		ClientSecret: "testSecret",
+		APIKey:       "testKey",
+	}
+
+	result := credentials.tokenForm()
+
+	require.Equal(t, "client_id=testID&client_secret=testSecret&grant_type=client_credentials", result.Encode())
+}
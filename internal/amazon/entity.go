@@ -0,0 +1,131 @@
+package amazon
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const asinRe = `([A-Z0-9]{10})`
+
+var (
+	TrackRe = regexp.MustCompile(
+		fmt.Sprintf(`https://music\.amazon\.(%s)/tracks/%s`, allDomainZonesRe(), asinRe),
+	)
+	AlbumTrackRe = regexp.MustCompile(
+		fmt.Sprintf(`https://music\.amazon\.(%s)/albums/[A-Z0-9]{10}\S*[?&]trackAsin=%s`, allDomainZonesRe(), asinRe),
+	)
+	AlbumRe = regexp.MustCompile(
+		fmt.Sprintf(`https://music\.amazon\.(%s)/albums/%s`, allDomainZonesRe(), asinRe),
+	)
+	ArtistRe = regexp.MustCompile(
+		fmt.Sprintf(`https://music\.amazon\.(%s)/artists/%s`, allDomainZonesRe(), asinRe),
+	)
+	PlaylistRe = regexp.MustCompile(
+		fmt.Sprintf(`https://music\.amazon\.(%s)/playlists/%s`, allDomainZonesRe(), asinRe),
+	)
+)
+
+type Track struct {
+	ID       string   `json:"id"`
+	Title    string   `json:"title"`
+	ISRC     string   `json:"isrc"`
+	Duration int      `json:"duration"`
+	Explicit bool     `json:"explicit"`
+	Artists  []Artist `json:"artists"`
+	Album    *Album   `json:"album"`
+}
+
+type Album struct {
+	ID          string   `json:"id"`
+	Title       string   `json:"title"`
+	UPC         string   `json:"upc"`
+	ReleaseDate string   `json:"releaseDate"`
+	Explicit    bool     `json:"explicit"`
+	Images      []Image  `json:"images"`
+	Artists     []Artist `json:"artists"`
+}
+
+type Artist struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Image struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type Playlist struct {
+	ID      string   `json:"id"`
+	Title   string   `json:"title"`
+	Curator string   `json:"curator"`
+	Tracks  []*Track `json:"-"`
+}
+
+func DetectTrackID(trackURL string) string {
+	ck := CompositeKey{}
+	if err := ck.ParseFromTrackURL(trackURL); err != nil {
+		return ""
+	}
+	return ck.Marshal()
+}
+
+func DetectAlbumID(albumURL string) string {
+	ck := CompositeKey{}
+	if err := ck.ParseFromAlbumURL(albumURL); err != nil {
+		return ""
+	}
+	return ck.Marshal()
+}
+
+func DetectArtistID(artistURL string) string {
+	ck := CompositeKey{}
+	if err := ck.ParseFromArtistURL(artistURL); err != nil {
+		return ""
+	}
+	return ck.Marshal()
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	ck := CompositeKey{}
+	if err := ck.ParseFromPlaylistURL(playlistURL); err != nil {
+		return ""
+	}
+	return ck.Marshal()
+}
+
+// URL links the track within its album when the album is known, as the web player does.
+func (t *Track) URL(region string) string {
+	if t.Album != nil && t.Album.ID != "" {
+		return fmt.Sprintf("%s/albums/%s?trackAsin=%s", storefrontURL(region), t.Album.ID, t.ID)
+	}
+	return fmt.Sprintf("%s/tracks/%s", storefrontURL(region), t.ID)
+}
+
+func (a *Album) URL(region string) string {
+	return fmt.Sprintf("%s/albums/%s", storefrontURL(region), a.ID)
+}
+
+// ImageURL returns the largest image.
+func (a *Album) ImageURL() string {
+	largest := Image{}
+	for _, image := range a.Images {
+		if image.Width >= largest.Width {
+			largest = image
+		}
+	}
+	return largest.URL
+}
+
+func (a *Artist) URL(region string) string {
+	return fmt.Sprintf("%s/artists/%s", storefrontURL(region), a.ID)
+}
+
+func (p *Playlist) URL(region string) string {
+	return fmt.Sprintf("%s/playlists/%s", storefrontURL(region), p.ID)
+}
+
+func storefrontURL(region string) string {
+	return "https://music.amazon." + domainZone(region)
+}
@@ -0,0 +1,112 @@
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DetectTrackID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "album URL with track ASIN",
+			input:    "https://music.amazon.com/albums/B0BDHWDR3R?trackAsin=B0BDHX1B2S&ref=dm_sh",
+			expected: "us-B0BDHX1B2S",
+		},
+		{
+			name:     "album URL with track ASIN after other params",
+			input:    "https://music.amazon.co.uk/albums/B0BDHWDR3R?ref=dm_sh&trackAsin=B0BDHX1B2S",
+			expected: "gb-B0BDHX1B2S",
+		},
+		{
+			name:     "track URL",
+			input:    "https://music.amazon.co.jp/tracks/B0BDHX1B2S",
+			expected: "jp-B0BDHX1B2S",
+		},
+		{
+			name:     "track URL on multi-level domain zone",
+			input:    "https://music.amazon.com.au/tracks/B0BDHX1B2S",
+			expected: "au-B0BDHX1B2S",
+		},
+		{
+			name:     "album URL",
+			input:    "https://music.amazon.de/albums/B0BDHWDR3R",
+			expected: "",
+		},
+		{
+			name:     "unknown domain zone",
+			input:    "https://music.amazon.xyz/tracks/B0BDHX1B2S",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectTrackID(tt.input))
+		})
+	}
+}
+
+func Test_DetectAlbumID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "album URL",
+			input:    "https://music.amazon.de/albums/B0BDHWDR3R?ref=dm_sh",
+			expected: "de-B0BDHWDR3R",
+		},
+		{
+			name:     "album URL with track ASIN",
+			input:    "https://music.amazon.com/albums/B0BDHWDR3R?trackAsin=B0BDHX1B2S",
+			expected: "",
+		},
+		{
+			name:     "malformed ASIN",
+			input:    "https://music.amazon.com/albums/B0B",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectAlbumID(tt.input))
+		})
+	}
+}
+
+func Test_DetectArtistID(t *testing.T) {
+	require.Equal(t, "fr-B000QJPTJ2", DetectArtistID("https://music.amazon.fr/artists/B000QJPTJ2/taylor-swift"))
+	require.Equal(t, "", DetectArtistID("https://music.amazon.fr/albums/B000QJPTJ2"))
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
+	require.Equal(t, "us-B07QNZ6J4K", DetectPlaylistID("https://music.amazon.com/playlists/B07QNZ6J4K"))
+	require.Equal(t, "", DetectPlaylistID("https://music.amazon.com/user-playlists/3a5c9e2f"))
+}
+
+func TestTrack_URL(t *testing.T) {
+	track := Track{ID: "B0BDHX1B2S"}
+	require.Equal(t, "https://music.amazon.co.uk/tracks/B0BDHX1B2S", track.URL("gb"))
+
+	track.Album = &Album{ID: "B0BDHWDR3R"}
+	require.Equal(t, "https://music.amazon.com/albums/B0BDHWDR3R?trackAsin=B0BDHX1B2S", track.URL("us"))
+}
+
+func TestAlbum_URL(t *testing.T) {
+	album := Album{ID: "B0BDHWDR3R"}
+	require.Equal(t, "https://music.amazon.de/albums/B0BDHWDR3R", album.URL("de"))
+}
+
+func TestAlbum_ImageURL(t *testing.T) {
+	album := Album{Images: []Image{
+		{URL: "https://m.media-amazon.com/images/I/small.jpg", Width: 300},
+		{URL: "https://m.media-amazon.com/images/I/large.jpg", Width: 1000},
+	}}
+	require.Equal(t, "https://m.media-amazon.com/images/I/large.jpg", album.ImageURL())
+	require.Equal(t, "", (&Album{}).ImageURL())
+}
@@ -0,0 +1,55 @@
+package amazon
+
+import (
+	"regexp"
+	"strings"
+)
+
+const DefaultRegion = "us"
+
+// domainZones maps regions to the domain zones of their music.amazon storefronts.
+var domainZones = map[string]string{
+	"us": "com",
+	"gb": "co.uk",
+	"de": "de",
+	"fr": "fr",
+	"it": "it",
+	"es": "es",
+	"jp": "co.jp",
+	"ca": "ca",
+	"au": "com.au",
+	"br": "com.br",
+	"mx": "com.mx",
+	"in": "in",
+}
+
+var Regions = []string{"us", "gb", "de", "fr", "it", "es", "jp", "ca", "au", "br", "mx", "in"}
+
+func IsValidRegion(region string) bool {
+	_, ok := domainZones[region]
+	return ok
+}
+
+func regionByDomainZone(zone string) string {
+	for region, z := range domainZones {
+		if z == zone {
+			return region
+		}
+	}
+	return ""
+}
+
+func domainZone(region string) string {
+	if zone, ok := domainZones[region]; ok {
+		return zone
+	}
+	return domainZones[DefaultRegion]
+}
+
+func allDomainZonesRe() string {
+	zones := make([]string, 0, len(Regions))
+	for _, region := range Regions {
+		zones = append(zones, regexp.QuoteMeta(domainZones[region]))
+	}
+	return strings.Join(zones, "|")
+}
@@ -0,0 +1,56 @@
+package apierr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	RateLimitedError         = errors.New("rate limited")
+	UnauthorizedError        = errors.New("unauthorized")
+	UpstreamUnavailableError = errors.New("upstream unavailable")
+	RegionRestrictedError    = errors.New("region restricted")
+	MalformedResponseError   = errors.New("malformed response")
+
+	unexpectedStatusError = errors.New("unexpected http status")
+)
+
+// StatusError is an unsuccessful HTTP response classified into one of the sentinel errors.
+type StatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: http status %d", e.Err, e.StatusCode)
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// FromStatus classifies an unsuccessful HTTP status code. Not found responses are left to
+// the clients since every one of them reports those with its own NotFoundError.
+func FromStatus(statusCode int) error {
+	return &StatusError{StatusCode: statusCode, Err: classify(statusCode)}
+}
+
+func Malformed(err error) error {
+	return fmt.Errorf("%w: %w", MalformedResponseError, err)
+}
+
+func classify(statusCode int) error {
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return RateLimitedError
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return UnauthorizedError
+	case statusCode == http.StatusUnavailableForLegalReasons:
+		return RegionRestrictedError
+	case statusCode >= http.StatusInternalServerError:
+		return UpstreamUnavailableError
+	default:
+		return unexpectedStatusError
+	}
+}
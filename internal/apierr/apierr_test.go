@@ -0,0 +1,42 @@
+package apierr
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		want       error
+	}{
+		{name: "too many requests", statusCode: http.StatusTooManyRequests, want: RateLimitedError},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, want: UnauthorizedError},
+		{name: "forbidden", statusCode: http.StatusForbidden, want: UnauthorizedError},
+		{name: "unavailable for legal reasons", statusCode: http.StatusUnavailableForLegalReasons, want: RegionRestrictedError},
+		{name: "internal server error", statusCode: http.StatusInternalServerError, want: UpstreamUnavailableError},
+		{name: "gateway timeout", statusCode: http.StatusGatewayTimeout, want: UpstreamUnavailableError},
+		{name: "unclassified", statusCode: http.StatusTeapot, want: unexpectedStatusError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := FromStatus(tt.statusCode)
+			require.ErrorIs(t, err, tt.want)
+
+			se := &StatusError{}
+			require.True(t, errors.As(err, &se))
+			require.Equal(t, tt.statusCode, se.StatusCode)
+		})
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	cause := errors.New("unexpected EOF")
+	err := Malformed(cause)
+	require.ErrorIs(t, err, MalformedResponseError)
+	require.ErrorIs(t, err, cause)
+}
@@ -8,11 +8,19 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
 )
 
 const (
 	defaultAPIURL      = "https://amp-api-edge.music.apple.com"
 	defaulWebPlayerURL = "https://music.apple.com"
+
+	playlistTracksPageLimit = 100
+	songType                = "songs"
 )
 
 var (
@@ -22,15 +30,26 @@ var (
 type Client interface {
 	FetchTrack(ctx context.Context, id, storefront string) (*Entity, error)
 	SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error)
+	SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error)
+	SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error)
 	FetchAlbum(ctx context.Context, id, storefront string) (*Entity, error)
 	SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error)
+	SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error)
+	SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error)
+	FetchArtist(ctx context.Context, id, storefront string) (*Entity, error)
+	SearchArtist(ctx context.Context, artistName string) (*Entity, error)
+	FetchPlaylist(ctx context.Context, id, storefront string) (*Playlist, error)
 }
 
 type HTTPClient struct {
 	apiURL       string
 	webPlayerURL string
+	tokenMu      sync.Mutex
 	token        string
 	httpClient   *http.Client
+	retryPolicy  retry.Policy
+	limiter      *throttle.Limiter
+	quota        *throttle.Quota
 }
 
 type searchResponse struct {
@@ -53,11 +72,13 @@ type searchDataItem struct {
 
 type getResponse struct {
 	Data []*Entity `json:"data"`
+	Next string    `json:"next"`
 }
 
 type searchResources struct {
-	Songs  map[string]*Entity `json:"songs"`
-	Albums map[string]*Entity `json:"albums"`
+	Songs   map[string]*Entity `json:"songs"`
+	Albums  map[string]*Entity `json:"albums"`
+	Artists map[string]*Entity `json:"artists"`
 }
 
 func NewHTTPClient(opts ...ClientOption) *HTTPClient {
@@ -65,11 +86,16 @@ func NewHTTPClient(opts ...ClientOption) *HTTPClient {
 		httpClient:   &http.Client{},
 		apiURL:       defaultAPIURL,
 		webPlayerURL: defaulWebPlayerURL,
+		retryPolicy:  retry.DefaultPolicy,
 	}
 
 	for _, opt := range opts {
 		opt(&c)
 	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
 
 	return &c
 }
@@ -78,7 +104,7 @@ func (c *HTTPClient) FetchTrack(ctx context.Context, id, storefront string) (*En
 	url := fmt.Sprintf(`%s/v1/catalog/%s/songs/%s`, c.apiURL, storefront, id)
 	response, err := c.getAPI(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform get request: %s", err)
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
 	}
 	defer response.Body.Close()
 
@@ -88,35 +114,111 @@ func (c *HTTPClient) FetchTrack(ctx context.Context, id, storefront string) (*En
 
 	gr := getResponse{}
 	if err := json.NewDecoder(response.Body).Decode(&gr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal get response: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal get response: %w", err))
 	}
 	return gr.Data[0], nil
 }
 
 func (c *HTTPClient) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
-	url := fmt.Sprintf(`%s/v1/catalog/us/search?%s`, c.apiURL, searchQuery(artistName+" "+trackName))
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	sr, err := c.search(ctx, artistName+" "+trackName)
+	if err != nil {
+		return nil, err
+	}
+	return sr.top(songType, sr.Resources.Songs, limit)
+}
+
+func (c *HTTPClient) SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error) {
+	return c.filterCatalog(ctx, "songs", "isrc", isrc)
+}
+
+func (c *HTTPClient) FetchAlbum(ctx context.Context, id, storefront string) (*Entity, error) {
+	url := fmt.Sprintf(`%s/v1/catalog/%s/albums/%s`, c.apiURL, storefront, id)
 	response, err := c.getAPI(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform get request: %s", err)
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, NotFoundError
+	}
+	gr := getResponse{}
+	if err := json.NewDecoder(response.Body).Decode(&gr); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal get response: %w", err))
+	}
+	return gr.Data[0], nil
+}
+func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	sr, err := c.search(ctx, artistName+" "+albumName)
+	if err != nil {
+		return nil, err
+	}
+	return sr.top("albums", sr.Resources.Albums, limit)
+}
+
+func (c *HTTPClient) SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error) {
+	return c.filterCatalog(ctx, "albums", "upc", upc)
+}
+
+func (c *HTTPClient) FetchArtist(ctx context.Context, id, storefront string) (*Entity, error) {
+	url := fmt.Sprintf(`%s/v1/catalog/%s/artists/%s`, c.apiURL, storefront, id)
+	response, err := c.getAPI(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, NotFoundError
+	}
+	gr := getResponse{}
+	if err := json.NewDecoder(response.Body).Decode(&gr); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal get response: %w", err))
+	}
+	return gr.Data[0], nil
+}
+
+func (c *HTTPClient) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	url := fmt.Sprintf(`%s/v1/catalog/us/search?%s`, c.apiURL, searchQuery(artistName))
+	response, err := c.getAPI(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
 	}
 	defer response.Body.Close()
 
 	sr := searchResponse{}
 	if err := json.NewDecoder(response.Body).Decode(&sr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal search response: %w", err))
 	}
 	for _, topResult := range sr.Results.Top.Data {
-		if topResult.Type == "songs" {
-			return sr.Resources.Songs[topResult.ID], nil
+		if topResult.Type == "artists" {
+			return sr.Resources.Artists[topResult.ID], nil
 		}
 	}
 	return nil, NotFoundError
 }
-func (c *HTTPClient) FetchAlbum(ctx context.Context, id, storefront string) (*Entity, error) {
-	url := fmt.Sprintf(`%s/v1/catalog/%s/albums/%s`, c.apiURL, storefront, id)
+
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, id, storefront string) (*Playlist, error) {
+	url := fmt.Sprintf(`%s/v1/catalog/%s/playlists/%s`, c.apiURL, storefront, id)
 	response, err := c.getAPI(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform get request: %s", err)
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
 	}
 	defer response.Body.Close()
 
@@ -125,69 +227,181 @@ func (c *HTTPClient) FetchAlbum(ctx context.Context, id, storefront string) (*En
 	}
 	gr := getResponse{}
 	if err := json.NewDecoder(response.Body).Decode(&gr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal get response: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal get response: %w", err))
+	}
+
+	tracks, err := c.fetchPlaylistTracks(ctx, id, storefront)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch playlist tracks: %w", err)
+	}
+
+	return &Playlist{
+		Entity: gr.Data[0],
+		Tracks: tracks,
+	}, nil
+}
+
+func (c *HTTPClient) fetchPlaylistTracks(ctx context.Context, id, storefront string) ([]*Entity, error) {
+	tracks := []*Entity{}
+	for offset := 0; ; {
+		url := fmt.Sprintf(
+			`%s/v1/catalog/%s/playlists/%s/tracks?offset=%d&limit=%d`,
+			c.apiURL, storefront, id, offset, playlistTracksPageLimit,
+		)
+		response, err := c.getAPI(ctx, url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to perform get request: %w", err)
+		}
+
+		gr := getResponse{}
+		err = json.NewDecoder(response.Body).Decode(&gr)
+		response.Body.Close()
+		if err != nil {
+			return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal get response: %w", err))
+		}
+
+		for _, track := range gr.Data {
+			if track.Type == songType {
+				tracks = append(tracks, track)
+			}
+		}
+		offset += len(gr.Data)
+		if gr.Next == "" || len(gr.Data) == 0 {
+			return tracks, nil
+		}
+	}
+}
+
+func (c *HTTPClient) filterCatalog(ctx context.Context, resource, filter, value string) (*Entity, error) {
+	query := url.Values{}
+	query.Set(fmt.Sprintf("filter[%s]", filter), value)
+
+	url := fmt.Sprintf(`%s/v1/catalog/us/%s?%s`, c.apiURL, resource, query.Encode())
+	response, err := c.getAPI(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, NotFoundError
+	}
+	gr := getResponse{}
+	if err := json.NewDecoder(response.Body).Decode(&gr); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal get response: %w", err))
+	}
+	if len(gr.Data) == 0 {
+		return nil, NotFoundError
 	}
 	return gr.Data[0], nil
 }
-func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
-	url := fmt.Sprintf(`%s/v1/catalog/us/search?%s`, c.apiURL, searchQuery(artistName+" "+albumName))
+
+func (c *HTTPClient) search(ctx context.Context, term string) (*searchResponse, error) {
+	url := fmt.Sprintf(`%s/v1/catalog/us/search?%s`, c.apiURL, searchQuery(term))
 	response, err := c.getAPI(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform get request: %s", err)
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
 	}
 	defer response.Body.Close()
 
 	sr := searchResponse{}
 	if err := json.NewDecoder(response.Body).Decode(&sr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal search response: %w", err))
 	}
+	return &sr, nil
+}
+
+// top returns up to limit resources of the given type in the order of the top search results.
+func (sr *searchResponse) top(resourceType string, resources map[string]*Entity, limit int) ([]*Entity, error) {
+	entities := make([]*Entity, 0, limit)
 	for _, topResult := range sr.Results.Top.Data {
-		if topResult.Type == "albums" {
-			return sr.Resources.Albums[topResult.ID], nil
+		if len(entities) == limit {
+			break
+		}
+		if entity, ok := resources[topResult.ID]; ok && topResult.Type == resourceType {
+			entities = append(entities, entity)
 		}
 	}
-	return nil, NotFoundError
+	if len(entities) == 0 {
+		return nil, NotFoundError
+	}
+	return entities, nil
 }
 
 func (c *HTTPClient) getAPI(ctx context.Context, reqURL string) (*http.Response, error) {
-	if c.token == "" {
-		token, err := c.fetchToken(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch token: %s", err)
-		}
-		c.token = token
+	token, err := c.cachedToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %s", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	req.Header.Set("Origin", defaulWebPlayerURL)
 
-	return c.httpClient.Do(req)
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
+		response.Body.Close()
+		if response.StatusCode == http.StatusUnauthorized {
+			c.resetToken(token)
+		}
+		return nil, apierr.FromStatus(response.StatusCode)
+	}
+	return response, nil
+}
+
+// cachedToken returns the cached developer token, fetching it when there is none.
+// Concurrent requests wait for a single fetch.
+func (c *HTTPClient) cachedToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == "" {
+		token, err := c.fetchToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.token = token
+	}
+	return c.token, nil
+}
+
+// resetToken drops the cached token when it is the rejected one, unless another request
+// has replaced it already.
+func (c *HTTPClient) resetToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == token {
+		c.token = ""
+	}
 }
 
 func (c *HTTPClient) fetchToken(ctx context.Context) (string, error) {
 	webPlayerHTML, err := c.fetchWebPlayerHTML(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to fetch index page: %s", err)
+		return "", fmt.Errorf("failed to fetch index page: %w", err)
 	}
 
 	bundleName := parseBundleName(webPlayerHTML)
 	if bundleName == "" {
-		return "", fmt.Errorf("failed to extract bundle name")
+		return "", apierr.Malformed(fmt.Errorf("failed to extract bundle name"))
 	}
 
 	webPlayerJS, err := c.fetchWebPlayerJS(ctx, bundleName)
 	if err != nil {
-		return "", fmt.Errorf("failed to fetch index js: %s", err)
+		return "", fmt.Errorf("failed to fetch index js: %w", err)
 	}
 
 	token, err := parseToken(webPlayerJS)
 	if err != nil {
-		return "", fmt.Errorf("failed to extract token: %s", err)
+		return "", apierr.Malformed(fmt.Errorf("failed to extract token: %w", err))
 	}
 
 	return token, nil
@@ -196,28 +410,34 @@ func (c *HTTPClient) fetchToken(ctx context.Context) (string, error) {
 func (c *HTTPClient) fetchWebPlayerHTML(ctx context.Context) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.webPlayerURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %s", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	response, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform get request: %s", err)
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
 	}
 	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, apierr.FromStatus(response.StatusCode)
+	}
 	return io.ReadAll(response.Body)
 }
 
 func (c *HTTPClient) fetchWebPlayerJS(ctx context.Context, bundleName string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.webPlayerURL+bundleName, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %s", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	response, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform get request: %s", err)
+		return nil, fmt.Errorf("failed to perform get request: %w", err)
 	}
 	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, apierr.FromStatus(response.StatusCode)
+	}
 	return io.ReadAll(response.Body)
 }
 
@@ -5,9 +5,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,9 +30,20 @@ func TestHTTPClient_FetchTrack(t *testing.T) {
 			want: &Entity{
 				ID: "foundID",
 				Attributes: Attributes{
-					ArtistName: "sampleArtistName",
-					Name:       "sampleTrackName",
-					URL:        "sampleURL",
+					ArtistName:       "sampleArtistName",
+					Name:             "sampleTrackName",
+					URL:              "sampleURL",
+					AlbumName:        "sampleAlbumName",
+					DurationInMillis: 215000,
+					ReleaseDate:      "2017-06-23",
+					Artwork: &Artwork{
+						URL:    "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/{w}x{h}bb.jpg",
+						Width:  3000,
+						Height: 3000,
+					},
+					ContentRating: "explicit",
+					TrackNumber:   3,
+					DiscNumber:    1,
 				},
 			},
 		},
@@ -55,7 +70,18 @@ func TestHTTPClient_FetchTrack(t *testing.T) {
 							"attributes": {
 								"artistName": "sampleArtistName",
 								"name": "sampleTrackName",
-								"url": "sampleURL"
+								"url": "sampleURL",
+								"albumName": "sampleAlbumName",
+								"durationInMillis": 215000,
+								"releaseDate": "2017-06-23",
+								"artwork": {
+									"url": "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/{w}x{h}bb.jpg",
+									"width": 3000,
+									"height": 3000
+								},
+								"contentRating": "explicit",
+								"trackNumber": 3,
+								"discNumber": 1
 							}
 						}
 					]
@@ -201,6 +227,215 @@ func TestHTTPClient_SearchTrack(t *testing.T) {
 	}
 }
 
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/v1/catalog/us/search", r.URL.Path)
+		require.Equal(t, "sampleArtistName sampleTrackName", r.URL.Query().Get("term"))
+
+		_, err := w.Write([]byte(`{
+			"results": {
+				"top": {
+					"data": [
+						{"id": "secondID", "type": "songs"},
+						{"id": "albumID", "type": "albums"},
+						{"id": "firstID", "type": "songs"},
+						{"id": "thirdID", "type": "songs"}
+					]
+				}
+			},
+			"resources": {
+				"songs": {
+					"firstID": {"id": "firstID", "attributes": {"name": "first"}},
+					"secondID": {"id": "secondID", "attributes": {"name": "second"}},
+					"thirdID": {"id": "thirdID", "attributes": {"name": "third"}}
+				},
+				"albums": {
+					"albumID": {"id": "albumID", "attributes": {"name": "album"}}
+				}
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := HTTPClient{
+		apiURL:     apiServerMock.URL,
+		token:      "tokenMock",
+		httpClient: &http.Client{},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchTracks(ctx, "sampleArtistName", "sampleTrackName", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{ID: "secondID", Attributes: Attributes{Name: "second"}},
+		{ID: "firstID", Attributes: Attributes{Name: "first"}},
+	}, result)
+}
+
+func TestHTTPClient_SearchTrackByISRC(t *testing.T) {
+	tests := []struct {
+		name    string
+		isrc    string
+		want    *Entity
+		wantErr error
+	}{
+		{
+			name: "when track found",
+			isrc: "USUM71703861",
+			want: &Entity{
+				ID:   "foundID",
+				Type: "songs",
+				Attributes: Attributes{
+					ArtistName: "sampleArtistName",
+					Name:       "sampleTrackName",
+					URL:        "sampleURL",
+					ISRC:       "USUM71703861",
+				},
+			},
+		},
+		{
+			name:    "when track not found",
+			isrc:    "notFoundISRC",
+			wantErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "Bearer tokenMock", r.Header.Get("Authorization"))
+				require.Equal(t, "/v1/catalog/us/songs", r.URL.Path)
+
+				switch r.URL.Query().Get("filter[isrc]") {
+				case "USUM71703861":
+					_, err := w.Write([]byte(`{
+						"data":[
+							{
+								"id":"foundID",
+								"type":"songs",
+								"attributes": {
+									"artistName": "sampleArtistName",
+									"name": "sampleTrackName",
+									"url": "sampleURL",
+									"isrc": "USUM71703861"
+								}
+							}
+						]
+					}`))
+					require.NoError(t, err)
+				case "notFoundISRC":
+					_, err := w.Write([]byte(`{"data":[]}`))
+					require.NoError(t, err)
+				default:
+					require.Fail(t, "unexpected query: %s", r.URL.RawQuery)
+				}
+			}))
+			defer apiServerMock.Close()
+
+			client := HTTPClient{
+				apiURL:     apiServerMock.URL,
+				token:      "tokenMock",
+				httpClient: &http.Client{},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.SearchTrackByISRC(ctx, tt.isrc)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchAlbumByUPC(t *testing.T) {
+	tests := []struct {
+		name    string
+		upc     string
+		want    *Entity
+		wantErr error
+	}{
+		{
+			name: "when album found",
+			upc:  "00602557382549",
+			want: &Entity{
+				ID:   "foundID",
+				Type: "albums",
+				Attributes: Attributes{
+					ArtistName: "sampleArtistName",
+					Name:       "sampleAlbumName",
+					URL:        "sampleURL",
+					UPC:        "00602557382549",
+				},
+			},
+		},
+		{
+			name:    "when album not found",
+			upc:     "notFoundUPC",
+			wantErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "Bearer tokenMock", r.Header.Get("Authorization"))
+				require.Equal(t, "/v1/catalog/us/albums", r.URL.Path)
+
+				switch r.URL.Query().Get("filter[upc]") {
+				case "00602557382549":
+					_, err := w.Write([]byte(`{
+						"data":[
+							{
+								"id":"foundID",
+								"type":"albums",
+								"attributes": {
+									"artistName": "sampleArtistName",
+									"name": "sampleAlbumName",
+									"url": "sampleURL",
+									"upc": "00602557382549"
+								}
+							}
+						]
+					}`))
+					require.NoError(t, err)
+				case "notFoundUPC":
+					_, err := w.Write([]byte(`{"data":[]}`))
+					require.NoError(t, err)
+				default:
+					require.Fail(t, "unexpected query: %s", r.URL.RawQuery)
+				}
+			}))
+			defer apiServerMock.Close()
+
+			client := HTTPClient{
+				apiURL:     apiServerMock.URL,
+				token:      "tokenMock",
+				httpClient: &http.Client{},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.SearchAlbumByUPC(ctx, tt.upc)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
 func TestHTTPClient_FetchAlbum(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -391,6 +626,362 @@ func TestHTTPClient_SearchAlbum(t *testing.T) {
 	}
 }
 
+func TestHTTPClient_FetchArtist(t *testing.T) {
+	tests := []struct {
+		name       string
+		artistID   string
+		storeFront string
+		want       *Entity
+		wantErr    error
+	}{
+		{
+			name:       "when artist found",
+			artistID:   "foundId",
+			storeFront: "us",
+			want: &Entity{
+				ID: "foundID",
+				Attributes: Attributes{
+					Name: "sampleArtistName",
+					URL:  "sampleURL",
+				},
+			},
+		},
+		{
+			name:       "when artist not found",
+			artistID:   "notFoundId",
+			storeFront: "nevermind",
+			wantErr:    NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "Bearer tokenMock", r.Header.Get("Authorization"))
+				require.Equal(t, "https://music.apple.com", r.Header.Get("Origin"))
+
+				switch r.URL.Path {
+				case "/v1/catalog/us/artists/foundId":
+					_, err := w.Write([]byte(`{
+					"data":[
+						{
+							"id":"foundID",
+							"attributes": {
+								"name": "sampleArtistName",
+								"url": "sampleURL"
+							}
+						}
+					]
+				}`))
+					require.NoError(t, err)
+				case "/v1/catalog/nevermind/artists/notFoundId":
+					w.WriteHeader(http.StatusNotFound)
+				default:
+					require.Fail(t, "unexpected path: %s", r.URL.Path)
+				}
+			}))
+			defer apiServerMock.Close()
+
+			client := HTTPClient{
+				apiURL:     apiServerMock.URL,
+				token:      "tokenMock",
+				httpClient: &http.Client{},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchArtist(ctx, tt.artistID, tt.storeFront)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name       string
+		artistName string
+		want       *Entity
+		wantErr    error
+	}{
+		{
+			name:       "when artist found",
+			artistName: "foundArtistName",
+			want: &Entity{
+				ID: "foundID",
+				Attributes: Attributes{
+					Name: "sampleArtistName",
+					URL:  "sampleURL",
+				},
+			},
+		},
+		{
+			name:       "when artist not found",
+			artistName: "notFoundArtistName",
+			wantErr:    NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "Bearer tokenMock", r.Header.Get("Authorization"))
+				require.Equal(t, "/v1/catalog/us/search", r.URL.Path)
+
+				var resp string
+				if r.URL.Query().Get("term") == "foundArtistName" {
+					resp = `{
+						"results": {
+							"top": {
+								"data": [
+									{
+										"id": "foundId",
+										"type": "artists"
+									}
+								]
+							}
+						},
+						"resources": {
+							"artists": {
+								"foundId": {
+									"id":"foundID",
+									"attributes": {
+										"name": "sampleArtistName",
+										"url": "sampleURL"
+									}
+								}
+							}
+						}
+					}`
+				} else {
+					resp = `{}`
+				}
+				_, err := w.Write([]byte(resp))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := HTTPClient{
+				apiURL:     apiServerMock.URL,
+				token:      "tokenMock",
+				httpClient: &http.Client{},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.SearchArtist(ctx, tt.artistName)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	tests := []struct {
+		name       string
+		playlistID string
+		storeFront string
+		want       *Playlist
+		wantErr    error
+	}{
+		{
+			name:       "when playlist found",
+			playlistID: "pl.foundId",
+			storeFront: "us",
+			want: &Playlist{
+				Entity: &Entity{
+					ID:   "pl.foundId",
+					Type: "playlists",
+					Attributes: Attributes{
+						Name:        "samplePlaylistName",
+						CuratorName: "sampleCuratorName",
+						URL:         "sampleURL",
+					},
+				},
+				Tracks: []*Entity{
+					{
+						ID:   "1",
+						Type: "songs",
+						Attributes: Attributes{
+							ArtistName: "sampleArtistName",
+							Name:       "sampleTrackName1",
+							URL:        "sampleTrackURL1",
+						},
+					},
+					{
+						ID:   "3",
+						Type: "songs",
+						Attributes: Attributes{
+							ArtistName: "sampleArtistName",
+							Name:       "sampleTrackName3",
+							URL:        "sampleTrackURL3",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "when playlist not found",
+			playlistID: "pl.notFoundId",
+			storeFront: "nevermind",
+			wantErr:    NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "Bearer tokenMock", r.Header.Get("Authorization"))
+
+				var resp string
+				switch r.URL.Path {
+				case "/v1/catalog/us/playlists/pl.foundId":
+					resp = `{
+						"data":[
+							{
+								"id":"pl.foundId",
+								"type":"playlists",
+								"attributes": {
+									"curatorName": "sampleCuratorName",
+									"name": "samplePlaylistName",
+									"url": "sampleURL"
+								}
+							}
+						]
+					}`
+				case "/v1/catalog/us/playlists/pl.foundId/tracks":
+					switch r.URL.Query().Get("offset") {
+					case "0":
+						resp = `{
+							"data":[
+								{
+									"id":"1",
+									"type":"songs",
+									"attributes": {
+										"artistName": "sampleArtistName",
+										"name": "sampleTrackName1",
+										"url": "sampleTrackURL1"
+									}
+								},
+								{
+									"id":"2",
+									"type":"music-videos",
+									"attributes": {
+										"artistName": "sampleArtistName",
+										"name": "sampleVideoName2",
+										"url": "sampleVideoURL2"
+									}
+								}
+							],
+							"next": "/v1/catalog/us/playlists/pl.foundId/tracks?offset=2"
+						}`
+					case "2":
+						resp = `{
+							"data":[
+								{
+									"id":"3",
+									"type":"songs",
+									"attributes": {
+										"artistName": "sampleArtistName",
+										"name": "sampleTrackName3",
+										"url": "sampleTrackURL3"
+									}
+								}
+							]
+						}`
+					default:
+						require.Fail(t, "unexpected offset: %s", r.URL.Query().Get("offset"))
+					}
+				case "/v1/catalog/nevermind/playlists/pl.notFoundId":
+					w.WriteHeader(http.StatusNotFound)
+					return
+				default:
+					require.Fail(t, "unexpected path: %s", r.URL.Path)
+				}
+				_, err := w.Write([]byte(resp))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := HTTPClient{
+				apiURL:     apiServerMock.URL,
+				token:      "tokenMock",
+				httpClient: &http.Client{},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchPlaylist(ctx, tt.playlistID, tt.storeFront)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_getAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseCode int
+		wantErr      error
+		wantToken    string
+	}{
+		{
+			name:         "when token expired",
+			responseCode: http.StatusUnauthorized,
+			wantErr:      apierr.UnauthorizedError,
+		},
+		{
+			name:         "when rate limited",
+			responseCode: http.StatusTooManyRequests,
+			wantErr:      apierr.RateLimitedError,
+			wantToken:    "tokenMock",
+		},
+		{
+			name:         "when upstream unavailable",
+			responseCode: http.StatusBadGateway,
+			wantErr:      apierr.UpstreamUnavailableError,
+			wantToken:    "tokenMock",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.responseCode)
+			}))
+			defer apiServerMock.Close()
+
+			client := HTTPClient{
+				apiURL:     apiServerMock.URL,
+				token:      "tokenMock",
+				httpClient: &http.Client{},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchTrack(ctx, "sampleID", "us")
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Nil(t, result)
+			require.Equal(t, tt.wantToken, client.token)
+		})
+	}
+}
+
 func TestHTTPClient_fetchToken(t *testing.T) {
 	webPlayerServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -428,6 +1019,59 @@ func TestHTTPClient_fetchToken(t *testing.T) {
 	require.Equal(t, "sampleToken", token)
 }
 
+func TestHTTPClient_ConcurrentRequests(t *testing.T) {
+	var fetched atomic.Int32
+	webPlayerServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			_, err := w.Write([]byte(`<script src="/assets/index-Samp1eBund13.js"></script>`))
+			require.NoError(t, err)
+		case "/assets/index-Samp1eBund13.js":
+			fetched.Add(1)
+			_, err := w.Write([]byte(`tokenVar = "sampleToken"; headers.Authorization = ` + "`Bearer ${tokenVar}`"))
+			require.NoError(t, err)
+		}
+	}))
+	defer webPlayerServerMock.Close()
+
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer sampleToken", r.Header.Get("Authorization"))
+		_, err := w.Write([]byte(`{
+			"results": {"top": {"data": [{"id": "firstID", "type": "songs"}]}},
+			"resources": {"songs": {"firstID": {"id": "firstID", "attributes": {"name": "first"}}}}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := HTTPClient{
+		apiURL:       apiServerMock.URL,
+		webPlayerURL: webPlayerServerMock.URL,
+		httpClient:   &http.Client{},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.SearchTrack(ctx, "sampleArtistName", "sampleTrackName")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), fetched.Load())
+}
+
 func Test_searchQuery(t *testing.T) {
 	sampleTerm := "sample term"
 	result := searchQuery(sampleTerm)
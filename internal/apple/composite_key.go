@@ -12,7 +12,7 @@ const (
 
 var (
 	compositeKeyRe = regexp.MustCompile(
-		fmt.Sprintf(`^([a-z]{2})%s([0-9]+)$`, delimiter),
+		fmt.Sprintf(`^([a-z]{2})%s([0-9]+|pl\.[\w-]+)$`, delimiter),
 	)
 	CompositeKeyError = errors.New("invalid composite key")
 )
@@ -56,6 +56,34 @@ func (k *CompositeKey) ParseFromAlbumURL(url string) error {
 	return nil
 }
 
+func (k *CompositeKey) ParseFromArtistURL(url string) error {
+	matches := ArtistRe.FindStringSubmatch(url)
+	if len(matches) != 3 {
+		return fmt.Errorf("%w (not valid url)", CompositeKeyError)
+	}
+	if !IsValidStorefront(matches[1]) {
+		return fmt.Errorf("%w (invalid storefront)", CompositeKeyError)
+	}
+
+	k.Storefront = matches[1]
+	k.ID = matches[2]
+	return nil
+}
+
+func (k *CompositeKey) ParseFromPlaylistURL(url string) error {
+	matches := PlaylistRe.FindStringSubmatch(url)
+	if len(matches) != 3 {
+		return fmt.Errorf("%w (not valid url)", CompositeKeyError)
+	}
+	if !IsValidStorefront(matches[1]) {
+		return fmt.Errorf("%w (invalid storefront)", CompositeKeyError)
+	}
+
+	k.Storefront = matches[1]
+	k.ID = matches[2]
+	return nil
+}
+
 func (k *CompositeKey) Marshal() string {
 	return k.Storefront + delimiter + k.ID
 }
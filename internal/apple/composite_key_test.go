@@ -75,6 +75,40 @@ func TestCompositeKey_ParseFromAlbumURL(t *testing.T) {
 	}
 }
 
+func TestCompositeKey_ParseFromArtistURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		artistURL string
+		want      CompositeKey
+		wantErr   error
+	}{
+		{
+			name:      "valid artist URL",
+			artistURL: "https://music.apple.com/us/artist/radiohead/657515",
+			want:      CompositeKey{ID: "657515", Storefront: "us"},
+		},
+		{
+			name:      "invalid storefront URL",
+			artistURL: "https://music.apple.com/invalid/artist/radiohead/657515",
+			wantErr:   CompositeKeyError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &CompositeKey{}
+			err := k.ParseFromArtistURL(tt.artistURL)
+
+			if tt.wantErr != nil {
+				require.ErrorAs(t, err, &tt.wantErr)
+			} else {
+				require.Equal(t, tt.want, *k)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestCompositeKey_Marshal(t *testing.T) {
 	ck := CompositeKey{
 		ID:         "123",
@@ -107,6 +141,19 @@ func TestCompositeKey_Unmarshal(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "valid playlist composite key",
+			compositeKey: CompositeKey{
+				ID:         "",
+				Storefront: "",
+			},
+			input: "gb-pl.u-aZb0kDLTPl0zXe",
+			wantResult: CompositeKey{
+				ID:         "pl.u-aZb0kDLTPl0zXe",
+				Storefront: "gb",
+			},
+			wantErr: nil,
+		},
 		{
 			name: "invalid composite key",
 			compositeKey: CompositeKey{
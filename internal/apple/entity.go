@@ -4,21 +4,49 @@ import (
 	"regexp"
 )
 
+const (
+	explicitContentRating = "explicit"
+)
+
 var (
 	AlbumRe      = regexp.MustCompile(`music\.apple\.com/(\w+)/album/.*/(\d+)`)
 	AlbumTrackRe = regexp.MustCompile(`music\.apple\.com/(\w+)/album/.*/(\d+)\?i=(\d+)`)
 	SongRe       = regexp.MustCompile(`music\.apple\.com/(\w+)/song/.*/(\d+)`)
+	ArtistRe     = regexp.MustCompile(`music\.apple\.com/(\w+)/artist/.*/(\d+)`)
+	PlaylistRe   = regexp.MustCompile(`music\.apple\.com/(\w+)/playlist/(?:.*/)?(pl\.[\w-]+)`)
 )
 
 type Entity struct {
 	ID         string     `json:"id"`
+	Type       string     `json:"type"`
 	Attributes Attributes `json:"attributes"`
 }
 
 type Attributes struct {
-	Name       string `json:"name"`
-	URL        string `json:"url"`
-	ArtistName string `json:"artistName"`
+	Name             string   `json:"name"`
+	URL              string   `json:"url"`
+	ArtistName       string   `json:"artistName"`
+	CuratorName      string   `json:"curatorName"`
+	ISRC             string   `json:"isrc"`
+	UPC              string   `json:"upc"`
+	AlbumName        string   `json:"albumName"`
+	DurationInMillis int      `json:"durationInMillis"`
+	ReleaseDate      string   `json:"releaseDate"`
+	Artwork          *Artwork `json:"artwork,omitempty"`
+	ContentRating    string   `json:"contentRating"`
+	TrackNumber      int      `json:"trackNumber"`
+	DiscNumber       int      `json:"discNumber"`
+}
+
+type Artwork struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type Playlist struct {
+	*Entity
+	Tracks []*Entity
 }
 
 func DetectTrackID(trackURL string) string {
@@ -36,3 +64,30 @@ func DetectAlbumID(albumURL string) string {
 	}
 	return ck.Marshal()
 }
+
+func DetectArtistID(artistURL string) string {
+	ck := CompositeKey{}
+	if err := ck.ParseFromArtistURL(artistURL); err != nil {
+		return ""
+	}
+	return ck.Marshal()
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	ck := CompositeKey{}
+	if err := ck.ParseFromPlaylistURL(playlistURL); err != nil {
+		return ""
+	}
+	return ck.Marshal()
+}
+
+func (a *Attributes) IsExplicit() bool {
+	return a.ContentRating == explicitContentRating
+}
+
+func (a *Attributes) ArtworkURL() string {
+	if a.Artwork == nil {
+		return ""
+	}
+	return a.Artwork.URL
+}
@@ -107,3 +107,93 @@ func Test_DetectAlbumID(t *testing.T) {
 		})
 	}
 }
+
+func Test_DetectArtistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "valid URL with artist ID",
+			input:    "https://music.apple.com/us/artist/radiohead/657515",
+			expected: "us-657515",
+		},
+		{
+			name:     "valid URL with artist ID and invalid iso3611 storefront",
+			input:    "https://music.apple.com/invalidstorefront/artist/radiohead/657515",
+			expected: "",
+		},
+		{
+			name:     "album URL",
+			input:    "https://music.apple.com/us/album/album-name/123456789",
+			expected: "",
+		},
+		{
+			name:     "empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectArtistID(tt.input)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "valid URL with playlist ID",
+			input:    "https://music.apple.com/us/playlist/todays-hits/pl.f4d106fed2bd41149aaacabb233eb5eb",
+			expected: "us-pl.f4d106fed2bd41149aaacabb233eb5eb",
+		},
+		{
+			name:     "valid URL with user playlist ID",
+			input:    "https://music.apple.com/gb/playlist/my-mix/pl.u-aZb0kDLTPl0zXe",
+			expected: "gb-pl.u-aZb0kDLTPl0zXe",
+		},
+		{
+			name:     "valid URL with playlist ID and invalid iso3611 storefront",
+			input:    "https://music.apple.com/invalidstorefront/playlist/todays-hits/pl.f4d106fed2bd41149aaacabb233eb5eb",
+			expected: "",
+		},
+		{
+			name:     "album URL",
+			input:    "https://music.apple.com/us/album/album-name/123456789",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectPlaylistID(tt.input)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAttributes_IsExplicit(t *testing.T) {
+	require.True(t, (&Attributes{ContentRating: "explicit"}).IsExplicit())
+	require.False(t, (&Attributes{ContentRating: "clean"}).IsExplicit())
+	require.False(t, (&Attributes{}).IsExplicit())
+}
+
+func TestAttributes_ArtworkURL(t *testing.T) {
+	attributes := Attributes{
+		Artwork: &Artwork{
+			URL:    "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/{w}x{h}bb.jpg",
+			Width:  3000,
+			Height: 3000,
+		},
+	}
+	require.Equal(t, "https://is1-ssl.mzstatic.com/image/thumb/Music/sample/{w}x{h}bb.jpg", attributes.ArtworkURL())
+	require.Equal(t, "", (&Attributes{}).ArtworkURL())
+}
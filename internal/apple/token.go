@@ -1,11 +1,14 @@
 package apple
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 )
 
 var (
+	variableValueNotFoundError = errors.New("variable value not found")
+
 	tokenBundleRe = regexp.MustCompile(`src="(/assets/index-[a-zA-Z0-9]+\.js)"`)
 	tokenVarRe    = regexp.MustCompile(`headers\.Authorization\s*=\s*` + "`Bearer \\${([a-zA-Z0-9_]+)}`")
 )
@@ -26,7 +29,7 @@ func parseToken(jsBundle []byte) (string, error) {
 
 	tokenVarValue, err := parseVariableValue(jsBundle, tokenVar)
 	if err != nil {
-		return "", fmt.Errorf("failed to find token variable value: %s", err)
+		return "", fmt.Errorf("failed to find token variable value: %w", err)
 	}
 
 	return tokenVarValue, err
@@ -44,7 +47,7 @@ func parseVariableValue(jsBundle []byte, variable string) (string, error) {
 	re := regexp.MustCompile(fmt.Sprintf(`\b%s\s*=\s*"([^"]+)"`, regexp.QuoteMeta(variable)))
 	matches := re.FindSubmatch(jsBundle)
 	if matches == nil || len(matches) < 2 {
-		return "", fmt.Errorf("value of variable %s not found", variable)
+		return "", fmt.Errorf("%s: %w", variable, variableValueNotFoundError)
 	}
 	return string(matches[1]), nil
 }
@@ -37,3 +37,10 @@ func Test_parseToken(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "sampleToken", result)
 }
+
+func Test_parseTokenWithoutValue(t *testing.T) {
+	jsBundle := []byte(`headers.Authorization = ` + "`Bearer ${token}`" + `; anotherSampleJs();`)
+	_, err := parseToken(jsBundle)
+	require.ErrorIs(t, err, variableValueNotFoundError)
+	require.ErrorContains(t, err, "failed to find token variable value: token")
+}
@@ -0,0 +1,228 @@
+package bandcamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	defaultSearchURL = "https://bandcamp.com"
+
+	// pages are a few hundred kilobytes, the limit guards against unexpected responses
+	maxPageSize = 8 << 20
+)
+
+var (
+	NotFoundError = errors.New("not found")
+)
+
+type Client interface {
+	FetchTrack(ctx context.Context, id string) (*Track, error)
+	SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error)
+	SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error)
+	FetchAlbum(ctx context.Context, id string) (*Album, error)
+	SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error)
+	SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error)
+	FetchArtist(ctx context.Context, id string) (*Artist, error)
+	SearchArtist(ctx context.Context, artistName string) (*Artist, error)
+}
+
+// HTTPClient scrapes the public pages since Bandcamp has no public API.
+type HTTPClient struct {
+	searchURL   string
+	httpClient  *http.Client
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
+}
+
+func NewHTTPClient(opts ...ClientOption) *HTTPClient {
+	c := HTTPClient{
+		searchURL:   defaultSearchURL,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
+
+	return &c
+}
+
+func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error) {
+	if DetectTrackID(pageURL(id)) != id {
+		return nil, NotFoundError
+	}
+
+	page, err := c.getPage(ctx, pageURL(id))
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := parseTrackPage(id, page)
+	if err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to parse track page: %w", err))
+	}
+	return track, nil
+}
+
+// https://bandcamp.com/search
+func (c *HTTPClient) SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+// https://bandcamp.com/search
+func (c *HTTPClient) SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error) {
+	results, err := c.search(ctx, searchQuery(artistName, trackName), trackItemType)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := []*Track{}
+	for _, result := range results {
+		if track := result.track(); track != nil && len(tracks) < limit {
+			tracks = append(tracks, track)
+		}
+	}
+	if len(tracks) == 0 {
+		return nil, NotFoundError
+	}
+	return tracks, nil
+}
+
+func (c *HTTPClient) FetchAlbum(ctx context.Context, id string) (*Album, error) {
+	if DetectAlbumID(pageURL(id)) != id {
+		return nil, NotFoundError
+	}
+
+	page, err := c.getPage(ctx, pageURL(id))
+	if err != nil {
+		return nil, err
+	}
+
+	album, err := parseAlbumPage(id, page)
+	if err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to parse album page: %w", err))
+	}
+	return album, nil
+}
+
+// https://bandcamp.com/search
+func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+// https://bandcamp.com/search
+func (c *HTTPClient) SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error) {
+	results, err := c.search(ctx, searchQuery(artistName, albumName), albumItemType)
+	if err != nil {
+		return nil, err
+	}
+
+	albums := []*Album{}
+	for _, result := range results {
+		if album := result.album(); album != nil && len(albums) < limit {
+			albums = append(albums, album)
+		}
+	}
+	if len(albums) == 0 {
+		return nil, NotFoundError
+	}
+	return albums, nil
+}
+
+func (c *HTTPClient) FetchArtist(ctx context.Context, id string) (*Artist, error) {
+	if DetectArtistID(pageURL(id)) != id {
+		return nil, NotFoundError
+	}
+
+	page, err := c.getPage(ctx, pageURL(id)+"/music")
+	if err != nil {
+		return nil, err
+	}
+
+	artist, err := parseArtistPage(id, page)
+	if err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to parse artist page: %w", err))
+	}
+	return artist, nil
+}
+
+// https://bandcamp.com/search
+func (c *HTTPClient) SearchArtist(ctx context.Context, artistName string) (*Artist, error) {
+	results, err := c.search(ctx, artistName, artistItemType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if artist := result.artist(); artist != nil {
+			return artist, nil
+		}
+	}
+	return nil, NotFoundError
+}
+
+func (c *HTTPClient) search(ctx context.Context, query, itemType string) ([]*searchResult, error) {
+	u := fmt.Sprintf("%s/search?%s", c.searchURL, url.Values{
+		"q":         []string{query},
+		"item_type": []string{itemType},
+	}.Encode())
+	page, err := c.getPage(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return parseSearchResults(page), nil
+}
+
+func (c *HTTPClient) getPage(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, NotFoundError
+	default:
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPageSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return body, nil
+}
+
+func searchQuery(artistName, name string) string {
+	return strings.TrimSpace(artistName + " " + name)
+}
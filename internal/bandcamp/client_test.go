@@ -0,0 +1,217 @@
+package bandcamp
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+
+	"github.com/stretchr/testify/require"
+)
+
+const sampleSearchPage = `<ul class="result-items">
+	<li class="searchresult data-search" data-search="{}">
+		<a class="artcont" href="https://tychomusic.bandcamp.com/track/awake?from=search"><div class="art"><img src="https://f4.bcbits.com/img/a1_7.jpg"></div></a>
+		<div class="result-info">
+			<div class="itemtype">TRACK</div>
+			<div class="heading">
+				<a href="https://tychomusic.bandcamp.com/track/awake?from=search">Awake</a>
+			</div>
+			<div class="subhead">
+				from Awake
+				by Tycho
+			</div>
+			<div class="itemurl"><a href="https://tychomusic.bandcamp.com/track/awake?from=search">https://tychomusic.bandcamp.com/track/awake</a></div>
+		</div>
+	</li>
+	<li class="searchresult data-search" data-search="{}">
+		<div class="result-info">
+			<div class="heading"><a href="https://someone.bandcamp.com/track/awake-cover">Awake (Tycho Cover)</a></div>
+			<div class="subhead">by Someone &amp; Friends</div>
+			<div class="itemurl"><a href="https://someone.bandcamp.com/track/awake-cover">https://someone.bandcamp.com/track/awake-cover</a></div>
+		</div>
+	</li>
+</ul>`
+
+// newPagesServerMock serves every host requested by the client, so absolute page URLs
+// of *.bandcamp.com are routed to the handler.
+func newPagesServerMock(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *HTTPClient) {
+	server := httptest.NewTLSServer(handler)
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	}
+	client := NewHTTPClient(
+		WithHTTPTransport(transport),
+		WithRetryPolicy(retry.Policy{MaxAttempts: 1}),
+	)
+	return server, client
+}
+
+func TestHTTPClient_FetchTrack(t *testing.T) {
+	server, client := newPagesServerMock(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "tychomusic.bandcamp.com", r.Host)
+		require.Equal(t, "/track/awake", r.URL.Path)
+		_, err := w.Write([]byte(sampleTrackPage))
+		require.NoError(t, err)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "tychomusic.bandcamp.com/track/awake")
+	require.NoError(t, err)
+	require.Equal(t, "Awake", track.Title)
+	require.Equal(t, "Tycho", track.Artist)
+	require.Equal(t, "USGHO1400001", track.ISRC)
+}
+
+func TestHTTPClient_FetchTrackErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		status      int
+		page        string
+		expectedErr error
+	}{
+		{
+			name:        "not found page",
+			id:          "tychomusic.bandcamp.com/track/unknown",
+			status:      http.StatusNotFound,
+			expectedErr: NotFoundError,
+		},
+		{
+			name:        "foreign host",
+			id:          "example.org/track/awake",
+			expectedErr: NotFoundError,
+		},
+		{
+			name:        "page without track data",
+			id:          "tychomusic.bandcamp.com/track/awake",
+			status:      http.StatusOK,
+			page:        "<html></html>",
+			expectedErr: apierr.MalformedResponseError,
+		},
+		{
+			name:        "server error",
+			id:          "tychomusic.bandcamp.com/track/awake",
+			status:      http.StatusServiceUnavailable,
+			expectedErr: apierr.UpstreamUnavailableError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := newPagesServerMock(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, err := w.Write([]byte(tt.page))
+				require.NoError(t, err)
+			})
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			track, err := client.FetchTrack(ctx, tt.id)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, track)
+		})
+	}
+}
+
+func TestHTTPClient_FetchAlbum(t *testing.T) {
+	server, client := newPagesServerMock(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "tychomusic.bandcamp.com", r.Host)
+		require.Equal(t, "/album/awake", r.URL.Path)
+		_, err := w.Write([]byte(sampleAlbumPage))
+		require.NoError(t, err)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	album, err := client.FetchAlbum(ctx, "tychomusic.bandcamp.com/album/awake")
+	require.NoError(t, err)
+	require.Equal(t, "Awake", album.Title)
+	require.Equal(t, "0656605232425", album.UPC)
+	require.Len(t, album.Tracks, 2)
+}
+
+func TestHTTPClient_FetchArtist(t *testing.T) {
+	server, client := newPagesServerMock(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "tychomusic.bandcamp.com", r.Host)
+		require.Equal(t, "/music", r.URL.Path)
+		_, err := w.Write([]byte(`<div data-band="{&quot;name&quot;:&quot;Tycho&quot;}"></div>`))
+		require.NoError(t, err)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.FetchArtist(ctx, "tychomusic.bandcamp.com")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{ID: "tychomusic.bandcamp.com", Name: "Tycho"}, artist)
+}
+
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	server, client := newPagesServerMock(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "bandcamp.com", r.Host)
+		require.Equal(t, "/search", r.URL.Path)
+		require.Equal(t, "Tycho Awake", r.URL.Query().Get("q"))
+		require.Equal(t, "t", r.URL.Query().Get("item_type"))
+		_, err := w.Write([]byte(sampleSearchPage))
+		require.NoError(t, err)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tracks, err := client.SearchTracks(ctx, "Tycho", "Awake", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Track{
+		{
+			ID:       "tychomusic.bandcamp.com/track/awake",
+			Title:    "Awake",
+			Artist:   "Tycho",
+			Album:    "Awake",
+			ImageURL: "https://f4.bcbits.com/img/a1_7.jpg",
+		},
+		{
+			ID:     "someone.bandcamp.com/track/awake-cover",
+			Title:  "Awake (Tycho Cover)",
+			Artist: "Someone & Friends",
+		},
+	}, tracks)
+
+	track, err := client.SearchTrack(ctx, "Tycho", "Awake")
+	require.NoError(t, err)
+	require.Equal(t, tracks[0], track)
+}
+
+func TestHTTPClient_SearchNotFound(t *testing.T) {
+	server, client := newPagesServerMock(t, func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`<ul class="result-items"></ul>`))
+		require.NoError(t, err)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.SearchAlbum(ctx, "Tycho", "Unknown")
+	require.ErrorIs(t, err, NotFoundError)
+	_, err = client.SearchArtist(ctx, "Unknown")
+	require.ErrorIs(t, err, NotFoundError)
+}
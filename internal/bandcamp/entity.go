@@ -0,0 +1,135 @@
+package bandcamp
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	TrackRe  = regexp.MustCompile(`https?://([a-zA-Z0-9.-]+)/track/([a-zA-Z0-9_-]+)`)
+	AlbumRe  = regexp.MustCompile(`https?://([a-zA-Z0-9.-]+)/album/([a-zA-Z0-9_-]+)`)
+	ArtistRe = regexp.MustCompile(`https?://([a-zA-Z0-9.-]+)(?:/music)?/?(?:[?#]\S*)?(?:\s|$)`)
+
+	// subdomains of bandcamp.com which are not artist pages
+	reservedSubdomains = map[string]bool{
+		"www":   true,
+		"daily": true,
+		"blog":  true,
+		"get":   true,
+		"f4":    true,
+	}
+)
+
+type Track struct {
+	ID          string
+	Title       string
+	Artist      string
+	Album       string
+	ISRC        string
+	Duration    time.Duration
+	ReleaseDate string
+	ImageURL    string
+	TrackNumber int
+}
+
+type Album struct {
+	ID          string
+	Title       string
+	Artist      string
+	UPC         string
+	ReleaseDate string
+	ImageURL    string
+	Tracks      []*Track
+}
+
+type Artist struct {
+	ID   string
+	Name string
+}
+
+// Domains are custom domains of artist pages hosted by Bandcamp, e.g. music.artist.com,
+// detected along with *.bandcamp.com links.
+type Domains map[string]bool
+
+func NewDomains(domains ...string) Domains {
+	d := Domains{}
+	d.Add(domains...)
+	return d
+}
+
+func (d Domains) Add(domains ...string) {
+	for _, domain := range domains {
+		d[strings.ToLower(domain)] = true
+	}
+}
+
+// IDs are the host and path of the page, e.g. artist.bandcamp.com/track/slug,
+// so custom domains are fetched as is.
+func DetectTrackID(trackURL string) string {
+	return Domains(nil).DetectTrackID(trackURL)
+}
+
+func DetectAlbumID(albumURL string) string {
+	return Domains(nil).DetectAlbumID(albumURL)
+}
+
+func DetectArtistID(artistURL string) string {
+	return Domains(nil).DetectArtistID(artistURL)
+}
+
+// Bandcamp has no playlists.
+func DetectPlaylistID(_ string) string {
+	return ""
+}
+
+// DetectTrackID detects track links on bandcamp.com subdomains and the custom domains.
+func (d Domains) DetectTrackID(trackURL string) string {
+	return d.detectPageID(TrackRe, "track", trackURL)
+}
+
+func (d Domains) DetectAlbumID(albumURL string) string {
+	return d.detectPageID(AlbumRe, "album", albumURL)
+}
+
+func (d Domains) DetectArtistID(artistURL string) string {
+	match := ArtistRe.FindStringSubmatch(artistURL)
+	if len(match) < 2 || !d.IsArtistHost(match[1]) {
+		return ""
+	}
+	return strings.ToLower(match[1])
+}
+
+// IsArtistHost reports whether the host serves an artist page: a bandcamp.com subdomain
+// or one of the custom domains.
+func (d Domains) IsArtistHost(host string) bool {
+	host = strings.ToLower(host)
+	if subdomain, ok := strings.CutSuffix(host, ".bandcamp.com"); ok {
+		return subdomain != "" && !strings.Contains(subdomain, ".") && !reservedSubdomains[subdomain]
+	}
+	return d[host]
+}
+
+func (t *Track) URL() string {
+	return pageURL(t.ID)
+}
+
+func (a *Album) URL() string {
+	return pageURL(a.ID)
+}
+
+func (a *Artist) URL() string {
+	return pageURL(a.ID)
+}
+
+func (d Domains) detectPageID(re *regexp.Regexp, kind, pageURL string) string {
+	match := re.FindStringSubmatch(pageURL)
+	if len(match) < 3 || !d.IsArtistHost(match[1]) {
+		return ""
+	}
+	return strings.ToLower(match[1]) + "/" + kind + "/" + match[2]
+}
+
+func pageURL(id string) string {
+	return "https://" + id
+}
@@ -0,0 +1,144 @@
+package bandcamp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrack_URL(t *testing.T) {
+	track := Track{ID: "tychomusic.bandcamp.com/track/awake"}
+	require.Equal(t, "https://tychomusic.bandcamp.com/track/awake", track.URL())
+}
+
+func TestAlbum_URL(t *testing.T) {
+	album := Album{ID: "tychomusic.bandcamp.com/album/awake"}
+	require.Equal(t, "https://tychomusic.bandcamp.com/album/awake", album.URL())
+}
+
+func TestArtist_URL(t *testing.T) {
+	artist := Artist{ID: "tychomusic.bandcamp.com"}
+	require.Equal(t, "https://tychomusic.bandcamp.com", artist.URL())
+}
+
+func Test_DetectTrackID(t *testing.T) {
+	domains := NewDomains("Music.Example.com")
+
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://tychomusic.bandcamp.com/track/awake",
+			expected: "tychomusic.bandcamp.com/track/awake",
+		},
+		{
+			name:     "Valid URL with query",
+			inputURL: "https://tychomusic.bandcamp.com/track/awake?from=search",
+			expected: "tychomusic.bandcamp.com/track/awake",
+		},
+		{
+			name:     "Custom domain",
+			inputURL: "https://music.example.com/track/awake",
+			expected: "music.example.com/track/awake",
+		},
+		{
+			name:     "Unknown custom domain",
+			inputURL: "https://example.org/track/awake",
+			expected: "",
+		},
+		{
+			name:     "Reserved subdomain",
+			inputURL: "https://daily.bandcamp.com/track/awake",
+			expected: "",
+		},
+		{
+			name:     "Album URL",
+			inputURL: "https://tychomusic.bandcamp.com/album/awake",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, domains.DetectTrackID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectAlbumID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://tychomusic.bandcamp.com/album/awake",
+			expected: "tychomusic.bandcamp.com/album/awake",
+		},
+		{
+			name:     "Bandcamp without subdomain",
+			inputURL: "https://bandcamp.com/album/awake",
+			expected: "",
+		},
+		{
+			name:     "Track URL",
+			inputURL: "https://tychomusic.bandcamp.com/track/awake",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectAlbumID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectArtistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Root URL",
+			inputURL: "https://tychomusic.bandcamp.com",
+			expected: "tychomusic.bandcamp.com",
+		},
+		{
+			name:     "Music URL",
+			inputURL: "https://tychomusic.bandcamp.com/music",
+			expected: "tychomusic.bandcamp.com",
+		},
+		{
+			name:     "Root URL with slash and query",
+			inputURL: "https://tychomusic.bandcamp.com/?from=search",
+			expected: "tychomusic.bandcamp.com",
+		},
+		{
+			name:     "Bandcamp home",
+			inputURL: "https://bandcamp.com",
+			expected: "",
+		},
+		{
+			name:     "Other service",
+			inputURL: "https://www.deezer.com",
+			expected: "",
+		},
+		{
+			name:     "Track URL",
+			inputURL: "https://tychomusic.bandcamp.com/track/awake",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectArtistID(tt.inputURL))
+		})
+	}
+}
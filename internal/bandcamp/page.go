@@ -0,0 +1,233 @@
+package bandcamp
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ldTrackType = "MusicRecording"
+	ldAlbumType = "MusicAlbum"
+
+	datePublishedLayout = "02 Jan 2006 15:04:05 MST"
+)
+
+var (
+	ldJSONRe     = regexp.MustCompile(`(?s)<script type="application/ld\+json"[^>]*>(.*?)</script>`)
+	tralbumRe    = regexp.MustCompile(`data-tralbum="([^"]*)"`)
+	bandRe       = regexp.MustCompile(`data-band="([^"]*)"`)
+	ogTitleRe    = regexp.MustCompile(`<meta property="og:title" content="([^"]*)"`)
+	ldDurationRe = regexp.MustCompile(`^P(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?$`)
+)
+
+// ldItem is the schema.org object embedded into track and album pages as JSON-LD.
+type ldItem struct {
+	Type          string       `json:"@type"`
+	ID            string       `json:"@id"`
+	Name          string       `json:"name"`
+	Duration      string       `json:"duration"`
+	DatePublished string       `json:"datePublished"`
+	Image         string       `json:"image"`
+	ByArtist      *ldItem      `json:"byArtist"`
+	InAlbum       *ldItem      `json:"inAlbum"`
+	Track         *ldTrackList `json:"track"`
+}
+
+type ldTrackList struct {
+	ItemListElement []ldListItem `json:"itemListElement"`
+}
+
+type ldListItem struct {
+	Position int    `json:"position"`
+	Item     ldItem `json:"item"`
+}
+
+// tralbum is the player data embedded into track and album pages, carrying identifiers
+// and exact durations missing in JSON-LD.
+type tralbum struct {
+	Current   tralbumCurrent `json:"current"`
+	TrackInfo []tralbumTrack `json:"trackinfo"`
+}
+
+type tralbumCurrent struct {
+	ISRC string `json:"isrc"`
+	UPC  string `json:"upc"`
+}
+
+type tralbumTrack struct {
+	TrackNum  int     `json:"track_num"`
+	Title     string  `json:"title"`
+	Duration  float64 `json:"duration"`
+	TitleLink string  `json:"title_link"`
+	ISRC      string  `json:"isrc"`
+}
+
+type band struct {
+	Name string `json:"name"`
+}
+
+func parseTrackPage(id string, page []byte) (*Track, error) {
+	item, err := parseLDItem(page, ldTrackType)
+	if err != nil {
+		return nil, err
+	}
+	ta, err := parseTralbum(page)
+	if err != nil {
+		return nil, err
+	}
+
+	track := trackFromLD(id, item)
+	track.ISRC = ta.Current.ISRC
+	if len(ta.TrackInfo) > 0 {
+		track.TrackNumber = ta.TrackInfo[0].TrackNum
+		if ta.TrackInfo[0].Duration > 0 {
+			track.Duration = secondsDuration(ta.TrackInfo[0].Duration)
+		}
+	}
+	if item.InAlbum != nil {
+		track.Album = item.InAlbum.Name
+	}
+	return track, nil
+}
+
+func parseAlbumPage(id string, page []byte) (*Album, error) {
+	item, err := parseLDItem(page, ldAlbumType)
+	if err != nil {
+		return nil, err
+	}
+	ta, err := parseTralbum(page)
+	if err != nil {
+		return nil, err
+	}
+
+	album := Album{
+		ID:          id,
+		Title:       item.Name,
+		UPC:         ta.Current.UPC,
+		ReleaseDate: parseDatePublished(item.DatePublished),
+		ImageURL:    item.Image,
+	}
+	if item.ByArtist != nil {
+		album.Artist = item.ByArtist.Name
+	}
+	if item.Track == nil {
+		return &album, nil
+	}
+
+	host, _, _ := strings.Cut(id, "/")
+	for i, element := range item.Track.ItemListElement {
+		trackID := DetectTrackID(element.Item.ID)
+		if trackID == "" && i < len(ta.TrackInfo) && ta.TrackInfo[i].TitleLink != "" {
+			trackID = host + ta.TrackInfo[i].TitleLink
+		}
+		track := trackFromLD(trackID, &element.Item)
+		track.Album = album.Title
+		track.TrackNumber = element.Position
+		if track.Artist == "" {
+			track.Artist = album.Artist
+		}
+		if track.ReleaseDate == "" {
+			track.ReleaseDate = album.ReleaseDate
+		}
+		if track.ImageURL == "" {
+			track.ImageURL = album.ImageURL
+		}
+		if i < len(ta.TrackInfo) {
+			track.ISRC = ta.TrackInfo[i].ISRC
+			if ta.TrackInfo[i].Duration > 0 {
+				track.Duration = secondsDuration(ta.TrackInfo[i].Duration)
+			}
+		}
+		album.Tracks = append(album.Tracks, track)
+	}
+	return &album, nil
+}
+
+func parseArtistPage(id string, page []byte) (*Artist, error) {
+	if match := bandRe.FindSubmatch(page); match != nil {
+		b := band{}
+		if err := json.Unmarshal([]byte(html.UnescapeString(string(match[1]))), &b); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal band data: %w", err)
+		}
+		if b.Name != "" {
+			return &Artist{ID: id, Name: b.Name}, nil
+		}
+	}
+
+	match := ogTitleRe.FindSubmatch(page)
+	if match == nil {
+		return nil, fmt.Errorf("artist name not found")
+	}
+	return &Artist{ID: id, Name: html.UnescapeString(string(match[1]))}, nil
+}
+
+func parseLDItem(page []byte, itemType string) (*ldItem, error) {
+	match := ldJSONRe.FindSubmatch(page)
+	if match == nil {
+		return nil, fmt.Errorf("json-ld not found")
+	}
+
+	item := ldItem{}
+	if err := json.Unmarshal(match[1], &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal json-ld: %w", err)
+	}
+	if item.Type != itemType {
+		return nil, fmt.Errorf("unexpected json-ld type %q", item.Type)
+	}
+	return &item, nil
+}
+
+func parseTralbum(page []byte) (*tralbum, error) {
+	ta := tralbum{}
+	match := tralbumRe.FindSubmatch(page)
+	if match == nil {
+		return &ta, nil
+	}
+	if err := json.Unmarshal([]byte(html.UnescapeString(string(match[1]))), &ta); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tralbum data: %w", err)
+	}
+	return &ta, nil
+}
+
+func trackFromLD(id string, item *ldItem) *Track {
+	track := Track{
+		ID:          id,
+		Title:       item.Name,
+		Duration:    parseLDDuration(item.Duration),
+		ReleaseDate: parseDatePublished(item.DatePublished),
+		ImageURL:    item.Image,
+	}
+	if item.ByArtist != nil {
+		track.Artist = item.ByArtist.Name
+	}
+	return &track
+}
+
+// parseLDDuration parses durations like P00H03M42S used in JSON-LD.
+func parseLDDuration(value string) time.Duration {
+	match := ldDurationRe.FindStringSubmatch(value)
+	if match == nil {
+		return 0
+	}
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	seconds, _ := strconv.ParseFloat(match[3], 64)
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + secondsDuration(seconds)
+}
+
+func parseDatePublished(value string) string {
+	date, err := time.Parse(datePublishedLayout, value)
+	if err != nil {
+		return ""
+	}
+	return date.Format(time.DateOnly)
+}
+
+func secondsDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond)
+}
@@ -0,0 +1,144 @@
+package bandcamp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sampleTrackPage = `<!DOCTYPE html>
+<html>
+<head>
+	<script type="application/ld+json">
+	{
+		"@type": "MusicRecording",
+		"@id": "https://tychomusic.bandcamp.com/track/awake",
+		"name": "Awake",
+		"duration": "P00H04M43S",
+		"datePublished": "18 Mar 2014 00:00:00 GMT",
+		"image": "https://f4.bcbits.com/img/a1_10.jpg",
+		"byArtist": {"@type": "MusicGroup", "name": "Tycho"},
+		"inAlbum": {"@type": "MusicAlbum", "name": "Awake"}
+	}
+	</script>
+	<script data-tralbum="{&quot;current&quot;:{&quot;isrc&quot;:&quot;USGHO1400001&quot;},&quot;trackinfo&quot;:[{&quot;track_num&quot;:1,&quot;title&quot;:&quot;Awake&quot;,&quot;duration&quot;:283.413}]}"></script>
+</head>
+</html>`
+
+	sampleAlbumPage = `<!DOCTYPE html>
+<html>
+<head>
+	<script type="application/ld+json">
+	{
+		"@type": "MusicAlbum",
+		"@id": "https://tychomusic.bandcamp.com/album/awake",
+		"name": "Awake",
+		"datePublished": "18 Mar 2014 00:00:00 GMT",
+		"image": "https://f4.bcbits.com/img/a1_10.jpg",
+		"byArtist": {"@type": "MusicGroup", "name": "Tycho"},
+		"track": {
+			"itemListElement": [
+				{"position": 1, "item": {"@id": "https://tychomusic.bandcamp.com/track/awake", "name": "Awake", "duration": "P00H04M43S"}},
+				{"position": 2, "item": {"name": "Montana", "duration": "P00H04M26S"}}
+			]
+		}
+	}
+	</script>
+	<script data-tralbum="{&quot;current&quot;:{&quot;upc&quot;:&quot;0656605232425&quot;},&quot;trackinfo&quot;:[{&quot;track_num&quot;:1,&quot;duration&quot;:283.413,&quot;title_link&quot;:&quot;/track/awake&quot;},{&quot;track_num&quot;:2,&quot;duration&quot;:266.2,&quot;title_link&quot;:&quot;/track/montana&quot;}]}"></script>
+</head>
+</html>`
+)
+
+func Test_parseTrackPage(t *testing.T) {
+	track, err := parseTrackPage("tychomusic.bandcamp.com/track/awake", []byte(sampleTrackPage))
+	require.NoError(t, err)
+	require.Equal(t, &Track{
+		ID:          "tychomusic.bandcamp.com/track/awake",
+		Title:       "Awake",
+		Artist:      "Tycho",
+		Album:       "Awake",
+		ISRC:        "USGHO1400001",
+		Duration:    283413 * time.Millisecond,
+		ReleaseDate: "2014-03-18",
+		ImageURL:    "https://f4.bcbits.com/img/a1_10.jpg",
+		TrackNumber: 1,
+	}, track)
+}
+
+func Test_parseTrackPageUnexpectedType(t *testing.T) {
+	_, err := parseTrackPage("tychomusic.bandcamp.com/track/awake", []byte(sampleAlbumPage))
+	require.ErrorContains(t, err, `unexpected json-ld type "MusicAlbum"`)
+
+	_, err = parseTrackPage("tychomusic.bandcamp.com/track/awake", []byte("<html></html>"))
+	require.ErrorContains(t, err, "json-ld not found")
+}
+
+func Test_parseAlbumPage(t *testing.T) {
+	album, err := parseAlbumPage("tychomusic.bandcamp.com/album/awake", []byte(sampleAlbumPage))
+	require.NoError(t, err)
+	require.Equal(t, &Album{
+		ID:          "tychomusic.bandcamp.com/album/awake",
+		Title:       "Awake",
+		Artist:      "Tycho",
+		UPC:         "0656605232425",
+		ReleaseDate: "2014-03-18",
+		ImageURL:    "https://f4.bcbits.com/img/a1_10.jpg",
+		Tracks: []*Track{
+			{
+				ID:          "tychomusic.bandcamp.com/track/awake",
+				Title:       "Awake",
+				Artist:      "Tycho",
+				Album:       "Awake",
+				Duration:    283413 * time.Millisecond,
+				ReleaseDate: "2014-03-18",
+				ImageURL:    "https://f4.bcbits.com/img/a1_10.jpg",
+				TrackNumber: 1,
+			},
+			{
+				ID:          "tychomusic.bandcamp.com/track/montana",
+				Title:       "Montana",
+				Artist:      "Tycho",
+				Album:       "Awake",
+				Duration:    266200 * time.Millisecond,
+				ReleaseDate: "2014-03-18",
+				ImageURL:    "https://f4.bcbits.com/img/a1_10.jpg",
+				TrackNumber: 2,
+			},
+		},
+	}, album)
+}
+
+func Test_parseArtistPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     string
+		expected *Artist
+	}{
+		{
+			name:     "band data",
+			page:     `<div id="pagedata" data-band="{&quot;id&quot;:1,&quot;name&quot;:&quot;Tycho&quot;}"></div>`,
+			expected: &Artist{ID: "tychomusic.bandcamp.com", Name: "Tycho"},
+		},
+		{
+			name:     "open graph title",
+			page:     `<meta property="og:title" content="Simon &amp; Garfunkel">`,
+			expected: &Artist{ID: "tychomusic.bandcamp.com", Name: "Simon & Garfunkel"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artist, err := parseArtistPage("tychomusic.bandcamp.com", []byte(tt.page))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, artist)
+		})
+	}
+}
+
+func Test_parseLDDuration(t *testing.T) {
+	require.Equal(t, 4*time.Minute+43*time.Second, parseLDDuration("P00H04M43S"))
+	require.Equal(t, time.Hour+time.Second, parseLDDuration("P01H00M01S"))
+	require.Equal(t, time.Duration(0), parseLDDuration("4:43"))
+}
@@ -0,0 +1,118 @@
+package bandcamp
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+const (
+	searchResultMarker = `<li class="searchresult`
+
+	trackItemType  = "t"
+	albumItemType  = "a"
+	artistItemType = "b"
+)
+
+var (
+	headingRe = regexp.MustCompile(`(?s)<div class="heading">\s*<a[^>]*>(.*?)</a>`)
+	subheadRe = regexp.MustCompile(`(?s)<div class="subhead">(.*?)</div>`)
+	itemURLRe = regexp.MustCompile(`(?s)<div class="itemurl">\s*<a[^>]*>(.*?)</a>`)
+	imageRe   = regexp.MustCompile(`<img src="([^"]+)"`)
+	byRe      = regexp.MustCompile(`(?:^|\s)by\s+(.+)$`)
+	fromRe    = regexp.MustCompile(`^from\s+(.+?)\s+by\s`)
+	spacesRe  = regexp.MustCompile(`\s+`)
+	tagRe     = regexp.MustCompile(`<[^>]*>`)
+)
+
+// searchResult is an item of the bandcamp.com search page.
+type searchResult struct {
+	URL      string
+	Heading  string
+	Subhead  string
+	ImageURL string
+}
+
+func parseSearchResults(page []byte) []*searchResult {
+	chunks := strings.Split(string(page), searchResultMarker)
+	results := make([]*searchResult, 0, len(chunks))
+	for _, chunk := range chunks[1:] {
+		result := searchResult{
+			URL:     submatchText(itemURLRe, chunk),
+			Heading: submatchText(headingRe, chunk),
+			Subhead: submatchText(subheadRe, chunk),
+		}
+		if match := imageRe.FindStringSubmatch(chunk); match != nil {
+			result.ImageURL = html.UnescapeString(match[1])
+		}
+		if result.URL != "" {
+			results = append(results, &result)
+		}
+	}
+	return results
+}
+
+func (r *searchResult) artistName() string {
+	match := byRe.FindStringSubmatch(r.Subhead)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+func (r *searchResult) albumTitle() string {
+	match := fromRe.FindStringSubmatch(r.Subhead)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+func (r *searchResult) track() *Track {
+	id := DetectTrackID(r.URL)
+	if id == "" {
+		return nil
+	}
+	return &Track{
+		ID:       id,
+		Title:    r.Heading,
+		Artist:   r.artistName(),
+		Album:    r.albumTitle(),
+		ImageURL: r.ImageURL,
+	}
+}
+
+func (r *searchResult) album() *Album {
+	id := DetectAlbumID(r.URL)
+	if id == "" {
+		return nil
+	}
+	return &Album{
+		ID:       id,
+		Title:    r.Heading,
+		Artist:   r.artistName(),
+		ImageURL: r.ImageURL,
+	}
+}
+
+func (r *searchResult) artist() *Artist {
+	id := DetectArtistID(r.URL)
+	if id == "" {
+		return nil
+	}
+	return &Artist{
+		ID:   id,
+		Name: r.Heading,
+	}
+}
+
+// submatchText returns the text of the first submatch with tags stripped and whitespace collapsed.
+func submatchText(re *regexp.Regexp, chunk string) string {
+	match := re.FindStringSubmatch(chunk)
+	if match == nil {
+		return ""
+	}
+	text := tagRe.ReplaceAllString(match[1], " ")
+	text = spacesRe.ReplaceAllString(html.UnescapeString(text), " ")
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,321 @@
+package deezer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	defaultAPIURL = "https://api.deezer.com"
+
+	playlistTracksPageLimit = 100
+)
+
+// https://developers.deezer.com/api/errors
+const (
+	quotaErrorCode       = 4
+	serviceBusyErrorCode = 700
+	notFoundErrorCode    = 800
+)
+
+var (
+	NotFoundError = errors.New("not found")
+)
+
+type Client interface {
+	FetchTrack(ctx context.Context, id string) (*Track, error)
+	SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error)
+	SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error)
+	SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error)
+	FetchAlbum(ctx context.Context, id string) (*Album, error)
+	SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error)
+	SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error)
+	SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error)
+	FetchArtist(ctx context.Context, id string) (*Artist, error)
+	SearchArtist(ctx context.Context, artistName string) (*Artist, error)
+	FetchPlaylist(ctx context.Context, id string) (*Playlist, error)
+}
+
+type HTTPClient struct {
+	apiURL      string
+	httpClient  *http.Client
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
+}
+
+type apiError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+type errorResponse struct {
+	Error *apiError `json:"error"`
+}
+
+type tracksPage struct {
+	Data []*Track `json:"data"`
+	Next string   `json:"next"`
+}
+
+type albumsPage struct {
+	Data []*Album `json:"data"`
+}
+
+type artistsPage struct {
+	Data []*Artist `json:"data"`
+}
+
+func NewHTTPClient(opts ...ClientOption) *HTTPClient {
+	c := HTTPClient{
+		apiURL:      defaultAPIURL,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
+
+	return &c
+}
+
+// https://developers.deezer.com/api/track
+func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error) {
+	return c.fetchTrack(ctx, id)
+}
+
+func (c *HTTPClient) SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+// https://developers.deezer.com/api/search
+func (c *HTTPClient) SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error) {
+	q := fmt.Sprintf(`artist:"%s" track:"%s"`, artistName, trackName)
+	body, err := c.getAPI(ctx, "/search/track", searchQuery(q, limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	page := tracksPage{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(page.Data) == 0 {
+		return nil, NotFoundError
+	}
+
+	return page.Data[:min(limit, len(page.Data))], nil
+}
+
+func (c *HTTPClient) SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error) {
+	return c.fetchTrack(ctx, "isrc:"+isrc)
+}
+
+// https://developers.deezer.com/api/album
+func (c *HTTPClient) FetchAlbum(ctx context.Context, id string) (*Album, error) {
+	return c.fetchAlbum(ctx, id)
+}
+
+func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error) {
+	q := fmt.Sprintf(`artist:"%s" album:"%s"`, artistName, albumName)
+	body, err := c.getAPI(ctx, "/search/album", searchQuery(q, limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	page := albumsPage{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(page.Data) == 0 {
+		return nil, NotFoundError
+	}
+
+	return page.Data[:min(limit, len(page.Data))], nil
+}
+
+func (c *HTTPClient) SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error) {
+	return c.fetchAlbum(ctx, "upc:"+upc)
+}
+
+// https://developers.deezer.com/api/artist
+func (c *HTTPClient) FetchArtist(ctx context.Context, id string) (*Artist, error) {
+	body, err := c.getAPI(ctx, "/artist/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	artist := Artist{}
+	if err := json.Unmarshal(body, &artist); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+
+	return &artist, nil
+}
+
+func (c *HTTPClient) SearchArtist(ctx context.Context, artistName string) (*Artist, error) {
+	body, err := c.getAPI(ctx, "/search/artist", searchQuery(artistName, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	page := artistsPage{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(page.Data) == 0 {
+		return nil, NotFoundError
+	}
+
+	return page.Data[0], nil
+}
+
+// https://developers.deezer.com/api/playlist
+// https://developers.deezer.com/api/playlist/tracks
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	path := "/playlist/" + url.PathEscape(id)
+	body, err := c.getAPI(ctx, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	playlist := Playlist{}
+	if err := json.Unmarshal(body, &playlist); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+
+	for index := 0; ; {
+		body, err = c.getAPI(ctx, path+"/tracks", url.Values{
+			"index": []string{strconv.Itoa(index)},
+			"limit": []string{strconv.Itoa(playlistTracksPageLimit)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		page := tracksPage{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+		}
+
+		playlist.Tracks = append(playlist.Tracks, page.Data...)
+		index += len(page.Data)
+		if page.Next == "" || len(page.Data) == 0 {
+			return &playlist, nil
+		}
+	}
+}
+
+func (c *HTTPClient) fetchTrack(ctx context.Context, id string) (*Track, error) {
+	body, err := c.getAPI(ctx, "/track/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	track := Track{}
+	if err := json.Unmarshal(body, &track); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+
+	return &track, nil
+}
+
+func (c *HTTPClient) fetchAlbum(ctx context.Context, id string) (*Album, error) {
+	body, err := c.getAPI(ctx, "/album/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	album := Album{}
+	if err := json.Unmarshal(body, &album); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+
+	return &album, nil
+}
+
+// getAPI returns the response body, converting the errors the API reports
+// with 200 OK status into NotFoundError and the typed errors.
+func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	u := fmt.Sprintf("%s%s?%s", c.apiURL, path, query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, NotFoundError
+	default:
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	er := errorResponse{}
+	if err := json.Unmarshal(body, &er); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if er.Error != nil {
+		return nil, er.Error.err()
+	}
+
+	return body, nil
+}
+
+func (e *apiError) err() error {
+	switch e.Code {
+	case notFoundErrorCode:
+		return NotFoundError
+	case quotaErrorCode:
+		return fmt.Errorf("%w: %s", apierr.RateLimitedError, e.Message)
+	case serviceBusyErrorCode:
+		return fmt.Errorf("%w: %s", apierr.UpstreamUnavailableError, e.Message)
+	default:
+		return fmt.Errorf("api error %d %s: %s", e.Code, e.Type, e.Message)
+	}
+}
+
+func searchQuery(q string, limit int) url.Values {
+	return url.Values{
+		"q":     []string{q},
+		"limit": []string{strconv.Itoa(limit)},
+	}
+}
@@ -0,0 +1,421 @@
+package deezer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+
+	"github.com/stretchr/testify/require"
+)
+
+const notFoundResponse = `{"error": {"type": "DataException", "message": "no data", "code": 800}}`
+
+func TestHTTPClient_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name    string
+		trackID string
+		want    *Track
+		wantErr error
+	}{
+		{
+			name:    "when track found",
+			trackID: "3135556",
+			want: &Track{
+				ID:             3135556,
+				Title:          "Harder, Better, Faster, Stronger",
+				ISRC:           "GBDUW0000059",
+				Duration:       224,
+				TrackPosition:  4,
+				DiskNumber:     1,
+				ReleaseDate:    "2001-03-07",
+				ExplicitLyrics: false,
+				Artist:         Artist{ID: 27, Name: "Daft Punk"},
+				Contributors:   []Artist{{ID: 27, Name: "Daft Punk"}},
+				Album: &Album{
+					ID:          302127,
+					Title:       "Discovery",
+					CoverXL:     "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000-000000-80-0-0.jpg",
+					ReleaseDate: "2001-03-07",
+				},
+			},
+		},
+		{
+			name:    "when track not found",
+			trackID: "0",
+			wantErr: NotFoundError,
+		},
+		{
+			name:    "when quota exceeded",
+			trackID: "4",
+			wantErr: apierr.RateLimitedError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+
+				var response string
+				switch r.URL.Path {
+				case "/track/3135556":
+					response = `{
+						"id": 3135556,
+						"title": "Harder, Better, Faster, Stronger",
+						"isrc": "GBDUW0000059",
+						"duration": 224,
+						"track_position": 4,
+						"disk_number": 1,
+						"release_date": "2001-03-07",
+						"explicit_lyrics": false,
+						"contributors": [{"id": 27, "name": "Daft Punk"}],
+						"artist": {"id": 27, "name": "Daft Punk"},
+						"album": {
+							"id": 302127,
+							"title": "Discovery",
+							"cover_xl": "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000-000000-80-0-0.jpg",
+							"release_date": "2001-03-07"
+						}
+					}`
+				case "/track/4":
+					response = `{"error": {"type": "Exception", "message": "Quota limit exceeded", "code": 4}}`
+				default:
+					response = notFoundResponse
+				}
+				_, err := w.Write([]byte(response))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchTrack(ctx, tt.trackID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	tests := []struct {
+		name      string
+		artist    string
+		track     string
+		limit     int
+		wantIDs   []int
+		wantErr   error
+		wantQuery string
+	}{
+		{
+			name:      "when tracks found",
+			artist:    "Daft Punk",
+			track:     "One More Time",
+			limit:     2,
+			wantIDs:   []int{3135553, 3135554},
+			wantQuery: `artist:"Daft Punk" track:"One More Time"`,
+		},
+		{
+			name:      "when tracks not found",
+			artist:    "Unknown",
+			track:     "Unknown",
+			limit:     5,
+			wantErr:   NotFoundError,
+			wantQuery: `artist:"Unknown" track:"Unknown"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/search/track", r.URL.Path)
+				require.Equal(t, tt.wantQuery, r.URL.Query().Get("q"))
+
+				response := `{"data": [], "total": 0}`
+				if r.URL.Query().Get("limit") == "2" {
+					response = `{"data": [
+						{"id": 3135553, "title": "One More Time", "artist": {"id": 27, "name": "Daft Punk"}},
+						{"id": 3135554, "title": "One More Time (Radio Edit)", "artist": {"id": 27, "name": "Daft Punk"}}
+					], "total": 2}`
+				}
+				_, err := w.Write([]byte(response))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.SearchTracks(ctx, tt.artist, tt.track, tt.limit)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := make([]int, 0, len(result))
+			for _, track := range result {
+				ids = append(ids, track.ID)
+			}
+			require.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestHTTPClient_SearchTrackByISRC(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/track/isrc:GBDUW0000059", r.URL.Path)
+		_, err := w.Write([]byte(`{"id": 3135556, "title": "Harder, Better, Faster, Stronger", "isrc": "GBDUW0000059"}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchTrackByISRC(ctx, "GBDUW0000059")
+	require.NoError(t, err)
+	require.Equal(t, &Track{ID: 3135556, Title: "Harder, Better, Faster, Stronger", ISRC: "GBDUW0000059"}, result)
+}
+
+func TestHTTPClient_FetchAlbum(t *testing.T) {
+	tests := []struct {
+		name    string
+		albumID string
+		want    *Album
+		wantErr error
+	}{
+		{
+			name:    "when album found",
+			albumID: "302127",
+			want: &Album{
+				ID:          302127,
+				Title:       "Discovery",
+				UPC:         "724384960650",
+				CoverXL:     "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000-000000-80-0-0.jpg",
+				ReleaseDate: "2001-03-07",
+				Artist:      &Artist{ID: 27, Name: "Daft Punk"},
+			},
+		},
+		{
+			name:    "when album not found",
+			albumID: "0",
+			wantErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response := notFoundResponse
+				if r.URL.Path == "/album/302127" {
+					response = `{
+						"id": 302127,
+						"title": "Discovery",
+						"upc": "724384960650",
+						"cover_xl": "https://e-cdns-images.dzcdn.net/images/cover/sample/1000x1000-000000-80-0-0.jpg",
+						"release_date": "2001-03-07",
+						"artist": {"id": 27, "name": "Daft Punk"}
+					}`
+				}
+				_, err := w.Write([]byte(response))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchAlbum(ctx, tt.albumID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchAlbum(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/search/album", r.URL.Path)
+		require.Equal(t, `artist:"Daft Punk" album:"Discovery"`, r.URL.Query().Get("q"))
+		require.Equal(t, "1", r.URL.Query().Get("limit"))
+		_, err := w.Write([]byte(`{"data": [{"id": 302127, "title": "Discovery"}], "total": 1}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchAlbum(ctx, "Daft Punk", "Discovery")
+	require.NoError(t, err)
+	require.Equal(t, &Album{ID: 302127, Title: "Discovery"}, result)
+}
+
+func TestHTTPClient_SearchAlbumByUPC(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/album/upc:724384960650", r.URL.Path)
+		_, err := w.Write([]byte(notFoundResponse))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchAlbumByUPC(ctx, "724384960650")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, result)
+}
+
+func TestHTTPClient_FetchArtist(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/artist/27", r.URL.Path)
+		_, err := w.Write([]byte(`{"id": 27, "name": "Daft Punk", "nb_album": 36}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.FetchArtist(ctx, "27")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{ID: 27, Name: "Daft Punk"}, result)
+}
+
+func TestHTTPClient_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    *Artist
+		wantErr error
+	}{
+		{
+			name:  "when artist found",
+			query: "Daft Punk",
+			want:  &Artist{ID: 27, Name: "Daft Punk"},
+		},
+		{
+			name:    "when artist not found",
+			query:   "Unknown",
+			wantErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/search/artist", r.URL.Path)
+				response := `{"data": [], "total": 0}`
+				if r.URL.Query().Get("q") == "Daft Punk" {
+					response = `{"data": [{"id": 27, "name": "Daft Punk"}], "total": 1}`
+				}
+				_, err := w.Write([]byte(response))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.SearchArtist(ctx, tt.query)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+		switch r.URL.Path {
+		case "/playlist/908622995":
+			response = `{
+				"id": 908622995,
+				"title": "Sample Playlist",
+				"creator": {"id": 1, "name": "Sample User"},
+				"tracks": {"data": [{"id": 1}]}
+			}`
+		case "/playlist/908622995/tracks":
+			switch r.URL.Query().Get("index") {
+			case "0":
+				response = `{"data": [{"id": 1}, {"id": 2}], "next": "https://api.deezer.com/playlist/908622995/tracks?index=2"}`
+			case "2":
+				response = `{"data": [{"id": 3}]}`
+			default:
+				require.Fail(t, "unexpected index", r.URL.Query().Get("index"))
+			}
+		default:
+			response = notFoundResponse
+		}
+		_, err := w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.FetchPlaylist(ctx, "908622995")
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{
+		ID:      908622995,
+		Title:   "Sample Playlist",
+		Creator: Creator{ID: 1, Name: "Sample User"},
+		Tracks:  []*Track{{ID: 1}, {ID: 2}, {ID: 3}},
+	}, result)
+
+	result, err = client.FetchPlaylist(ctx, "0")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, result)
+}
+
+func TestHTTPClient_UnavailableUpstream(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(WithAPIURL(apiServerMock.URL), WithRetryPolicy(retry.Policy{MaxAttempts: 1}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.FetchTrack(ctx, "3135556")
+	require.ErrorIs(t, err, apierr.UpstreamUnavailableError)
+	require.Nil(t, result)
+}
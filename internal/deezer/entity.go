@@ -0,0 +1,132 @@
+package deezer
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+var (
+	TrackRe    = regexp.MustCompile(`(?:https|deezer)://(?:www\.)?deezer\.com/(?:[a-z]{2}(?:-[a-z]{2})?/)?track/(\d+)`)
+	AlbumRe    = regexp.MustCompile(`(?:https|deezer)://(?:www\.)?deezer\.com/(?:[a-z]{2}(?:-[a-z]{2})?/)?album/(\d+)`)
+	ArtistRe   = regexp.MustCompile(`(?:https|deezer)://(?:www\.)?deezer\.com/(?:[a-z]{2}(?:-[a-z]{2})?/)?artist/(\d+)`)
+	PlaylistRe = regexp.MustCompile(`(?:https|deezer)://(?:www\.)?deezer\.com/(?:[a-z]{2}(?:-[a-z]{2})?/)?playlist/(\d+)`)
+
+	ShortLinkRe = regexp.MustCompile(`https://deezer\.page\.link/\S*`)
+)
+
+type Track struct {
+	ID             int      `json:"id"`
+	Title          string   `json:"title"`
+	ISRC           string   `json:"isrc"`
+	Duration       int      `json:"duration"`
+	TrackPosition  int      `json:"track_position"`
+	DiskNumber     int      `json:"disk_number"`
+	ReleaseDate    string   `json:"release_date"`
+	ExplicitLyrics bool     `json:"explicit_lyrics"`
+	Artist         Artist   `json:"artist"`
+	Contributors   []Artist `json:"contributors"`
+	Album          *Album   `json:"album,omitempty"`
+}
+
+type Album struct {
+	ID             int      `json:"id"`
+	Title          string   `json:"title"`
+	UPC            string   `json:"upc"`
+	CoverXL        string   `json:"cover_xl"`
+	ReleaseDate    string   `json:"release_date"`
+	ExplicitLyrics bool     `json:"explicit_lyrics"`
+	Artist         *Artist  `json:"artist,omitempty"`
+	Contributors   []Artist `json:"contributors"`
+}
+
+type Artist struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Playlist struct {
+	ID      int      `json:"id"`
+	Title   string   `json:"title"`
+	Creator Creator  `json:"creator"`
+	Tracks  []*Track `json:"-"`
+}
+
+type Creator struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func DetectTrackID(trackURL string) string {
+	return detectID(TrackRe, trackURL)
+}
+
+func DetectAlbumID(albumURL string) string {
+	return detectID(AlbumRe, albumURL)
+}
+
+func DetectArtistID(artistURL string) string {
+	return detectID(ArtistRe, artistURL)
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	return detectID(PlaylistRe, playlistURL)
+}
+
+// detectID also looks into deezer.page.link dynamic links which carry the target URL
+// in the link query parameter.
+func detectID(re *regexp.Regexp, rawURL string) string {
+	if target := shortLinkTarget(rawURL); target != "" {
+		rawURL = target
+	}
+	match := re.FindStringSubmatch(rawURL)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func shortLinkTarget(rawURL string) string {
+	shortLink := ShortLinkRe.FindString(rawURL)
+	if shortLink == "" {
+		return ""
+	}
+	u, err := url.Parse(shortLink)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("link")
+}
+
+func (t *Track) URL() string {
+	return fmt.Sprintf("https://www.deezer.com/track/%d", t.ID)
+}
+
+func (t *Track) Artists() []Artist {
+	if len(t.Contributors) > 0 {
+		return t.Contributors
+	}
+	return []Artist{t.Artist}
+}
+
+func (a *Album) URL() string {
+	return fmt.Sprintf("https://www.deezer.com/album/%d", a.ID)
+}
+
+func (a *Album) Artists() []Artist {
+	if len(a.Contributors) > 0 {
+		return a.Contributors
+	}
+	if a.Artist != nil {
+		return []Artist{*a.Artist}
+	}
+	return nil
+}
+
+func (a *Artist) URL() string {
+	return fmt.Sprintf("https://www.deezer.com/artist/%d", a.ID)
+}
+
+func (p *Playlist) URL() string {
+	return fmt.Sprintf("https://www.deezer.com/playlist/%d", p.ID)
+}
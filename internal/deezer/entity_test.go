@@ -0,0 +1,133 @@
+package deezer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrack_URL(t *testing.T) {
+	track := Track{ID: 3135556}
+	require.Equal(t, "https://www.deezer.com/track/3135556", track.URL())
+}
+
+func TestAlbum_URL(t *testing.T) {
+	album := Album{ID: 302127}
+	require.Equal(t, "https://www.deezer.com/album/302127", album.URL())
+}
+
+func TestArtist_URL(t *testing.T) {
+	artist := Artist{ID: 27}
+	require.Equal(t, "https://www.deezer.com/artist/27", artist.URL())
+}
+
+func TestPlaylist_URL(t *testing.T) {
+	playlist := Playlist{ID: 908622995}
+	require.Equal(t, "https://www.deezer.com/playlist/908622995", playlist.URL())
+}
+
+func TestTrack_Artists(t *testing.T) {
+	track := Track{Artist: Artist{ID: 27, Name: "Daft Punk"}}
+	require.Equal(t, []Artist{{ID: 27, Name: "Daft Punk"}}, track.Artists())
+
+	track.Contributors = []Artist{{ID: 27, Name: "Daft Punk"}, {ID: 1, Name: "Pharrell Williams"}}
+	require.Equal(t, track.Contributors, track.Artists())
+}
+
+func Test_DetectTrackID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://www.deezer.com/track/3135556",
+			expected: "3135556",
+		},
+		{
+			name:     "Valid URL with locale",
+			inputURL: "https://www.deezer.com/en/track/3135556?utm_source=deezer",
+			expected: "3135556",
+		},
+		{
+			name:     "App URI",
+			inputURL: "deezer://www.deezer.com/track/3135556",
+			expected: "3135556",
+		},
+		{
+			name:     "Valid URL without www",
+			inputURL: "https://deezer.com/fr/track/3135556",
+			expected: "3135556",
+		},
+		{
+			name:     "Dynamic short link",
+			inputURL: "https://deezer.page.link/?link=https%3A%2F%2Fwww.deezer.com%2Ftrack%2F3135556",
+			expected: "3135556",
+		},
+		{
+			name:     "Opaque short link",
+			inputURL: "https://deezer.page.link/aBcD1234eFgH",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://www.deezer.com/album/302127",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Host",
+			inputURL: "https://www.example.com/track/3135556",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectTrackID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectAlbumID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://www.deezer.com/album/302127",
+			expected: "302127",
+		},
+		{
+			name:     "Valid URL with locale",
+			inputURL: "https://www.deezer.com/pt-br/album/302127",
+			expected: "302127",
+		},
+		{
+			name:     "Dynamic short link",
+			inputURL: "https://deezer.page.link/?link=https://www.deezer.com/album/302127&apn=deezer.android.app",
+			expected: "302127",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://www.deezer.com/track/3135556",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectAlbumID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectArtistID(t *testing.T) {
+	require.Equal(t, "27", DetectArtistID("https://www.deezer.com/en/artist/27"))
+	require.Equal(t, "", DetectArtistID("https://www.deezer.com/en/album/27"))
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
+	require.Equal(t, "908622995", DetectPlaylistID("https://www.deezer.com/en/playlist/908622995"))
+	require.Equal(t, "", DetectPlaylistID("https://www.deezer.com/en/track/908622995"))
+}
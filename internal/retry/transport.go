@@ -0,0 +1,135 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+var DefaultPolicy = Policy{
+	MaxAttempts: 3,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+// Policy configures how many times and how long apart requests are retried.
+// MaxAttempts includes the first attempt, so values below 2 disable retries.
+type Policy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Transport retries idempotent requests failed with network errors, 429 or 5xx statuses
+// using exponential backoff with jitter and honoring the Retry-After header up to the policy's MaxDelay.
+type Transport struct {
+	base   http.RoundTripper
+	policy Policy
+	jitter func(time.Duration) time.Duration
+}
+
+func NewTransport(base http.RoundTripper, policy Policy) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{
+		base:   base,
+		policy: policy,
+		jitter: func(d time.Duration) time.Duration {
+			return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+		},
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req.Method) || t.policy.MaxAttempts < 2 {
+		return t.base.RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if attempt == t.policy.MaxAttempts || !t.retryable(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		delay, ok := t.delay(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+		if deadline, ok := req.Context().Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *Transport) retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// An exceeded quota isn't restored before the retries end.
+		if errors.Is(err, throttle.QuotaExceededError) {
+			return false
+		}
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the next attempt. It isn't retried when the server
+// asks to wait longer than the policy allows.
+func (t *Transport) delay(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter, retryAfter <= t.policy.MaxDelay
+		}
+	}
+
+	backoff := t.policy.BaseDelay << (attempt - 1)
+	if backoff <= 0 || backoff > t.policy.MaxDelay {
+		backoff = t.policy.MaxDelay
+	}
+	return t.jitter(backoff), true
+}
+
+// parseRetryAfter supports both delay-seconds and HTTP-date forms of the header.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
@@ -0,0 +1,218 @@
+package retry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testPolicy = Policy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    10 * time.Millisecond,
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		policy       Policy
+		statuses     []int
+		retryAfter   string
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{
+			name:         "success without retries",
+			method:       http.MethodGet,
+			policy:       testPolicy,
+			statuses:     []int{http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retried until success",
+			method:       http.MethodGet,
+			policy:       testPolicy,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "last response returned when attempts exhausted",
+			method:       http.MethodGet,
+			policy:       testPolicy,
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 3,
+		},
+		{
+			name:         "client errors not retried",
+			method:       http.MethodGet,
+			policy:       testPolicy,
+			statuses:     []int{http.StatusNotFound},
+			wantStatus:   http.StatusNotFound,
+			wantAttempts: 1,
+		},
+		{
+			name:         "non idempotent requests not retried",
+			method:       http.MethodPost,
+			policy:       testPolicy,
+			statuses:     []int{http.StatusServiceUnavailable},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries disabled",
+			method:       http.MethodGet,
+			policy:       Policy{MaxAttempts: 1},
+			statuses:     []int{http.StatusServiceUnavailable},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retry after honored",
+			method:       http.MethodGet,
+			policy:       testPolicy,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "0",
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "retry after longer than max delay not waited for",
+			method:       http.MethodGet,
+			policy:       testPolicy,
+			statuses:     []int{http.StatusTooManyRequests},
+			retryAfter:   "3600",
+			wantStatus:   http.StatusTooManyRequests,
+			wantAttempts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := atomic.Int32{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			client := http.Client{Transport: NewTransport(nil, tt.policy)}
+			req, err := http.NewRequest(tt.method, server.URL, nil)
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, tt.wantStatus, resp.StatusCode)
+			require.Equal(t, tt.wantAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestTransport_RoundTripBoundedByContext(t *testing.T) {
+	attempts := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Retry-After is within the max delay, so the attempt is given up for the context only.
+	policy := Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Minute}
+	client := http.Client{Transport: NewTransport(nil, policy)}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestTransport_RoundTripThrottled(t *testing.T) {
+	attempts := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	quota := throttle.NewQuota(2, nil, time.UTC)
+	client := http.Client{
+		Transport: NewTransport(throttle.NewTransport(nil, throttle.NewLimiter(1000, 10), quota), testPolicy),
+	}
+
+	_, err := client.Get(server.URL)
+	require.ErrorIs(t, err, throttle.QuotaExceededError)
+	require.Equal(t, int32(2), attempts.Load())
+	require.Equal(t, 2, quota.Used())
+}
+
+func TestTransport_delay(t *testing.T) {
+	transport := NewTransport(nil, Policy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second})
+	transport.jitter = func(d time.Duration) time.Duration { return d }
+
+	tests := []struct {
+		attempt   int
+		resp      *http.Response
+		wantDelay time.Duration
+		wantOK    bool
+	}{
+		{attempt: 1, wantDelay: 100 * time.Millisecond, wantOK: true},
+		{attempt: 2, wantDelay: 200 * time.Millisecond, wantOK: true},
+		{attempt: 3, wantDelay: 400 * time.Millisecond, wantOK: true},
+		{attempt: 5, wantDelay: time.Second, wantOK: true},
+		{
+			attempt:   1,
+			resp:      &http.Response{Header: http.Header{"Retry-After": {"1"}}},
+			wantDelay: time.Second,
+			wantOK:    true,
+		},
+		{
+			attempt:   1,
+			resp:      &http.Response{Header: http.Header{"Retry-After": {"3"}}},
+			wantDelay: 3 * time.Second,
+			wantOK:    false,
+		},
+	}
+	for _, tt := range tests {
+		delay, ok := transport.delay(tt.attempt, tt.resp)
+		require.Equal(t, tt.wantDelay, delay)
+		require.Equal(t, tt.wantOK, ok)
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	delay, ok := parseRetryAfter("120")
+	require.True(t, ok)
+	require.Equal(t, 2*time.Minute, delay)
+
+	delay, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+	require.Zero(t, delay)
+
+	_, ok = parseRetryAfter("soon")
+	require.False(t, ok)
+
+	_, ok = parseRetryAfter("")
+	require.False(t, ok)
+}
@@ -0,0 +1,274 @@
+package soundcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	defaultAPIURL = "https://api-v2.soundcloud.com"
+
+	tracksMaxIDs = 50
+)
+
+var (
+	NotFoundError = errors.New("not found")
+)
+
+type Client interface {
+	FetchTrack(ctx context.Context, id string) (*Track, error)
+	SearchTrack(ctx context.Context, query string) (*Track, error)
+	SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error)
+	FetchPlaylist(ctx context.Context, id string) (*Playlist, error)
+	SearchAlbum(ctx context.Context, query string) (*Playlist, error)
+	SearchAlbums(ctx context.Context, query string, limit int) ([]*Playlist, error)
+	FetchUser(ctx context.Context, id string) (*User, error)
+	SearchUser(ctx context.Context, query string) (*User, error)
+}
+
+type HTTPClient struct {
+	apiURL      string
+	clientID    string
+	httpClient  *http.Client
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
+}
+
+type tracksPage struct {
+	Collection []*Track `json:"collection"`
+}
+
+type playlistsPage struct {
+	Collection []*Playlist `json:"collection"`
+}
+
+type usersPage struct {
+	Collection []*User `json:"collection"`
+}
+
+type kindResponse struct {
+	Kind string `json:"kind"`
+}
+
+func NewHTTPClient(clientID string, opts ...ClientOption) *HTTPClient {
+	c := HTTPClient{
+		apiURL:      defaultAPIURL,
+		clientID:    clientID,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
+
+	return &c
+}
+
+// FetchTrack accepts either the numeric ID or the permalink path detected in the link.
+func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error) {
+	track := Track{}
+	if err := c.fetch(ctx, "/tracks/", trackKind, id, &track); err != nil {
+		return nil, err
+	}
+	return &track, nil
+}
+
+func (c *HTTPClient) SearchTrack(ctx context.Context, query string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error) {
+	body, err := c.getAPI(ctx, "/search/tracks", searchQuery(query, limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	page := tracksPage{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(page.Collection) == 0 {
+		return nil, NotFoundError
+	}
+
+	return page.Collection[:min(limit, len(page.Collection))], nil
+}
+
+// FetchPlaylist returns the set with every track loaded; the API only includes the first
+// tracks in full and leaves the rest as ID stubs.
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	playlist := Playlist{}
+	if err := c.fetch(ctx, "/playlists/", playlistKind, id, &playlist); err != nil {
+		return nil, err
+	}
+	if err := c.loadTracks(ctx, playlist.Tracks); err != nil {
+		return nil, fmt.Errorf("failed to load playlist tracks: %w", err)
+	}
+	return &playlist, nil
+}
+
+func (c *HTTPClient) SearchAlbum(ctx context.Context, query string) (*Playlist, error) {
+	albums, err := c.SearchAlbums(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) SearchAlbums(ctx context.Context, query string, limit int) ([]*Playlist, error) {
+	body, err := c.getAPI(ctx, "/search/albums", searchQuery(query, limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	page := playlistsPage{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(page.Collection) == 0 {
+		return nil, NotFoundError
+	}
+
+	return page.Collection[:min(limit, len(page.Collection))], nil
+}
+
+func (c *HTTPClient) FetchUser(ctx context.Context, id string) (*User, error) {
+	user := User{}
+	if err := c.fetch(ctx, "/users/", userKind, id, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (c *HTTPClient) SearchUser(ctx context.Context, query string) (*User, error) {
+	body, err := c.getAPI(ctx, "/search/users", searchQuery(query, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	page := usersPage{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(page.Collection) == 0 {
+		return nil, NotFoundError
+	}
+
+	return page.Collection[0], nil
+}
+
+// fetch gets the entity by numeric ID or resolves its permalink, making sure the
+// resolved entity is of the expected kind.
+func (c *HTTPClient) fetch(ctx context.Context, path, kind, id string, v any) error {
+	var (
+		body []byte
+		err  error
+	)
+	switch _, convErr := strconv.Atoi(id); {
+	case convErr == nil:
+		body, err = c.getAPI(ctx, path+id, url.Values{})
+	default:
+		body, err = c.getAPI(ctx, "/resolve", url.Values{"url": []string{baseURL + id}})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get api: %w", err)
+	}
+
+	kr := kindResponse{}
+	if err := json.Unmarshal(body, &kr); err != nil {
+		return apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if kr.Kind != kind {
+		return NotFoundError
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	return nil
+}
+
+// loadTracks replaces the ID stubs in place with tracks fetched in batches.
+func (c *HTTPClient) loadTracks(ctx context.Context, tracks []*Track) error {
+	stubs := map[int][]int{}
+	ids := []string{}
+	for i, track := range tracks {
+		if track.Title != "" {
+			continue
+		}
+		if _, ok := stubs[track.ID]; !ok {
+			ids = append(ids, strconv.Itoa(track.ID))
+		}
+		stubs[track.ID] = append(stubs[track.ID], i)
+	}
+
+	for start := 0; start < len(ids); start += tracksMaxIDs {
+		batch := ids[start:min(start+tracksMaxIDs, len(ids))]
+		body, err := c.getAPI(ctx, "/tracks", url.Values{"ids": []string{strings.Join(batch, ",")}})
+		if err != nil {
+			return fmt.Errorf("failed to get api: %w", err)
+		}
+
+		loaded := []*Track{}
+		if err := json.Unmarshal(body, &loaded); err != nil {
+			return apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+		}
+		for _, track := range loaded {
+			for _, i := range stubs[track.ID] {
+				tracks[i] = track
+			}
+		}
+	}
+	return nil
+}
+
+func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	query.Set("client_id", c.clientID)
+	u := fmt.Sprintf("%s%s?%s", c.apiURL, path, query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, NotFoundError
+	default:
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+}
+
+func searchQuery(query string, limit int) url.Values {
+	return url.Values{
+		"q":     []string{query},
+		"limit": []string{strconv.Itoa(limit)},
+	}
+}
@@ -0,0 +1,281 @@
+package soundcloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+
+	"github.com/stretchr/testify/require"
+)
+
+const sampleClientID = "sampleClientID"
+
+const sampleTrackResponse = `{
+	"id": 261325553,
+	"kind": "track",
+	"title": "Never Be Like You feat. Kai",
+	"permalink_url": "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+	"duration": 233000,
+	"artwork_url": "https://i1.sndcdn.com/artworks-sample-large.jpg",
+	"release_date": "2016-05-27T00:00:00Z",
+	"user": {"id": 2976616, "kind": "user", "username": "Flume", "permalink_url": "https://soundcloud.com/flume"},
+	"publisher_metadata": {"artist": "Flume", "isrc": "AUFF01500360", "explicit": false}
+}`
+
+var sampleTrack = &Track{
+	ID:           261325553,
+	Kind:         "track",
+	Title:        "Never Be Like You feat. Kai",
+	PermalinkURL: "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+	Duration:     233000,
+	ArtworkURL:   "https://i1.sndcdn.com/artworks-sample-large.jpg",
+	ReleaseDate:  "2016-05-27T00:00:00Z",
+	User: &User{
+		ID:           2976616,
+		Kind:         "user",
+		Username:     "Flume",
+		PermalinkURL: "https://soundcloud.com/flume",
+	},
+	PublisherMetadata: &PublisherMetadata{Artist: "Flume", ISRC: "AUFF01500360"},
+}
+
+func TestHTTPClient_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    *Track
+		wantErr error
+	}{
+		{
+			name: "when resolved by permalink",
+			id:   "flume/never-be-like-you-feat-kai",
+			want: sampleTrack,
+		},
+		{
+			name: "when fetched by numeric ID",
+			id:   "261325553",
+			want: sampleTrack,
+		},
+		{
+			name:    "when permalink is not a track",
+			id:      "flume/sets/skin",
+			wantErr: NotFoundError,
+		},
+		{
+			name:    "when not found",
+			id:      "flume/unknown",
+			wantErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, sampleClientID, r.URL.Query().Get("client_id"))
+
+				var response string
+				switch {
+				case r.URL.Path == "/tracks/261325553":
+					response = sampleTrackResponse
+				case r.URL.Path == "/resolve" &&
+					r.URL.Query().Get("url") == "https://soundcloud.com/flume/never-be-like-you-feat-kai":
+					response = sampleTrackResponse
+				case r.URL.Path == "/resolve" && r.URL.Query().Get("url") == "https://soundcloud.com/flume/sets/skin":
+					response = `{"id": 1, "kind": "playlist", "title": "Skin"}`
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, err := w.Write([]byte(response))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(sampleClientID, WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchTrack(ctx, tt.id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/search/tracks", r.URL.Path)
+		require.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		response := `{"collection": []}`
+		if r.URL.Query().Get("q") == "Flume Never Be Like You" {
+			response = `{"collection": [
+				{"id": 1, "kind": "track", "title": "Never Be Like You feat. Kai"},
+				{"id": 2, "kind": "track", "title": "Never Be Like You (Remix)"},
+				{"id": 3, "kind": "track", "title": "Never Be Like You (Cover)"}
+			]}`
+		}
+		_, err := w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(sampleClientID, WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchTracks(ctx, "Flume Never Be Like You", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Track{
+		{ID: 1, Kind: "track", Title: "Never Be Like You feat. Kai"},
+		{ID: 2, Kind: "track", Title: "Never Be Like You (Remix)"},
+	}, result)
+
+	_, err = client.SearchTracks(ctx, "unknown", 2)
+	require.ErrorIs(t, err, NotFoundError)
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+		switch r.URL.Path {
+		case "/resolve":
+			require.Equal(t, "https://soundcloud.com/flume/sets/skin", r.URL.Query().Get("url"))
+			response = `{
+				"id": 212406553,
+				"kind": "playlist",
+				"title": "Skin",
+				"permalink_url": "https://soundcloud.com/flume/sets/skin",
+				"is_album": true,
+				"set_type": "album",
+				"tracks": [
+					{"id": 1, "kind": "track", "title": "Helix"},
+					{"id": 2, "kind": "track"},
+					{"id": 3, "kind": "track"}
+				]
+			}`
+		case "/tracks":
+			require.Equal(t, "2,3", r.URL.Query().Get("ids"))
+			response = `[
+				{"id": 3, "kind": "track", "title": "Smoke & Retribution"},
+				{"id": 2, "kind": "track", "title": "Never Be Like You"}
+			]`
+		default:
+			require.Fail(t, "unexpected path", r.URL.Path)
+		}
+		_, err := w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(sampleClientID, WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.FetchPlaylist(ctx, "flume/sets/skin")
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{
+		ID:           212406553,
+		Kind:         "playlist",
+		Title:        "Skin",
+		PermalinkURL: "https://soundcloud.com/flume/sets/skin",
+		IsAlbum:      true,
+		SetType:      "album",
+		Tracks: []*Track{
+			{ID: 1, Kind: "track", Title: "Helix"},
+			{ID: 2, Kind: "track", Title: "Never Be Like You"},
+			{ID: 3, Kind: "track", Title: "Smoke & Retribution"},
+		},
+	}, result)
+}
+
+func TestHTTPClient_SearchAlbums(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/search/albums", r.URL.Path)
+		require.Equal(t, "Flume Skin", r.URL.Query().Get("q"))
+		_, err := w.Write([]byte(`{"collection": [{"id": 212406553, "kind": "playlist", "title": "Skin", "is_album": true}]}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(sampleClientID, WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchAlbum(ctx, "Flume Skin")
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{ID: 212406553, Kind: "playlist", Title: "Skin", IsAlbum: true}, result)
+}
+
+func TestHTTPClient_FetchUser(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/resolve", r.URL.Path)
+		require.Equal(t, "https://soundcloud.com/flume", r.URL.Query().Get("url"))
+		_, err := w.Write([]byte(`{"id": 2976616, "kind": "user", "username": "Flume", "permalink_url": "https://soundcloud.com/flume"}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(sampleClientID, WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.FetchUser(ctx, "flume")
+	require.NoError(t, err)
+	require.Equal(t, &User{ID: 2976616, Kind: "user", Username: "Flume", PermalinkURL: "https://soundcloud.com/flume"}, result)
+}
+
+func TestHTTPClient_SearchUser(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/search/users", r.URL.Path)
+		response := `{"collection": []}`
+		if r.URL.Query().Get("q") == "Flume" {
+			response = `{"collection": [{"id": 2976616, "kind": "user", "username": "Flume"}]}`
+		}
+		_, err := w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(sampleClientID, WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchUser(ctx, "Flume")
+	require.NoError(t, err)
+	require.Equal(t, &User{ID: 2976616, Kind: "user", Username: "Flume"}, result)
+
+	_, err = client.SearchUser(ctx, "unknown")
+	require.ErrorIs(t, err, NotFoundError)
+}
+
+func TestHTTPClient_InvalidClientID(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient("invalid", WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.FetchTrack(ctx, "flume/never-be-like-you-feat-kai")
+	require.ErrorIs(t, err, apierr.UnauthorizedError)
+	require.Nil(t, result)
+}
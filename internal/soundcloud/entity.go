@@ -0,0 +1,166 @@
+package soundcloud
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	baseURL = "https://soundcloud.com/"
+
+	trackKind    = "track"
+	playlistKind = "playlist"
+	userKind     = "user"
+
+	artworkSize = "t500x500"
+)
+
+var (
+	TrackRe  = regexp.MustCompile(`https://(?:www\.|m\.)?soundcloud\.com/([\w-]+)/([\w-]+)/?(?:[?#]\S*)?$`)
+	SetRe    = regexp.MustCompile(`https://(?:www\.|m\.)?soundcloud\.com/([\w-]+)/sets/([\w-]+)/?(?:[?#]\S*)?$`)
+	ArtistRe = regexp.MustCompile(`https://(?:www\.|m\.)?soundcloud\.com/([\w-]+)/?(?:[?#]\S*)?$`)
+
+	// reservedTrackSlugs are profile tabs sharing the track URL shape.
+	reservedTrackSlugs = map[string]bool{
+		"albums": true, "comments": true, "followers": true, "following": true, "likes": true,
+		"popular-tracks": true, "reposts": true, "sets": true, "spotlight": true, "tracks": true,
+	}
+	// reservedUserSlugs are site sections sharing the artist URL shape.
+	reservedUserSlugs = map[string]bool{
+		"charts": true, "discover": true, "feed": true, "mobile": true, "pages": true, "pro": true,
+		"search": true, "settings": true, "stream": true, "terms-of-use": true, "upload": true, "you": true,
+	}
+)
+
+type Track struct {
+	ID                int                `json:"id"`
+	Kind              string             `json:"kind"`
+	Title             string             `json:"title"`
+	PermalinkURL      string             `json:"permalink_url"`
+	Duration          int                `json:"duration"`
+	ArtworkURL        string             `json:"artwork_url"`
+	ReleaseDate       string             `json:"release_date"`
+	DisplayDate       string             `json:"display_date"`
+	User              *User              `json:"user,omitempty"`
+	PublisherMetadata *PublisherMetadata `json:"publisher_metadata,omitempty"`
+}
+
+type PublisherMetadata struct {
+	Artist     string `json:"artist"`
+	AlbumTitle string `json:"album_title"`
+	ISRC       string `json:"isrc"`
+	UPC        string `json:"upc_or_ean"`
+	Explicit   bool   `json:"explicit"`
+}
+
+type Playlist struct {
+	ID           int      `json:"id"`
+	Kind         string   `json:"kind"`
+	Title        string   `json:"title"`
+	PermalinkURL string   `json:"permalink_url"`
+	ArtworkURL   string   `json:"artwork_url"`
+	ReleaseDate  string   `json:"release_date"`
+	DisplayDate  string   `json:"display_date"`
+	IsAlbum      bool     `json:"is_album"`
+	SetType      string   `json:"set_type"`
+	User         *User    `json:"user,omitempty"`
+	Tracks       []*Track `json:"tracks"`
+}
+
+type User struct {
+	ID           int    `json:"id"`
+	Kind         string `json:"kind"`
+	Username     string `json:"username"`
+	PermalinkURL string `json:"permalink_url"`
+}
+
+// on.soundcloud.com short links may point to a track or a set, so they are left to be
+// expanded by following the redirect.
+func DetectTrackID(trackURL string) string {
+	match := TrackRe.FindStringSubmatch(trackURL)
+	if len(match) < 3 || reservedUserSlugs[match[1]] || reservedTrackSlugs[match[2]] {
+		return ""
+	}
+	return match[1] + "/" + match[2]
+}
+
+func DetectSetID(setURL string) string {
+	match := SetRe.FindStringSubmatch(setURL)
+	if len(match) < 3 || reservedUserSlugs[match[1]] {
+		return ""
+	}
+	return match[1] + "/sets/" + match[2]
+}
+
+// Sets are detected as albums by DetectSetID.
+func DetectPlaylistID(_ string) string {
+	return ""
+}
+
+func DetectArtistID(artistURL string) string {
+	match := ArtistRe.FindStringSubmatch(artistURL)
+	if len(match) < 2 || reservedUserSlugs[match[1]] {
+		return ""
+	}
+	return match[1]
+}
+
+func (t *Track) URL() string {
+	return t.PermalinkURL
+}
+
+// Path returns the permalink path used as the entity ID.
+func (t *Track) Path() string {
+	return permalinkPath(t.PermalinkURL)
+}
+
+// Artist prefers the artist set by the label over the name of the uploading account.
+func (t *Track) Artist() string {
+	if t.PublisherMetadata != nil && t.PublisherMetadata.Artist != "" {
+		return t.PublisherMetadata.Artist
+	}
+	if t.User != nil {
+		return t.User.Username
+	}
+	return ""
+}
+
+func (t *Track) Artwork() string {
+	return artwork(t.ArtworkURL)
+}
+
+func (p *Playlist) URL() string {
+	return p.PermalinkURL
+}
+
+func (p *Playlist) Path() string {
+	return permalinkPath(p.PermalinkURL)
+}
+
+func (p *Playlist) Artist() string {
+	if p.User != nil {
+		return p.User.Username
+	}
+	return ""
+}
+
+func (p *Playlist) Artwork() string {
+	return artwork(p.ArtworkURL)
+}
+
+func (u *User) URL() string {
+	return u.PermalinkURL
+}
+
+func (u *User) Path() string {
+	return permalinkPath(u.PermalinkURL)
+}
+
+func permalinkPath(permalinkURL string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(permalinkURL, "https://"), "soundcloud.com/")
+}
+
+// artwork replaces the default 100x100 size with the largest one available for every artwork.
+func artwork(artworkURL string) string {
+	return strings.Replace(artworkURL, "-large.", "-"+artworkSize+".", 1)
+}
@@ -0,0 +1,115 @@
+package soundcloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DetectTrackID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+			expected: "flume/never-be-like-you-feat-kai",
+		},
+		{
+			name:     "Valid URL with query",
+			inputURL: "https://soundcloud.com/flume/never-be-like-you-feat-kai?utm_source=clipboard&in=flume/sets/skin",
+			expected: "flume/never-be-like-you-feat-kai",
+		},
+		{
+			name:     "Valid mobile URL",
+			inputURL: "https://m.soundcloud.com/flume/never-be-like-you-feat-kai",
+			expected: "flume/never-be-like-you-feat-kai",
+		},
+		{
+			name:     "Short link",
+			inputURL: "https://on.soundcloud.com/AbC123xYz",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Profile tab",
+			inputURL: "https://soundcloud.com/flume/tracks",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Set",
+			inputURL: "https://soundcloud.com/flume/sets/skin",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Site section",
+			inputURL: "https://soundcloud.com/discover/sets",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Host",
+			inputURL: "https://example.com/flume/never-be-like-you",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectTrackID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectSetID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://soundcloud.com/flume/sets/skin",
+			expected: "flume/sets/skin",
+		},
+		{
+			name:     "Valid URL with query",
+			inputURL: "https://soundcloud.com/flume/sets/skin?si=123",
+			expected: "flume/sets/skin",
+		},
+		{
+			name:     "Invalid URL - Track",
+			inputURL: "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectSetID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectArtistID(t *testing.T) {
+	require.Equal(t, "flume", DetectArtistID("https://soundcloud.com/flume"))
+	require.Equal(t, "flume", DetectArtistID("https://soundcloud.com/flume/"))
+	require.Equal(t, "", DetectArtistID("https://soundcloud.com/discover"))
+	require.Equal(t, "", DetectArtistID("https://soundcloud.com/flume/never-be-like-you-feat-kai"))
+}
+
+func TestTrack_Artist(t *testing.T) {
+	track := Track{User: &User{Username: "Flume"}}
+	require.Equal(t, "Flume", track.Artist())
+
+	track.PublisherMetadata = &PublisherMetadata{Artist: "Flume feat. Kai"}
+	require.Equal(t, "Flume feat. Kai", track.Artist())
+}
+
+func TestTrack_Path(t *testing.T) {
+	track := Track{PermalinkURL: "https://soundcloud.com/flume/never-be-like-you-feat-kai"}
+	require.Equal(t, "flume/never-be-like-you-feat-kai", track.Path())
+}
+
+func TestTrack_Artwork(t *testing.T) {
+	track := Track{ArtworkURL: "https://i1.sndcdn.com/artworks-000145394318-wl7b0n-large.jpg"}
+	require.Equal(t, "https://i1.sndcdn.com/artworks-000145394318-wl7b0n-t500x500.jpg", track.Artwork())
+}
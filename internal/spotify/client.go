@@ -9,12 +9,21 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
 )
 
 const (
 	defaultAuthURL = "https://accounts.spotify.com"
 	defaultAPIURL  = "https://api.spotify.com"
+
+	playlistTracksPageLimit = 100
 )
 
 var (
@@ -25,8 +34,15 @@ var (
 type Client interface {
 	FetchTrack(ctx context.Context, id string) (*Track, error)
 	SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error)
+	SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error)
+	SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error)
 	FetchAlbum(ctx context.Context, id string) (*Album, error)
 	SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error)
+	SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error)
+	SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error)
+	FetchArtist(ctx context.Context, id string) (*Artist, error)
+	SearchArtist(ctx context.Context, artistName string) (*Artist, error)
+	FetchPlaylist(ctx context.Context, id string) (*Playlist, error)
 }
 
 type HTTPClient struct {
@@ -34,12 +50,17 @@ type HTTPClient struct {
 	apiURL      string
 	httpClient  *http.Client
 	credentials *Credentials
+	tokenMu     sync.Mutex
 	token       *token
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
 }
 
 type searchResult struct {
-	Tracks tracksSection `json:"tracks"`
-	Albums albumsSection `json:"albums"`
+	Tracks  tracksSection  `json:"tracks"`
+	Albums  albumsSection  `json:"albums"`
+	Artists artistsSection `json:"artists"`
 }
 
 type tracksSection struct {
@@ -50,6 +71,24 @@ type albumsSection struct {
 	Items []*Album `json:"items"`
 }
 
+type artistsSection struct {
+	Items []*Artist `json:"items"`
+}
+
+type playlistResponse struct {
+	Playlist
+	Tracks playlistTracksPage `json:"tracks"`
+}
+
+type playlistTracksPage struct {
+	Items []playlistItem `json:"items"`
+	Next  string         `json:"next"`
+}
+
+type playlistItem struct {
+	Track *Track `json:"track"`
+}
+
 type apiError struct {
 	Status  int    `json:"status"`
 	Message string `json:"message"`
@@ -65,11 +104,16 @@ func NewHTTPClient(credentials *Credentials, opts ...ClientOption) *HTTPClient {
 		apiURL:      defaultAPIURL,
 		credentials: credentials,
 		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
 	}
 
 	for _, opt := range opts {
 		opt(&c)
 	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
 
 	return &c
 }
@@ -87,7 +131,7 @@ func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error)
 
 	track := Track{}
 	if err := json.Unmarshal(body, &track); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 
 	return &track, nil
@@ -96,9 +140,83 @@ func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error)
 // https://developer.spotify.com/documentation/web-api/reference/search
 func (c *HTTPClient) SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error) {
 	q := fmt.Sprintf("artist:%s track:%s", artistName, trackName)
+	return c.searchTrack(ctx, q)
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/search
+func (c *HTTPClient) SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error) {
+	q := fmt.Sprintf("artist:%s track:%s", artistName, trackName)
+	return c.searchTracks(ctx, q, limit)
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/search
+func (c *HTTPClient) SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error) {
+	q := fmt.Sprintf("isrc:%s", isrc)
+	return c.searchTrack(ctx, q)
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/get-an-album
+func (c *HTTPClient) FetchAlbum(ctx context.Context, id string) (*Album, error) {
+	path := fmt.Sprintf("/v1/albums/%s", id)
+	body, err := c.getAPI(ctx, path, nil)
+	if err != nil {
+		if errors.Is(err, invalidIDError) {
+			return nil, NotFoundError
+		}
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	album := Album{}
+	if err := json.Unmarshal(body, &album); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+
+	return &album, nil
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/search
+func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error) {
+	q := fmt.Sprintf("artist:%s album:%s", artistName, albumName)
+	return c.searchAlbum(ctx, q)
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/search
+func (c *HTTPClient) SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error) {
+	q := fmt.Sprintf("artist:%s album:%s", artistName, albumName)
+	return c.searchAlbums(ctx, q, limit)
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/search
+func (c *HTTPClient) SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error) {
+	q := fmt.Sprintf("upc:%s", upc)
+	return c.searchAlbum(ctx, q)
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/get-an-artist
+func (c *HTTPClient) FetchArtist(ctx context.Context, id string) (*Artist, error) {
+	path := fmt.Sprintf("/v1/artists/%s", id)
+	body, err := c.getAPI(ctx, path, nil)
+	if err != nil {
+		if errors.Is(err, invalidIDError) {
+			return nil, NotFoundError
+		}
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	artist := Artist{}
+	if err := json.Unmarshal(body, &artist); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+
+	return &artist, nil
+}
+
+// https://developer.spotify.com/documentation/web-api/reference/search
+func (c *HTTPClient) SearchArtist(ctx context.Context, artistName string) (*Artist, error) {
+	q := fmt.Sprintf("artist:%s", artistName)
 	body, err := c.getAPI(ctx, "/v1/search", url.Values{
 		"q":     []string{q},
-		"type":  []string{"track"},
+		"type":  []string{"artist"},
 		"limit": []string{"1"},
 	})
 	if err != nil {
@@ -107,18 +225,19 @@ func (c *HTTPClient) SearchTrack(ctx context.Context, artistName, trackName stri
 
 	sr := searchResult{}
 	if err := json.Unmarshal(body, &sr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
-	if len(sr.Tracks.Items) == 0 {
+	if len(sr.Artists.Items) == 0 {
 		return nil, NotFoundError
 	}
 
-	return sr.Tracks.Items[0], nil
+	return sr.Artists.Items[0], nil
 }
 
-// https://developer.spotify.com/documentation/web-api/reference/get-an-album
-func (c *HTTPClient) FetchAlbum(ctx context.Context, id string) (*Album, error) {
-	path := fmt.Sprintf("/v1/albums/%s", id)
+// https://developer.spotify.com/documentation/web-api/reference/get-playlist
+// https://developer.spotify.com/documentation/web-api/reference/get-playlists-tracks
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	path := fmt.Sprintf("/v1/playlists/%s", id)
 	body, err := c.getAPI(ctx, path, nil)
 	if err != nil {
 		if errors.Is(err, invalidIDError) {
@@ -127,21 +246,84 @@ func (c *HTTPClient) FetchAlbum(ctx context.Context, id string) (*Album, error)
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 
-	album := Album{}
-	if err := json.Unmarshal(body, &album); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	pr := playlistResponse{}
+	if err := json.Unmarshal(body, &pr); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 
-	return &album, nil
+	playlist := pr.Playlist
+	page := pr.Tracks
+	offset := 0
+	for {
+		for _, item := range page.Items {
+			if item.Track != nil {
+				playlist.Tracks = append(playlist.Tracks, item.Track)
+			}
+		}
+		offset += len(page.Items)
+		if page.Next == "" || len(page.Items) == 0 {
+			break
+		}
+
+		body, err = c.getAPI(ctx, path+"/tracks", url.Values{
+			"offset": []string{strconv.Itoa(offset)},
+			"limit":  []string{strconv.Itoa(playlistTracksPageLimit)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		page = playlistTracksPage{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+		}
+	}
+
+	return &playlist, nil
 }
 
-// https://developer.spotify.com/documentation/web-api/reference/search
-func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error) {
-	q := fmt.Sprintf("artist:%s album:%s", artistName, albumName)
+func (c *HTTPClient) searchTrack(ctx context.Context, q string) (*Track, error) {
+	tracks, err := c.searchTracks(ctx, q, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) searchTracks(ctx context.Context, q string, limit int) ([]*Track, error) {
+	body, err := c.getAPI(ctx, "/v1/search", url.Values{
+		"q":     []string{q},
+		"type":  []string{"track"},
+		"limit": []string{strconv.Itoa(limit)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	sr := searchResult{}
+	if err := json.Unmarshal(body, &sr); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if len(sr.Tracks.Items) == 0 {
+		return nil, NotFoundError
+	}
+
+	return sr.Tracks.Items, nil
+}
+
+func (c *HTTPClient) searchAlbum(ctx context.Context, q string) (*Album, error) {
+	albums, err := c.searchAlbums(ctx, q, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) searchAlbums(ctx context.Context, q string, limit int) ([]*Album, error) {
 	body, err := c.getAPI(ctx, "/v1/search", url.Values{
 		"q":     []string{q},
 		"type":  []string{"album"},
-		"limit": []string{"1"},
+		"limit": []string{strconv.Itoa(limit)},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
@@ -149,13 +331,13 @@ func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName stri
 
 	sr := searchResult{}
 	if err := json.Unmarshal(body, &sr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 	if len(sr.Albums.Items) == 0 {
 		return nil, NotFoundError
 	}
 
-	return sr.Albums.Items[0], nil
+	return sr.Albums.Items, nil
 }
 
 func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values) ([]byte, error) {
@@ -167,16 +349,20 @@ func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values)
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
-		c.token = nil
+		c.resetToken(resp.Request.Header.Get("Authorization"))
 		resp, err = c.requestWithToken(ctx, u)
 		if err != nil {
 			return nil, fmt.Errorf("failed to send request: %w", err)
 		}
+		defer resp.Body.Close()
 	}
 
 	if resp.StatusCode == http.StatusBadRequest {
 		return nil, invalidIDError
 	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, NotFoundError
+	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -186,9 +372,9 @@ func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values)
 	if resp.StatusCode != http.StatusOK {
 		er := errorResponse{}
 		if err := json.Unmarshal(body, &er); err != nil {
-			return nil, fmt.Errorf("failed to load error response")
+			return nil, apierr.FromStatus(resp.StatusCode)
 		}
-		return nil, fmt.Errorf("unexpected API response: %d %s", er.Error.Status, er.Error.Message)
+		return nil, fmt.Errorf("unexpected API response: %s: %w", er.Error.Message, statusError(resp.StatusCode, er.Error))
 	}
 
 	return body, nil
@@ -211,6 +397,13 @@ func (c *HTTPClient) fetchToken(ctx context.Context) (*token, error) {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		return nil, &apierr.StatusError{StatusCode: resp.StatusCode, Err: apierr.UnauthorizedError}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
@@ -218,25 +411,32 @@ func (c *HTTPClient) fetchToken(ctx context.Context) (*token, error) {
 
 	result := token{}
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 	result.fetchedAt = time.Now()
 	return &result, nil
 }
 
+// statusError classifies the API error, telling apart tracks unavailable in the market
+// from the other forbidden responses.
+func statusError(statusCode int, ae apiError) error {
+	if statusCode == http.StatusForbidden && strings.Contains(ae.Message, "unavailable in this country") {
+		return &apierr.StatusError{StatusCode: statusCode, Err: apierr.RegionRestrictedError}
+	}
+	return apierr.FromStatus(statusCode)
+}
+
 func (c *HTTPClient) requestWithToken(ctx context.Context, url string) (*http.Response, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	if c.token == nil || c.token.isExpired() {
-		c.token, err = c.fetchToken(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch token: %w", err)
-		}
+	authHeader, err := c.authHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token: %w", err)
 	}
-	req.Header.Set("Authorization", c.token.authHeader())
+	req.Header.Set("Authorization", authHeader)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -244,3 +444,30 @@ func (c *HTTPClient) requestWithToken(ctx context.Context, url string) (*http.Re
 	}
 	return resp, nil
 }
+
+// authHeader returns the authorization header of the cached token, fetching a new token
+// when there is none or it is expired. Concurrent requests wait for a single fetch.
+func (c *HTTPClient) authHeader(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == nil || c.token.isExpired() {
+		t, err := c.fetchToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.token = t
+	}
+	return c.token.authHeader(), nil
+}
+
+// resetToken drops the cached token rejected with the authorization header, unless another
+// request has replaced it already.
+func (c *HTTPClient) resetToken(authHeader string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != nil && c.token.authHeader() == authHeader {
+		c.token = nil
+	}
+}
@@ -3,11 +3,16 @@ package spotify
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,7 +42,18 @@ func TestHTTPClient_FetchTrack(t *testing.T) {
 		_, err := w.Write([]byte(`{
 			"id": "sampletrackid",
 			"artists": [{"name": "Sample Artist"}],
-			"name": "Sample Track"
+			"name": "Sample Track",
+			"external_ids": {"isrc": "USUM71703861"},
+			"duration_ms": 215000,
+			"explicit": true,
+			"track_number": 3,
+			"disc_number": 1,
+			"album": {
+				"id": "samplealbumid",
+				"name": "Sample Album",
+				"release_date": "2017-06-23",
+				"images": [{"url": "https://i.scdn.co/image/sample", "width": 640, "height": 640}]
+			}
 		}`))
 		require.NoError(t, err)
 	}))
@@ -61,7 +77,20 @@ func TestHTTPClient_FetchTrack(t *testing.T) {
 				Name: "Sample Artist",
 			},
 		},
-		Name: "Sample Track",
+		Name:        "Sample Track",
+		ExternalIDs: ExternalIDs{ISRC: "USUM71703861"},
+		DurationMS:  215000,
+		Explicit:    true,
+		TrackNumber: 3,
+		DiscNumber:  1,
+		Album: &Album{
+			ID:          "samplealbumid",
+			Name:        "Sample Album",
+			ReleaseDate: "2017-06-23",
+			Images: []Image{
+				{URL: "https://i.scdn.co/image/sample", Width: 640, Height: 640},
+			},
+		},
 	}, track)
 }
 
@@ -111,6 +140,124 @@ func TestHTTPClient_SearchTrack(t *testing.T) {
 	}, track)
 }
 
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, r.URL.Path, "/v1/search")
+		require.Equal(t, r.URL.Query().Get("q"), "artist:Sample Artist track:Sample Track")
+		require.Equal(t, r.URL.Query().Get("type"), "track")
+		require.Equal(t, r.URL.Query().Get("limit"), "2")
+		_, err := w.Write([]byte(`{
+			"tracks": {
+				"items": [
+					{"id": "firsttrackid", "artists": [{"name": "Sample Artist"}], "name": "Sample Track (Live)"},
+					{"id": "secondtrackid", "artists": [{"name": "Sample Artist"}], "name": "Sample Track"}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tracks, err := client.SearchTracks(ctx, "Sample Artist", "Sample Track", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Track{
+		{ID: "firsttrackid", Artists: []Artist{{Name: "Sample Artist"}}, Name: "Sample Track (Live)"},
+		{ID: "secondtrackid", Artists: []Artist{{Name: "Sample Artist"}}, Name: "Sample Track"},
+	}, tracks)
+}
+
+func TestHTTPClient_SearchAlbums(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, r.URL.Path, "/v1/search")
+		require.Equal(t, r.URL.Query().Get("q"), "artist:Sample Artist album:Sample Album")
+		require.Equal(t, r.URL.Query().Get("type"), "album")
+		require.Equal(t, r.URL.Query().Get("limit"), "5")
+		_, err := w.Write([]byte(`{
+			"albums": {
+				"items": []
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	albums, err := client.SearchAlbums(ctx, "Sample Artist", "Sample Album", 5)
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, albums)
+}
+
+func TestHTTPClient_SearchTrackByISRC(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, r.URL.Path, "/v1/search")
+		require.Equal(t, r.URL.Query().Get("q"), "isrc:USUM71703861")
+		require.Equal(t, r.URL.Query().Get("type"), "track")
+		_, err := w.Write([]byte(`{
+			"tracks": {
+				"items": [{
+					"id": "sampletrackid",
+					"artists": [{"name": "Sample Artist"}],
+					"name": "Sample Track",
+					"external_ids": {"isrc": "USUM71703861"}
+				}]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.SearchTrackByISRC(ctx, "USUM71703861")
+	require.NoError(t, err)
+	require.Equal(t, &Track{
+		ID: "sampletrackid",
+		Artists: []Artist{
+			{
+				Name: "Sample Artist",
+			},
+		},
+		Name:        "Sample Track",
+		ExternalIDs: ExternalIDs{ISRC: "USUM71703861"},
+	}, track)
+}
+
 func TestHTTPClient_FetchAlbum(t *testing.T) {
 	mockAuthServer := newAuthServerMock(t)
 	defer mockAuthServer.Close()
@@ -198,6 +345,178 @@ func TestHTTPClient_SearchAlbum(t *testing.T) {
 	}, album)
 }
 
+func TestHTTPClient_SearchAlbumByUPC(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, r.URL.Path, "/v1/search")
+		require.Equal(t, r.URL.Query().Get("q"), "upc:00602557382549")
+		require.Equal(t, r.URL.Query().Get("type"), "album")
+		_, err := w.Write([]byte(`{
+			"albums": {
+				"items": []
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	album, err := client.SearchAlbumByUPC(ctx, "00602557382549")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, album)
+}
+
+func TestHTTPClient_FetchArtist(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		authorization := r.Header.Get("Authorization")
+		require.Equal(t, authorization, "Bearer mock_access_token")
+		require.Equal(t, r.URL.Path, "/v1/artists/sampleartistid")
+		_, err := w.Write([]byte(`{
+			"id": "sampleartistid",
+			"name": "Sample Artist"
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.FetchArtist(ctx, "sampleartistid")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{
+		ID:   "sampleartistid",
+		Name: "Sample Artist",
+	}, artist)
+}
+
+func TestHTTPClient_SearchArtist(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		authorization := r.Header.Get("Authorization")
+		require.Equal(t, authorization, "Bearer mock_access_token")
+		require.Equal(t, r.URL.Path, "/v1/search")
+		require.Equal(t, r.URL.Query().Get("q"), "artist:Sample Artist")
+		require.Equal(t, r.URL.Query().Get("type"), "artist")
+		_, err := w.Write([]byte(`{
+			"artists": {
+				"items": [{
+					"id": "sampleartistid",
+					"name": "Sample Artist"
+				}]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.SearchArtist(ctx, "Sample Artist")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{
+		ID:   "sampleartistid",
+		Name: "Sample Artist",
+	}, artist)
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		authorization := r.Header.Get("Authorization")
+		require.Equal(t, authorization, "Bearer mock_access_token")
+
+		var resp string
+		switch r.URL.Path {
+		case "/v1/playlists/sampleplaylistid":
+			resp = `{
+				"id": "sampleplaylistid",
+				"name": "Sample Playlist",
+				"owner": {"id": "sampleownerid", "display_name": "Sample Owner"},
+				"tracks": {
+					"items": [
+						{"track": {"id": "track1", "artists": [{"name": "Sample Artist"}], "name": "Track 1"}},
+						{"track": null}
+					],
+					"next": "https://api.spotify.com/v1/playlists/sampleplaylistid/tracks?offset=2&limit=100"
+				}
+			}`
+		case "/v1/playlists/sampleplaylistid/tracks":
+			require.Equal(t, "2", r.URL.Query().Get("offset"))
+			require.Equal(t, "100", r.URL.Query().Get("limit"))
+			resp = `{
+				"items": [
+					{"track": {"id": "track2", "artists": [{"name": "Sample Artist"}], "name": "Track 2"}}
+				],
+				"next": null
+			}`
+		default:
+			require.Fail(t, "unexpected path: %s", r.URL.Path)
+		}
+		_, err := w.Write([]byte(resp))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	playlist, err := client.FetchPlaylist(ctx, "sampleplaylistid")
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{
+		ID:    "sampleplaylistid",
+		Name:  "Sample Playlist",
+		Owner: Owner{ID: "sampleownerid", DisplayName: "Sample Owner"},
+		Tracks: []*Track{
+			{ID: "track1", Name: "Track 1", Artists: []Artist{{Name: "Sample Artist"}}},
+			{ID: "track2", Name: "Track 2", Artists: []Artist{{Name: "Sample Artist"}}},
+		},
+	}, playlist)
+}
+
 func TestHTTPClient_TokenNotExpired(t *testing.T) {
 	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodGet, r.Method)
@@ -335,6 +654,56 @@ func TestHTTPClient_RefreshTokenWhenUnauthorized(t *testing.T) {
 	}, track)
 }
 
+func TestHTTPClient_ConcurrentRequests(t *testing.T) {
+	var fetched atomic.Int32
+	mockAuthServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token_%d", fetched.Add(1)),
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+		require.NoError(t, err)
+	}))
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer token_1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(`{"tracks": {"items": [{"id": "sampletrackid", "name": "Sample Track"}]}}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.SearchTrack(ctx, "Sample Artist", "Sample Track")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), fetched.Load())
+}
+
 func TestHTTPClient_APIError(t *testing.T) {
 	mockAuthServer := newAuthServerMock(t)
 	defer mockAuthServer.Close()
@@ -366,8 +735,8 @@ func TestHTTPClient_APIError(t *testing.T) {
 	defer cancel()
 
 	track, err := client.FetchTrack(ctx, "sampletrackid")
-	require.Errorf(t, err,
-		"failed to send request: unexpected API response: 403 Spotify is unavailable in this country")
+	require.ErrorIs(t, err, apierr.RegionRestrictedError)
+	require.ErrorContains(t, err, "Spotify is unavailable in this country")
 	require.Nil(t, track)
 }
 
@@ -386,3 +755,21 @@ func newAuthServerMock(t *testing.T) *httptest.Server {
 		require.NoError(t, err)
 	}))
 }
+
+func TestHTTPClient_InvalidCredentials(t *testing.T) {
+	mockAuthServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(`{"error": "invalid_client", "error_description": "Invalid client"}`))
+		require.NoError(t, err)
+	}))
+	defer mockAuthServer.Close()
+
+	client := NewHTTPClient(&sampleCredentials, WithAuthURL(mockAuthServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "sampletrackid")
+	require.ErrorIs(t, err, apierr.UnauthorizedError)
+	require.Nil(t, track)
+}
@@ -6,23 +6,61 @@ import (
 )
 
 var (
-	TrackRe = regexp.MustCompile(`https://open\.spotify\.com/(?:[\w-]+/)?track/([a-zA-Z0-9]+)(?:\?.*)?`)
-	AlbumRe = regexp.MustCompile(`https://open\.spotify\.com/(?:[\w-]+/)?album/([a-zA-Z0-9]+)(?:\?.*)?`)
+	TrackRe  = regexp.MustCompile(`(?:https://open\.spotify\.com/(?:[\w-]+/)?track/|spotify:track:)([a-zA-Z0-9]+)(?:\?.*)?`)
+	AlbumRe  = regexp.MustCompile(`(?:https://open\.spotify\.com/(?:[\w-]+/)?album/|spotify:album:)([a-zA-Z0-9]+)(?:\?.*)?`)
+	ArtistRe = regexp.MustCompile(`(?:https://open\.spotify\.com/(?:[\w-]+/)?artist/|spotify:artist:)([a-zA-Z0-9]+)(?:\?.*)?`)
+
+	PlaylistRe = regexp.MustCompile(
+		`(?:https://open\.spotify\.com/(?:[\w-]+/)?playlist/|spotify:(?:user:[\w.-]+:)?playlist:)([a-zA-Z0-9]+)(?:\?.*)?`,
+	)
 )
 
 type Track struct {
-	Artists []Artist `json:"artists"`
-	ID      string   `json:"id"`
-	Name    string   `json:"name"`
+	Artists     []Artist    `json:"artists"`
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	ExternalIDs ExternalIDs `json:"external_ids"`
+	Album       *Album      `json:"album,omitempty"`
+	DurationMS  int         `json:"duration_ms"`
+	Explicit    bool        `json:"explicit"`
+	TrackNumber int         `json:"track_number"`
+	DiscNumber  int         `json:"disc_number"`
 }
 
 type Album struct {
-	ID      string   `json:"id"`
-	Name    string   `json:"name"`
-	Artists []Artist `json:"artists"`
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Artists     []Artist    `json:"artists"`
+	ExternalIDs ExternalIDs `json:"external_ids"`
+	ReleaseDate string      `json:"release_date"`
+	Images      []Image     `json:"images"`
+}
+
+type Image struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type ExternalIDs struct {
+	ISRC string `json:"isrc,omitempty"`
+	UPC  string `json:"upc,omitempty"`
+}
+
+type Playlist struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Owner  Owner    `json:"owner"`
+	Tracks []*Track `json:"-"`
+}
+
+type Owner struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
 }
 
 type Artist struct {
+	ID   string `json:"id"`
 	Name string `json:"name"`
 }
 
@@ -42,6 +80,32 @@ func DetectAlbumID(albumURL string) string {
 	return match[1]
 }
 
+func DetectArtistID(artistURL string) string {
+	match := ArtistRe.FindStringSubmatch(artistURL)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	match := PlaylistRe.FindStringSubmatch(playlistURL)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func (a *Album) ImageURL() string {
+	largest := Image{}
+	for _, image := range a.Images {
+		if image.Width >= largest.Width {
+			largest = image
+		}
+	}
+	return largest.URL
+}
+
 func (t *Track) URL() string {
 	return fmt.Sprintf("https://open.spotify.com/track/%s", t.ID)
 }
@@ -49,3 +113,11 @@ func (t *Track) URL() string {
 func (a *Album) URL() string {
 	return fmt.Sprintf("https://open.spotify.com/album/%s", a.ID)
 }
+
+func (a *Artist) URL() string {
+	return fmt.Sprintf("https://open.spotify.com/artist/%s", a.ID)
+}
+
+func (p *Playlist) URL() string {
+	return fmt.Sprintf("https://open.spotify.com/playlist/%s", p.ID)
+}
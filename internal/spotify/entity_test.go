@@ -18,6 +18,49 @@ func TestAlbum_URL(t *testing.T) {
 	require.Equal(t, "https://open.spotify.com/album/sample_id", result)
 }
 
+func TestArtist_URL(t *testing.T) {
+	artist := Artist{ID: "sample_id"}
+	result := artist.URL()
+	require.Equal(t, "https://open.spotify.com/artist/sample_id", result)
+}
+
+func TestPlaylist_URL(t *testing.T) {
+	playlist := Playlist{ID: "sample_id"}
+	result := playlist.URL()
+	require.Equal(t, "https://open.spotify.com/playlist/sample_id", result)
+}
+
+func TestAlbum_ImageURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		album Album
+		want  string
+	}{
+		{
+			name: "largest image",
+			album: Album{
+				Images: []Image{
+					{URL: "https://i.scdn.co/image/300", Width: 300, Height: 300},
+					{URL: "https://i.scdn.co/image/640", Width: 640, Height: 640},
+					{URL: "https://i.scdn.co/image/64", Width: 64, Height: 64},
+				},
+			},
+			want: "https://i.scdn.co/image/640",
+		},
+		{
+			name:  "no images",
+			album: Album{},
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.album.ImageURL())
+		})
+	}
+}
+
 func Test_DetectTrackID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -134,3 +177,86 @@ func Test_DetectAlbumID(t *testing.T) {
 		})
 	}
 }
+
+func Test_DetectArtistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://open.spotify.com/artist/4Z8W4fKeB5YxbusRsdQVPb",
+			expected: "4Z8W4fKeB5YxbusRsdQVPb",
+		},
+		{
+			name:     "Valid URL with locale and query",
+			inputURL: "https://open.spotify.com/intl-de/artist/4Z8W4fKeB5YxbusRsdQVPb?si=abc",
+			expected: "4Z8W4fKeB5YxbusRsdQVPb",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://open.spotify.com/album/3hARuIUZqAIAKSuNvW5dGh",
+			expected: "",
+		},
+		{
+			name:     "Empty URL",
+			inputURL: "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectArtistID(tt.inputURL)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M",
+			expected: "37i9dQZF1DXcBWIGoYBM5M",
+		},
+		{
+			name:     "Valid URL with query",
+			inputURL: "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M?si=abc",
+			expected: "37i9dQZF1DXcBWIGoYBM5M",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://open.spotify.com/album/3hARuIUZqAIAKSuNvW5dGh",
+			expected: "",
+		},
+		{
+			name:     "Empty URL",
+			inputURL: "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectPlaylistID(tt.inputURL)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_DetectIDs(t *testing.T) {
+	id := "7uv632EkfwYhXoqf8rhYrg"
+	require.Equal(t, id, DetectTrackID("https://open.spotify.com/track/"+id+"?si=abc"))
+	require.Equal(t, id, DetectTrackID("spotify:track:"+id))
+	require.Equal(t, id, DetectAlbumID("spotify:album:"+id))
+	require.Equal(t, id, DetectArtistID("spotify:artist:"+id))
+	require.Equal(t, id, DetectPlaylistID("spotify:playlist:"+id))
+	require.Equal(t, id, DetectPlaylistID("spotify:user:spotify:playlist:"+id))
+	require.Equal(t, "", DetectTrackID("spotify:album:"+id))
+}
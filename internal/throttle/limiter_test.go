@@ -0,0 +1,44 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_reserve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(2, 2)
+	limiter.now = func() time.Time { return now }
+	limiter.last = now
+
+	require.Zero(t, limiter.reserve())
+	require.Zero(t, limiter.reserve())
+	require.Equal(t, 500*time.Millisecond, limiter.reserve())
+
+	now = now.Add(250 * time.Millisecond)
+	require.Equal(t, 250*time.Millisecond, limiter.reserve())
+
+	now = now.Add(250 * time.Millisecond)
+	require.Zero(t, limiter.reserve())
+
+	now = now.Add(time.Hour)
+	require.Zero(t, limiter.reserve())
+	require.Zero(t, limiter.reserve())
+	require.NotZero(t, limiter.reserve(), "tokens should not exceed burst")
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	limiter := NewLimiter(1000, 1)
+	require.NoError(t, limiter.Wait(context.Background()))
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	slow := NewLimiter(0.001, 1)
+	require.NoError(t, slow.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, slow.Wait(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,95 @@
+package throttle
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const defaultEndpointCost = 1
+
+var QuotaExceededError = errors.New("quota exceeded")
+
+// Quota tracks units spent per day against a daily budget. Every endpoint costs one unit
+// unless configured otherwise, and the budget resets at midnight in the given location.
+type Quota struct {
+	budget   int
+	costs    map[string]int
+	location *time.Location
+	mu       sync.Mutex
+	used     int
+	resetAt  time.Time
+	now      func() time.Time
+}
+
+func NewQuota(dailyBudget int, costs map[string]int, location *time.Location) *Quota {
+	if location == nil {
+		location = time.UTC
+	}
+	return &Quota{
+		budget:   dailyBudget,
+		costs:    costs,
+		location: location,
+		now:      time.Now,
+	}
+}
+
+// Spend charges the endpoint cost or returns QuotaExceededError when the budget can't cover it.
+func (q *Quota) Spend(endpoint string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.reset()
+	cost := q.cost(endpoint)
+	if q.used+cost > q.budget {
+		return QuotaExceededError
+	}
+	q.used += cost
+	return nil
+}
+
+func (q *Quota) Budget() int {
+	return q.budget
+}
+
+func (q *Quota) Used() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.reset()
+	return q.used
+}
+
+func (q *Quota) Remaining() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.reset()
+	return q.budget - q.used
+}
+
+// ResetAt returns the time the budget is restored next.
+func (q *Quota) ResetAt() time.Time {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.reset()
+	return q.resetAt
+}
+
+func (q *Quota) cost(endpoint string) int {
+	if cost, ok := q.costs[endpoint]; ok {
+		return cost
+	}
+	return defaultEndpointCost
+}
+
+func (q *Quota) reset() {
+	now := q.now().In(q.location)
+	if now.Before(q.resetAt) {
+		return
+	}
+	q.used = 0
+	year, month, day := now.Date()
+	q.resetAt = time.Date(year, month, day+1, 0, 0, 0, 0, q.location)
+}
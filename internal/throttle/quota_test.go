@@ -0,0 +1,31 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuota(t *testing.T) {
+	location := time.FixedZone("PT", -8*60*60)
+	now := time.Date(2024, 1, 1, 23, 0, 0, 0, location)
+	quota := NewQuota(250, map[string]int{"/search": 100}, location)
+	quota.now = func() time.Time { return now }
+
+	require.NoError(t, quota.Spend("/search"))
+	require.NoError(t, quota.Spend("/search"))
+	require.NoError(t, quota.Spend("/videos"))
+	require.Equal(t, 201, quota.Used())
+	require.Equal(t, 49, quota.Remaining())
+
+	require.ErrorIs(t, quota.Spend("/search"), QuotaExceededError)
+	require.Equal(t, 49, quota.Remaining(), "rejected calls should not be charged")
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, location), quota.ResetAt())
+
+	now = now.Add(time.Hour)
+	require.Equal(t, 250, quota.Remaining())
+	require.NoError(t, quota.Spend("/search"))
+	require.Equal(t, 150, quota.Remaining())
+	require.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, location), quota.ResetAt())
+}
@@ -0,0 +1,38 @@
+package throttle
+
+import (
+	"net/http"
+)
+
+// Transport waits for the limiter and charges the quota by request URL path before
+// sending each request. Both the limiter and the quota are optional.
+type Transport struct {
+	base    http.RoundTripper
+	limiter *Limiter
+	quota   *Quota
+}
+
+func NewTransport(base http.RoundTripper, limiter *Limiter, quota *Quota) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{
+		base:    base,
+		limiter: limiter,
+		quota:   quota,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.limiter != nil {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	if t.quota != nil {
+		if err := t.quota.Spend(req.URL.Path); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(req)
+}
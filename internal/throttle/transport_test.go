@@ -0,0 +1,37 @@
+package throttle
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	quota := NewQuota(150, map[string]int{"/search": 100}, time.UTC)
+	client := http.Client{
+		Transport: NewTransport(nil, NewLimiter(1000, 10), quota),
+	}
+
+	resp, err := client.Get(server.URL + "/search")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	resp, err = client.Get(server.URL + "/videos")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	_, err = client.Get(server.URL + "/search")
+	require.ErrorIs(t, err, QuotaExceededError)
+
+	require.Equal(t, 2, requests)
+	require.Equal(t, 49, quota.Remaining())
+}
@@ -0,0 +1,534 @@
+package tidal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	defaultAuthURL = "https://auth.tidal.com"
+	defaultAPIURL  = "https://openapi.tidal.com/v2"
+
+	countryCode      = "US"
+	filterIDsLimit   = 20
+	trackIncludes    = "artists,albums"
+	albumIncludes    = "artists,coverArt"
+	jsonAPIMediaType = "application/vnd.api+json"
+)
+
+var NotFoundError = errors.New("not found")
+
+type Client interface {
+	FetchTrack(ctx context.Context, id string) (*Track, error)
+	SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error)
+	SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error)
+	SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error)
+	FetchAlbum(ctx context.Context, id string) (*Album, error)
+	SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error)
+	SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error)
+	SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error)
+	FetchArtist(ctx context.Context, id string) (*Artist, error)
+	SearchArtist(ctx context.Context, artistName string) (*Artist, error)
+	FetchPlaylist(ctx context.Context, id string) (*Playlist, error)
+}
+
+type HTTPClient struct {
+	authURL     string
+	apiURL      string
+	httpClient  *http.Client
+	credentials *Credentials
+	tokenMu     sync.Mutex
+	token       *token
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
+}
+
+type errorResponse struct {
+	Errors []apiError `json:"errors"`
+}
+
+type apiError struct {
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+func NewHTTPClient(credentials *Credentials, opts ...ClientOption) *HTTPClient {
+	c := HTTPClient{
+		authURL:     defaultAuthURL,
+		apiURL:      defaultAPIURL,
+		credentials: credentials,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
+
+	return &c
+}
+
+// https://developer.tidal.com/apiref?ref=get-track
+func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error) {
+	doc, err := c.getDocument(ctx, "/tracks/"+url.PathEscape(id), url.Values{
+		"include": []string{trackIncludes},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := doc.tracks()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	if len(tracks) == 0 {
+		return nil, NotFoundError
+	}
+	return tracks[0], nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-search-result-tracks
+func (c *HTTPClient) SearchTrack(ctx context.Context, artistName, trackName string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-search-result-tracks
+func (c *HTTPClient) SearchTracks(ctx context.Context, artistName, trackName string, limit int) ([]*Track, error) {
+	ids, err := c.searchIDs(ctx, tracksType, searchQuery(artistName, trackName), limit)
+	if err != nil {
+		return nil, err
+	}
+	return c.fetchTracks(ctx, ids)
+}
+
+// https://developer.tidal.com/apiref?ref=get-tracks
+func (c *HTTPClient) SearchTrackByISRC(ctx context.Context, isrc string) (*Track, error) {
+	doc, err := c.getDocument(ctx, "/tracks", url.Values{
+		"filter[isrc]": []string{isrc},
+		"include":      []string{trackIncludes},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := doc.tracks()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	if len(tracks) == 0 {
+		return nil, NotFoundError
+	}
+	return tracks[0], nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-album
+func (c *HTTPClient) FetchAlbum(ctx context.Context, id string) (*Album, error) {
+	doc, err := c.getDocument(ctx, "/albums/"+url.PathEscape(id), url.Values{
+		"include": []string{albumIncludes},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	albums, err := doc.albums()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	if len(albums) == 0 {
+		return nil, NotFoundError
+	}
+	return albums[0], nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-search-result-albums
+func (c *HTTPClient) SearchAlbum(ctx context.Context, artistName, albumName string) (*Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-search-result-albums
+func (c *HTTPClient) SearchAlbums(ctx context.Context, artistName, albumName string, limit int) ([]*Album, error) {
+	ids, err := c.searchIDs(ctx, albumsType, searchQuery(artistName, albumName), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := c.getDocument(ctx, "/albums", url.Values{
+		"filter[id]": []string{strings.Join(ids, ",")},
+		"include":    []string{albumIncludes},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	albums, err := doc.albums()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	albums = orderByIDs(albums, ids, func(a *Album) string { return a.ID })
+	if len(albums) == 0 {
+		return nil, NotFoundError
+	}
+	return albums, nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-albums
+func (c *HTTPClient) SearchAlbumByUPC(ctx context.Context, upc string) (*Album, error) {
+	doc, err := c.getDocument(ctx, "/albums", url.Values{
+		"filter[barcodeId]": []string{upc},
+		"include":           []string{albumIncludes},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	albums, err := doc.albums()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	if len(albums) == 0 {
+		return nil, NotFoundError
+	}
+	return albums[0], nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-artist
+func (c *HTTPClient) FetchArtist(ctx context.Context, id string) (*Artist, error) {
+	doc, err := c.getDocument(ctx, "/artists/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	artists, err := doc.artists()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	if len(artists) == 0 {
+		return nil, NotFoundError
+	}
+	return artists[0], nil
+}
+
+// https://developer.tidal.com/apiref?ref=get-search-result-artists
+func (c *HTTPClient) SearchArtist(ctx context.Context, artistName string) (*Artist, error) {
+	doc, err := c.getDocument(ctx, searchPath(artistName, artistsType), url.Values{
+		"include": []string{artistsType},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := doc.identifiers()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	for _, id := range ids {
+		if included := doc.included(id); included != nil {
+			artist, err := artistFromResource(included)
+			if err != nil {
+				return nil, apierr.Malformed(err)
+			}
+			return artist, nil
+		}
+	}
+	return nil, NotFoundError
+}
+
+// https://developer.tidal.com/apiref?ref=get-playlist
+// https://developer.tidal.com/apiref?ref=get-playlist-items
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	path := "/playlists/" + url.PathEscape(id)
+	doc, err := c.getDocument(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := doc.resources()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	if len(resources) == 0 {
+		return nil, NotFoundError
+	}
+	attributes := playlistAttributes{}
+	if err := json.Unmarshal(resources[0].Attributes, &attributes); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal playlist attributes: %w", err))
+	}
+
+	trackIDs := []string{}
+	u := c.apiRequestURL(path+"/relationships/items", nil)
+	for u != "" {
+		page, err := c.get(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		ids, err := page.identifiers()
+		if err != nil {
+			return nil, apierr.Malformed(err)
+		}
+		for _, item := range ids {
+			// playlists may contain videos which have no counterpart on other providers
+			if item.Type == tracksType {
+				trackIDs = append(trackIDs, item.ID)
+			}
+		}
+		u = c.nextURL(page.Links.Next)
+	}
+
+	playlist := Playlist{ID: resources[0].ID, Name: attributes.Name}
+	if len(trackIDs) == 0 {
+		return &playlist, nil
+	}
+	if playlist.Tracks, err = c.fetchTracks(ctx, trackIDs); err != nil {
+		return nil, err
+	}
+	return &playlist, nil
+}
+
+// searchIDs returns ids of the resources found, search results carry no attributes on their own.
+func (c *HTTPClient) searchIDs(ctx context.Context, resourceType, query string, limit int) ([]string, error) {
+	doc, err := c.getDocument(ctx, searchPath(query, resourceType), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := doc.identifiers()
+	if err != nil {
+		return nil, apierr.Malformed(err)
+	}
+	ids := []string{}
+	for _, id := range identifiers {
+		if id.Type == resourceType {
+			ids = append(ids, id.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, NotFoundError
+	}
+	return ids[:min(limit, len(ids))], nil
+}
+
+func (c *HTTPClient) fetchTracks(ctx context.Context, ids []string) ([]*Track, error) {
+	tracks := []*Track{}
+	for start := 0; start < len(ids); start += filterIDsLimit {
+		batch := ids[start:min(start+filterIDsLimit, len(ids))]
+		doc, err := c.getDocument(ctx, "/tracks", url.Values{
+			"filter[id]": []string{strings.Join(batch, ",")},
+			"include":    []string{trackIncludes},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		found, err := doc.tracks()
+		if err != nil {
+			return nil, apierr.Malformed(err)
+		}
+		tracks = append(tracks, orderByIDs(found, batch, func(t *Track) string { return t.ID })...)
+	}
+	if len(tracks) == 0 {
+		return nil, NotFoundError
+	}
+	return tracks, nil
+}
+
+func (c *HTTPClient) getDocument(ctx context.Context, path string, query url.Values) (*document, error) {
+	return c.get(ctx, c.apiRequestURL(path, query))
+}
+
+func (c *HTTPClient) get(ctx context.Context, u string) (*document, error) {
+	body, err := c.getAPI(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := document{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	return &doc, nil
+}
+
+func (c *HTTPClient) apiRequestURL(path string, query url.Values) string {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("countryCode", countryCode)
+	return fmt.Sprintf("%s%s?%s", c.apiURL, path, query.Encode())
+}
+
+// nextURL resolves the next page link which the API returns relative to its root.
+func (c *HTTPClient) nextURL(next string) string {
+	if next == "" || strings.HasPrefix(next, "http") {
+		return next
+	}
+	return c.apiURL + next
+}
+
+func (c *HTTPClient) getAPI(ctx context.Context, u string) ([]byte, error) {
+	resp, err := c.requestWithToken(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		c.resetToken(resp.Request.Header.Get("Authorization"))
+		resp, err = c.requestWithToken(ctx, u)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+		defer resp.Body.Close()
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, NotFoundError
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		er := errorResponse{}
+		if err := json.Unmarshal(body, &er); err != nil || len(er.Errors) == 0 {
+			return nil, apierr.FromStatus(resp.StatusCode)
+		}
+		return nil, fmt.Errorf("unexpected API response: %s: %w", er.Errors[0].Detail, apierr.FromStatus(resp.StatusCode))
+	}
+
+	return body, nil
+}
+
+// https://developer.tidal.com/documentation/authorization/authorization-client-credentials
+func (c *HTTPClient) fetchToken(ctx context.Context) (*token, error) {
+	url := fmt.Sprintf("%s/v1/oauth2/token", c.authURL)
+	form := bytes.NewBuffer([]byte("grant_type=client_credentials"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, form)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", c.credentials.authHeader())
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		return nil, &apierr.StatusError{StatusCode: resp.StatusCode, Err: apierr.UnauthorizedError}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	result := token{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	result.fetchedAt = time.Now()
+	return &result, nil
+}
+
+func (c *HTTPClient) requestWithToken(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	authHeader, err := c.authHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token: %w", err)
+	}
+	req.Header.Set("Authorization", authHeader)
+	req.Header.Set("Accept", jsonAPIMediaType)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	return resp, nil
+}
+
+func searchQuery(artistName, name string) string {
+	return strings.TrimSpace(artistName + " " + name)
+}
+
+func searchPath(query, resourceType string) string {
+	return fmt.Sprintf("/searchResults/%s/relationships/%s", url.PathEscape(query), resourceType)
+}
+
+// orderByIDs restores the order of ids since filtered collections come back in arbitrary order.
+func orderByIDs[T any](items []T, ids []string, id func(T) string) []T {
+	byID := make(map[string]T, len(items))
+	for _, item := range items {
+		byID[id(item)] = item
+	}
+	ordered := make([]T, 0, len(items))
+	for _, i := range ids {
+		if item, ok := byID[i]; ok {
+			ordered = append(ordered, item)
+		}
+	}
+	return ordered
+}
+
+// authHeader returns the authorization header of the cached token, fetching a new token
+// when there is none or it is expired. Concurrent requests wait for a single fetch.
+func (c *HTTPClient) authHeader(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == nil || c.token.isExpired() {
+		t, err := c.fetchToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.token = t
+	}
+	return c.token.authHeader(), nil
+}
+
+// resetToken drops the cached token rejected with the authorization header, unless another
+// request has replaced it already.
+func (c *HTTPClient) resetToken(authHeader string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != nil && c.token.authHeader() == authHeader {
+		c.token = nil
+	}
+}
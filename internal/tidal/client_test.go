@@ -0,0 +1,595 @@
+package tidal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sampleCredentials = Credentials{
+		ClientID:     "sampleClientID",
+		ClientSecret: "sampleClientSecret",
+	}
+	sampleToken = token{
+		AccessToken: "mock_access_token",
+		TokenType:   "Bearer",
+		ExpiresIn:   360,
+	}
+	sampleBasicAuth = "Basic c2FtcGxlQ2xpZW50SUQ6c2FtcGxlQ2xpZW50U2VjcmV0"
+
+	sampleTrackResource = `{
+		"id": "77646169",
+		"type": "tracks",
+		"attributes": {
+			"title": "Karma Police",
+			"version": "Remastered",
+			"isrc": "GBAYE9700120",
+			"duration": "PT4M24S",
+			"explicit": false
+		},
+		"relationships": {
+			"artists": {"data": [{"id": "8847", "type": "artists"}]},
+			"albums": {"data": [{"id": "77646164", "type": "albums"}]}
+		}
+	}`
+	sampleIncluded = `[
+		{"id": "8847", "type": "artists", "attributes": {"name": "Radiohead"}},
+		{
+			"id": "77646164",
+			"type": "albums",
+			"attributes": {
+				"title": "OK Computer",
+				"barcodeId": "0634904078164",
+				"releaseDate": "1997-05-21",
+				"explicit": false
+			},
+			"relationships": {
+				"artists": {"data": [{"id": "8847", "type": "artists"}]}
+			}
+		}
+	]`
+	sampleTrack = Track{
+		ID:       "77646169",
+		Title:    "Karma Police",
+		Version:  "Remastered",
+		ISRC:     "GBAYE9700120",
+		Duration: 4*time.Minute + 24*time.Second,
+		Artists:  []*Artist{{ID: "8847", Name: "Radiohead"}},
+		Album: &Album{
+			ID:          "77646164",
+			Title:       "OK Computer",
+			UPC:         "0634904078164",
+			ReleaseDate: "1997-05-21",
+			Artists:     []*Artist{{ID: "8847", Name: "Radiohead"}},
+		},
+	}
+)
+
+func TestHTTPClient_FetchTrack(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "Bearer mock_access_token", r.Header.Get("Authorization"))
+		require.Equal(t, "application/vnd.api+json", r.Header.Get("Accept"))
+		require.Equal(t, "/tracks/77646169", r.URL.Path)
+		require.Equal(t, "US", r.URL.Query().Get("countryCode"))
+		require.Equal(t, "artists,albums", r.URL.Query().Get("include"))
+		_, err := w.Write([]byte(`{"data": ` + sampleTrackResource + `, "included": ` + sampleIncluded + `}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "77646169")
+	require.NoError(t, err)
+	require.Equal(t, &sampleTrack, track)
+}
+
+func TestHTTPClient_FetchTrackNotFound(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "0")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, track)
+}
+
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "US", r.URL.Query().Get("countryCode"))
+
+		var err error
+		switch r.URL.Path {
+		case "/searchResults/Radiohead Karma Police/relationships/tracks":
+			_, err = w.Write([]byte(`{"data": [
+				{"id": "77646169", "type": "tracks"},
+				{"id": "1", "type": "tracks"},
+				{"id": "2", "type": "tracks"}
+			]}`))
+		case "/tracks":
+			require.Equal(t, "77646169,1", r.URL.Query().Get("filter[id]"))
+			require.Equal(t, "artists,albums", r.URL.Query().Get("include"))
+			_, err = w.Write([]byte(`{
+				"data": [
+					{"id": "1", "type": "tracks", "attributes": {"title": "Karma Police", "version": "Live"}},
+					` + sampleTrackResource + `
+				],
+				"included": ` + sampleIncluded + `
+			}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tracks, err := client.SearchTracks(ctx, "Radiohead", "Karma Police", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Track{
+		&sampleTrack,
+		{ID: "1", Title: "Karma Police", Version: "Live", Artists: []*Artist{}},
+	}, tracks)
+}
+
+func TestHTTPClient_SearchTrackNotFound(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/searchResults/Radiohead Karma Police/relationships/tracks", r.URL.Path)
+		_, err := w.Write([]byte(`{"data": []}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.SearchTrack(ctx, "Radiohead", "Karma Police")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, track)
+}
+
+func TestHTTPClient_SearchTrackByISRC(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/tracks", r.URL.Path)
+		require.Equal(t, "GBAYE9700120", r.URL.Query().Get("filter[isrc]"))
+		_, err := w.Write([]byte(`{"data": [` + sampleTrackResource + `], "included": ` + sampleIncluded + `}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.SearchTrackByISRC(ctx, "GBAYE9700120")
+	require.NoError(t, err)
+	require.Equal(t, &sampleTrack, track)
+}
+
+func TestHTTPClient_FetchAlbum(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/albums/77646164", r.URL.Path)
+		require.Equal(t, "artists,coverArt", r.URL.Query().Get("include"))
+		_, err := w.Write([]byte(`{
+			"data": {
+				"id": "77646164",
+				"type": "albums",
+				"attributes": {"title": "OK Computer", "barcodeId": "0634904078164", "releaseDate": "1997-05-21"},
+				"relationships": {
+					"artists": {"data": [{"id": "8847", "type": "artists"}]},
+					"coverArt": {"data": [{"id": "cover", "type": "artworks"}]}
+				}
+			},
+			"included": [
+				{"id": "8847", "type": "artists", "attributes": {"name": "Radiohead"}},
+				{"id": "cover", "type": "artworks", "attributes": {"files": [
+					{"href": "https://resources.tidal.com/images/cover/640x640.jpg", "meta": {"width": 640, "height": 640}},
+					{"href": "https://resources.tidal.com/images/cover/1280x1280.jpg", "meta": {"width": 1280, "height": 1280}},
+					{"href": "https://resources.tidal.com/images/cover/80x80.jpg", "meta": {"width": 80, "height": 80}}
+				]}}
+			]
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	album, err := client.FetchAlbum(ctx, "77646164")
+	require.NoError(t, err)
+	require.Equal(t, &Album{
+		ID:          "77646164",
+		Title:       "OK Computer",
+		UPC:         "0634904078164",
+		ReleaseDate: "1997-05-21",
+		CoverURL:    "https://resources.tidal.com/images/cover/1280x1280.jpg",
+		Artists:     []*Artist{{ID: "8847", Name: "Radiohead"}},
+	}, album)
+}
+
+func TestHTTPClient_SearchAlbums(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.URL.Path {
+		case "/searchResults/Radiohead OK Computer/relationships/albums":
+			_, err = w.Write([]byte(`{"data": [{"id": "77646164", "type": "albums"}]}`))
+		case "/albums":
+			require.Equal(t, "77646164", r.URL.Query().Get("filter[id]"))
+			_, err = w.Write([]byte(`{"data": [{"id": "77646164", "type": "albums", "attributes": {"title": "OK Computer"}}]}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	albums, err := client.SearchAlbums(ctx, "Radiohead", "OK Computer", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Album{{ID: "77646164", Title: "OK Computer", Artists: []*Artist{}}}, albums)
+}
+
+func TestHTTPClient_SearchAlbumByUPC(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/albums", r.URL.Path)
+		require.Equal(t, "0634904078164", r.URL.Query().Get("filter[barcodeId]"))
+		_, err := w.Write([]byte(`{"data": []}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	album, err := client.SearchAlbumByUPC(ctx, "0634904078164")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, album)
+}
+
+func TestHTTPClient_FetchArtist(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/artists/8847", r.URL.Path)
+		_, err := w.Write([]byte(`{"data": {"id": "8847", "type": "artists", "attributes": {"name": "Radiohead"}}}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.FetchArtist(ctx, "8847")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{ID: "8847", Name: "Radiohead"}, artist)
+}
+
+func TestHTTPClient_SearchArtist(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/searchResults/Radiohead/relationships/artists", r.URL.Path)
+		require.Equal(t, "artists", r.URL.Query().Get("include"))
+		_, err := w.Write([]byte(`{
+			"data": [{"id": "8847", "type": "artists"}],
+			"included": [{"id": "8847", "type": "artists", "attributes": {"name": "Radiohead"}}]
+		}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.SearchArtist(ctx, "Radiohead")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{ID: "8847", Name: "Radiohead"}, artist)
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	playlistID := "0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7"
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.URL.Path {
+		case "/playlists/" + playlistID:
+			_, err = w.Write([]byte(`{"data": {"id": "` + playlistID + `", "type": "playlists", "attributes": {"name": "Sample Playlist"}}}`))
+		case "/playlists/" + playlistID + "/relationships/items":
+			if r.URL.Query().Get("page[cursor]") == "" {
+				_, err = w.Write([]byte(`{
+					"data": [{"id": "77646169", "type": "tracks"}, {"id": "v1", "type": "videos"}],
+					"links": {"next": "/playlists/` + playlistID + `/relationships/items?countryCode=US&page[cursor]=next"}
+				}`))
+			} else {
+				_, err = w.Write([]byte(`{"data": [{"id": "1", "type": "tracks"}], "links": {}}`))
+			}
+		case "/tracks":
+			require.Equal(t, "77646169,1", r.URL.Query().Get("filter[id]"))
+			_, err = w.Write([]byte(`{
+				"data": [
+					` + sampleTrackResource + `,
+					{"id": "1", "type": "tracks", "attributes": {"title": "Lucky"}}
+				],
+				"included": ` + sampleIncluded + `
+			}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	playlist, err := client.FetchPlaylist(ctx, playlistID)
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{
+		ID:   playlistID,
+		Name: "Sample Playlist",
+		Tracks: []*Track{
+			&sampleTrack,
+			{ID: "1", Title: "Lucky", Artists: []*Artist{}},
+		},
+	}, playlist)
+}
+
+func TestHTTPClient_RefreshTokenWhenUnauthorized(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer not_expired_token_to_refresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.Equal(t, "Bearer mock_access_token", r.Header.Get("Authorization"))
+		_, err := w.Write([]byte(`{"data": {"id": "8847", "type": "artists", "attributes": {"name": "Radiohead"}}}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+	client.token = &token{
+		fetchedAt:   time.Now(),
+		ExpiresIn:   3600,
+		AccessToken: "not_expired_token_to_refresh",
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.FetchArtist(ctx, "8847")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{ID: "8847", Name: "Radiohead"}, artist)
+}
+
+func TestHTTPClient_ConcurrentRequests(t *testing.T) {
+	var fetched atomic.Int32
+	mockAuthServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token_%d", fetched.Add(1)),
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+		require.NoError(t, err)
+	}))
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer token_1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(`{"data": {"id": "8847", "type": "artists", "attributes": {"name": "Radiohead"}}}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.FetchArtist(ctx, "8847")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), fetched.Load())
+}
+
+func TestHTTPClient_APIError(t *testing.T) {
+	mockAuthServer := newAuthServerMock(t)
+	defer mockAuthServer.Close()
+
+	mockAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, err := w.Write([]byte(`{"errors": [{"code": "RATE_LIMITED", "detail": "Too many requests"}]}`))
+		require.NoError(t, err)
+	}))
+	defer mockAPIServer.Close()
+
+	client := NewHTTPClient(
+		&sampleCredentials,
+		WithAuthURL(mockAuthServer.URL),
+		WithAPIURL(mockAPIServer.URL),
+		WithRetryPolicy(retry.Policy{MaxAttempts: 1}),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "77646169")
+	require.ErrorIs(t, err, apierr.RateLimitedError)
+	require.ErrorContains(t, err, "Too many requests")
+	require.Nil(t, track)
+}
+
+func TestHTTPClient_InvalidCredentials(t *testing.T) {
+	mockAuthServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer mockAuthServer.Close()
+
+	client := NewHTTPClient(&sampleCredentials, WithAuthURL(mockAuthServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := client.FetchTrack(ctx, "77646169")
+	require.ErrorIs(t, err, apierr.UnauthorizedError)
+	require.Nil(t, track)
+}
+
+func newAuthServerMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/v1/oauth2/token", r.URL.Path)
+		require.Equal(t, sampleBasicAuth, r.Header.Get("Authorization"))
+		require.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"access_token": sampleToken.AccessToken,
+			"token_type":   sampleToken.TokenType,
+			"expires_in":   sampleToken.ExpiresIn,
+		})
+		require.NoError(t, err)
+	}))
+}
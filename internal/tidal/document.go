@@ -0,0 +1,264 @@
+package tidal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	tracksType    = "tracks"
+	albumsType    = "albums"
+	artistsType   = "artists"
+	artworksType  = "artworks"
+	playlistsType = "playlists"
+)
+
+// document is the JSON:API response of the API: primary data is a single resource or
+// a list of them, related resources requested with include are listed separately.
+type document struct {
+	Data     json.RawMessage `json:"data"`
+	Included []*resource     `json:"included"`
+	Links    documentLinks   `json:"links"`
+}
+
+type documentLinks struct {
+	Next string `json:"next"`
+}
+
+type resource struct {
+	ID            string                  `json:"id"`
+	Type          string                  `json:"type"`
+	Attributes    json.RawMessage         `json:"attributes"`
+	Relationships map[string]relationship `json:"relationships"`
+}
+
+type relationship struct {
+	Data []identifier `json:"data"`
+}
+
+type identifier struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type trackAttributes struct {
+	Title    string `json:"title"`
+	Version  string `json:"version"`
+	ISRC     string `json:"isrc"`
+	Duration string `json:"duration"`
+	Explicit bool   `json:"explicit"`
+}
+
+type albumAttributes struct {
+	Title       string `json:"title"`
+	BarcodeID   string `json:"barcodeId"`
+	ReleaseDate string `json:"releaseDate"`
+	Explicit    bool   `json:"explicit"`
+}
+
+type artistAttributes struct {
+	Name string `json:"name"`
+}
+
+type playlistAttributes struct {
+	Name string `json:"name"`
+}
+
+type artworkAttributes struct {
+	Files []artworkFile `json:"files"`
+}
+
+type artworkFile struct {
+	Href string `json:"href"`
+	Meta struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"meta"`
+}
+
+func (d *document) resources() ([]*resource, error) {
+	data := bytes.TrimSpace(d.Data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	if data[0] == '[' {
+		resources := []*resource{}
+		if err := json.Unmarshal(data, &resources); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+		return resources, nil
+	}
+
+	r := resource{}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+	return []*resource{&r}, nil
+}
+
+func (d *document) identifiers() ([]identifier, error) {
+	ids := []identifier{}
+	if err := json.Unmarshal(d.Data, &ids); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+	return ids, nil
+}
+
+func (d *document) tracks() ([]*Track, error) {
+	resources, err := d.resources()
+	if err != nil {
+		return nil, err
+	}
+	tracks := make([]*Track, 0, len(resources))
+	for _, r := range resources {
+		track, err := d.track(r)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, track)
+	}
+	return tracks, nil
+}
+
+func (d *document) albums() ([]*Album, error) {
+	resources, err := d.resources()
+	if err != nil {
+		return nil, err
+	}
+	albums := make([]*Album, 0, len(resources))
+	for _, r := range resources {
+		album, err := d.album(r)
+		if err != nil {
+			return nil, err
+		}
+		albums = append(albums, album)
+	}
+	return albums, nil
+}
+
+func (d *document) artists() ([]*Artist, error) {
+	resources, err := d.resources()
+	if err != nil {
+		return nil, err
+	}
+	artists := make([]*Artist, 0, len(resources))
+	for _, r := range resources {
+		artist, err := artistFromResource(r)
+		if err != nil {
+			return nil, err
+		}
+		artists = append(artists, artist)
+	}
+	return artists, nil
+}
+
+func (d *document) track(r *resource) (*Track, error) {
+	attributes := trackAttributes{}
+	if err := json.Unmarshal(r.Attributes, &attributes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal track attributes: %w", err)
+	}
+
+	artists, err := d.relatedArtists(r)
+	if err != nil {
+		return nil, err
+	}
+	track := Track{
+		ID:       r.ID,
+		Title:    attributes.Title,
+		Version:  attributes.Version,
+		ISRC:     attributes.ISRC,
+		Duration: parseDuration(attributes.Duration),
+		Explicit: attributes.Explicit,
+		Artists:  artists,
+	}
+
+	for _, id := range r.Relationships[albumsType].Data {
+		if included := d.included(id); included != nil {
+			if track.Album, err = d.album(included); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return &track, nil
+}
+
+func (d *document) album(r *resource) (*Album, error) {
+	attributes := albumAttributes{}
+	if err := json.Unmarshal(r.Attributes, &attributes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal album attributes: %w", err)
+	}
+
+	artists, err := d.relatedArtists(r)
+	if err != nil {
+		return nil, err
+	}
+	album := Album{
+		ID:          r.ID,
+		Title:       attributes.Title,
+		UPC:         attributes.BarcodeID,
+		ReleaseDate: attributes.ReleaseDate,
+		Explicit:    attributes.Explicit,
+		Artists:     artists,
+	}
+
+	for _, id := range r.Relationships["coverArt"].Data {
+		if included := d.included(id); included != nil {
+			if album.CoverURL, err = largestArtwork(included); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return &album, nil
+}
+
+func (d *document) relatedArtists(r *resource) ([]*Artist, error) {
+	artists := []*Artist{}
+	for _, id := range r.Relationships[artistsType].Data {
+		included := d.included(id)
+		if included == nil {
+			continue
+		}
+		artist, err := artistFromResource(included)
+		if err != nil {
+			return nil, err
+		}
+		artists = append(artists, artist)
+	}
+	return artists, nil
+}
+
+func (d *document) included(id identifier) *resource {
+	for _, r := range d.Included {
+		if r.ID == id.ID && r.Type == id.Type {
+			return r
+		}
+	}
+	return nil
+}
+
+func artistFromResource(r *resource) (*Artist, error) {
+	attributes := artistAttributes{}
+	if err := json.Unmarshal(r.Attributes, &attributes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal artist attributes: %w", err)
+	}
+	return &Artist{ID: r.ID, Name: attributes.Name}, nil
+}
+
+func largestArtwork(r *resource) (string, error) {
+	attributes := artworkAttributes{}
+	if err := json.Unmarshal(r.Attributes, &attributes); err != nil {
+		return "", fmt.Errorf("failed to unmarshal artwork attributes: %w", err)
+	}
+
+	largest := artworkFile{}
+	for _, file := range attributes.Files {
+		if file.Meta.Width >= largest.Meta.Width {
+			largest = file
+		}
+	}
+	return largest.Href, nil
+}
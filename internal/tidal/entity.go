@@ -0,0 +1,119 @@
+package tidal
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	TrackRe    = regexp.MustCompile(`(?:https://(?:www\.|listen\.)?tidal\.com/(?:browse/)?|tidal://)(?:album/\d+/)?track/(\d+)`)
+	AlbumRe    = regexp.MustCompile(`(?:https://(?:www\.|listen\.)?tidal\.com/(?:browse/)?|tidal://)album/(\d+)`)
+	ArtistRe   = regexp.MustCompile(`(?:https://(?:www\.|listen\.)?tidal\.com/(?:browse/)?|tidal://)artist/(\d+)`)
+	PlaylistRe = regexp.MustCompile(`(?:https://(?:www\.|listen\.)?tidal\.com/(?:browse/)?|tidal://)playlist/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
+
+	isoDurationRe = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?$`)
+)
+
+type Track struct {
+	ID       string
+	Title    string
+	Version  string
+	ISRC     string
+	Duration time.Duration
+	Explicit bool
+	Artists  []*Artist
+	Album    *Album
+}
+
+type Album struct {
+	ID          string
+	Title       string
+	UPC         string
+	ReleaseDate string
+	Explicit    bool
+	CoverURL    string
+	Artists     []*Artist
+}
+
+type Artist struct {
+	ID   string
+	Name string
+}
+
+type Playlist struct {
+	ID     string
+	Name   string
+	Tracks []*Track
+}
+
+func DetectTrackID(trackURL string) string {
+	match := TrackRe.FindStringSubmatch(trackURL)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func DetectAlbumID(albumURL string) string {
+	match := AlbumRe.FindStringSubmatch(albumURL)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func DetectArtistID(artistURL string) string {
+	match := ArtistRe.FindStringSubmatch(artistURL)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	match := PlaylistRe.FindStringSubmatch(playlistURL)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func (t *Track) URL() string {
+	return fmt.Sprintf("https://tidal.com/browse/track/%s", t.ID)
+}
+
+// FullTitle appends the version, e.g. "Remastered", the way Tidal displays it.
+func (t *Track) FullTitle() string {
+	if t.Version == "" {
+		return t.Title
+	}
+	return fmt.Sprintf("%s (%s)", t.Title, t.Version)
+}
+
+func (a *Album) URL() string {
+	return fmt.Sprintf("https://tidal.com/browse/album/%s", a.ID)
+}
+
+func (a *Artist) URL() string {
+	return fmt.Sprintf("https://tidal.com/browse/artist/%s", a.ID)
+}
+
+func (p *Playlist) URL() string {
+	return fmt.Sprintf("https://tidal.com/browse/playlist/%s", p.ID)
+}
+
+// parseDuration parses ISO 8601 durations like PT3M42S used by the API.
+func parseDuration(value string) time.Duration {
+	match := isoDurationRe.FindStringSubmatch(value)
+	if match == nil {
+		return 0
+	}
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	seconds, _ := strconv.ParseFloat(match[3], 64)
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second))
+}
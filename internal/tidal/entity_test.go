@@ -0,0 +1,195 @@
+package tidal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrack_URL(t *testing.T) {
+	track := Track{ID: "77646169"}
+	require.Equal(t, "https://tidal.com/browse/track/77646169", track.URL())
+}
+
+func TestAlbum_URL(t *testing.T) {
+	album := Album{ID: "77646164"}
+	require.Equal(t, "https://tidal.com/browse/album/77646164", album.URL())
+}
+
+func TestArtist_URL(t *testing.T) {
+	artist := Artist{ID: "8847"}
+	require.Equal(t, "https://tidal.com/browse/artist/8847", artist.URL())
+}
+
+func TestPlaylist_URL(t *testing.T) {
+	playlist := Playlist{ID: "0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7"}
+	require.Equal(t, "https://tidal.com/browse/playlist/0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7", playlist.URL())
+}
+
+func TestTrack_FullTitle(t *testing.T) {
+	track := Track{Title: "Karma Police"}
+	require.Equal(t, "Karma Police", track.FullTitle())
+
+	track.Version = "Remastered"
+	require.Equal(t, "Karma Police (Remastered)", track.FullTitle())
+}
+
+func Test_DetectTrackID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Browse URL",
+			inputURL: "https://tidal.com/browse/track/77646169",
+			expected: "77646169",
+		},
+		{
+			name:     "Browse URL with query",
+			inputURL: "https://tidal.com/browse/track/77646169?u",
+			expected: "77646169",
+		},
+		{
+			name:     "Web player URL",
+			inputURL: "https://listen.tidal.com/track/77646169",
+			expected: "77646169",
+		},
+		{
+			name:     "App URI",
+			inputURL: "tidal://track/77646169",
+			expected: "77646169",
+		},
+		{
+			name:     "Track URL within album",
+			inputURL: "https://listen.tidal.com/album/77646164/track/77646169",
+			expected: "77646169",
+		},
+		{
+			name:     "Album URL",
+			inputURL: "https://tidal.com/browse/album/77646164",
+			expected: "",
+		},
+		{
+			name:     "Other host",
+			inputURL: "https://example.com/browse/track/77646169",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectTrackID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectAlbumID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Browse URL",
+			inputURL: "https://tidal.com/browse/album/77646164",
+			expected: "77646164",
+		},
+		{
+			name:     "Web player URL",
+			inputURL: "https://listen.tidal.com/album/77646164",
+			expected: "77646164",
+		},
+		{
+			name:     "Track URL",
+			inputURL: "https://tidal.com/browse/track/77646169",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectAlbumID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectArtistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Browse URL",
+			inputURL: "https://tidal.com/browse/artist/8847",
+			expected: "8847",
+		},
+		{
+			name:     "Web player URL",
+			inputURL: "https://listen.tidal.com/artist/8847",
+			expected: "8847",
+		},
+		{
+			name:     "Album URL",
+			inputURL: "https://tidal.com/browse/album/77646164",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectArtistID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Browse URL",
+			inputURL: "https://tidal.com/browse/playlist/0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7",
+			expected: "0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7",
+		},
+		{
+			name:     "Web player URL",
+			inputURL: "https://listen.tidal.com/playlist/0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7",
+			expected: "0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7",
+		},
+		{
+			name:     "Malformed id",
+			inputURL: "https://tidal.com/browse/playlist/123",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectPlaylistID(tt.inputURL))
+		})
+	}
+}
+
+func Test_parseDuration(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{value: "PT3M42S", expected: 3*time.Minute + 42*time.Second},
+		{value: "PT1H2M3S", expected: time.Hour + 2*time.Minute + 3*time.Second},
+		{value: "PT58.5S", expected: 58500 * time.Millisecond},
+		{value: "", expected: 0},
+		{value: "3:42", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			require.Equal(t, tt.expected, parseDuration(tt.value))
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package translator
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const CacheBackend = "cache"
+
+// Backend is a translator of a chain named for the records of the answers.
+type Backend struct {
+	Name       string
+	Translator Translator
+}
+
+// Translation is a translated text and the name of the backend that answered.
+type Translation struct {
+	Text    string
+	Backend string
+}
+
+// Chain tries its backends in order until one of them translates the text. Backends without
+// a translation are skipped, as well as failing ones: a text no backend translates has no
+// translation, whether some of them failed or not. Answers are memoized in a bounded cache
+// when the chain is constructed with WithCache.
+type Chain struct {
+	backends []Backend
+	cache    *chainCache
+
+	mu      sync.Mutex
+	answers map[string]int
+}
+
+type ChainOption func(c *Chain)
+
+// WithCache memoizes up to size translations for ttl. Texts without a translation are
+// memoized too, unless a backend failed to translate them.
+func WithCache(size int, ttl time.Duration) ChainOption {
+	return func(c *Chain) {
+		c.cache = newChainCache(size, ttl)
+	}
+}
+
+func NewChain(backends []Backend, opts ...ChainOption) *Chain {
+	c := &Chain{
+		backends: backends,
+		answers:  map[string]int{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Chain) TranslateEnToRu(ctx context.Context, text string) (string, error) {
+	translation, err := c.Translate(ctx, text)
+	if err != nil {
+		return "", err
+	}
+	return translation.Text, nil
+}
+
+// Translate translates the text from English to Russian and tells the backend that answered,
+// CacheBackend for memoized translations.
+func (c *Chain) Translate(ctx context.Context, text string) (*Translation, error) {
+	if translation, ok := c.cache.get(text); ok {
+		if translation == nil {
+			return nil, fmt.Errorf("%q has no translation: %w", text, NoTranslationError)
+		}
+		c.record(CacheBackend)
+		return &Translation{Text: translation.Text, Backend: CacheBackend}, nil
+	}
+
+	translation, failures := c.translate(ctx, text)
+	switch {
+	case translation != nil:
+		c.cache.set(text, translation)
+		c.record(translation.Backend)
+		return translation, nil
+	case failures == nil:
+		c.cache.set(text, nil)
+	}
+	return nil, fmt.Errorf("%q has no translation: %w", text, errors.Join(NoTranslationError, failures))
+}
+
+// Answers returns the number of translations answered by every backend.
+func (c *Chain) Answers() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	answers := make(map[string]int, len(c.answers))
+	for backend, count := range c.answers {
+		answers[backend] = count
+	}
+	return answers
+}
+
+// Check checks the backends able to check themselves, e.g. a LazyClient.
+func (c *Chain) Check(ctx context.Context) error {
+	var errs []error
+	for _, backend := range c.backends {
+		if checker, ok := backend.Translator.(interface{ Check(context.Context) error }); ok {
+			if err := checker.Check(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", backend.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Chain) Close() error {
+	var errs []error
+	for _, backend := range c.backends {
+		if err := backend.Translator.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close %s: %w", backend.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// translate returns the translation of the first backend answering, or the failures of
+// the backends when none answers.
+func (c *Chain) translate(ctx context.Context, text string) (*Translation, error) {
+	var errs []error
+	for _, backend := range c.backends {
+		translated, err := backend.Translator.TranslateEnToRu(ctx, text)
+		if err == nil {
+			return &Translation{Text: translated, Backend: backend.Name}, nil
+		}
+		if !errors.Is(err, NoTranslationError) {
+			errs = append(errs, fmt.Errorf("%s: %w", backend.Name, err))
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (c *Chain) record(backend string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.answers[backend]++
+}
+
+// chainCache is an LRU cache of translations expiring after the TTL. A nil translation
+// memoizes a text without one.
+type chainCache struct {
+	size    int
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+type chainCacheEntry struct {
+	text        string
+	translation *Translation
+	expiresAt   time.Time
+}
+
+func newChainCache(size int, ttl time.Duration) *chainCache {
+	return &chainCache{
+		size:    size,
+		ttl:     ttl,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+func (cc *chainCache) get(text string) (*Translation, bool) {
+	if cc == nil {
+		return nil, false
+	}
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	element, ok := cc.entries[text]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*chainCacheEntry)
+	if cc.now().After(entry.expiresAt) {
+		cc.order.Remove(element)
+		delete(cc.entries, text)
+		return nil, false
+	}
+	cc.order.MoveToFront(element)
+	return entry.translation, true
+}
+
+func (cc *chainCache) set(text string, translation *Translation) {
+	if cc == nil {
+		return
+	}
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	expiresAt := cc.now().Add(cc.ttl)
+	if element, ok := cc.entries[text]; ok {
+		entry := element.Value.(*chainCacheEntry)
+		entry.translation, entry.expiresAt = translation, expiresAt
+		cc.order.MoveToFront(element)
+		return
+	}
+
+	cc.entries[text] = cc.order.PushFront(&chainCacheEntry{text: text, translation: translation, expiresAt: expiresAt})
+	for cc.order.Len() > cc.size {
+		back := cc.order.Back()
+		cc.order.Remove(back)
+		delete(cc.entries, back.Value.(*chainCacheEntry).text)
+	}
+}
@@ -0,0 +1,143 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type countingTranslator struct {
+	translations map[string]string
+	err          error
+	calls        int
+	closed       bool
+}
+
+func (ct *countingTranslator) TranslateEnToRu(_ context.Context, text string) (string, error) {
+	ct.calls++
+	if ct.err != nil {
+		return "", ct.err
+	}
+	translation, ok := ct.translations[text]
+	if !ok {
+		return "", NoTranslationError
+	}
+	return translation, nil
+}
+
+func (ct *countingTranslator) Close() error {
+	ct.closed = true
+	return nil
+}
+
+func TestChain_Translate(t *testing.T) {
+	ctx := context.Background()
+	dictionary := NewDictionary()
+	dictionary.Add("Zemfira", "Земфира")
+	remote := &countingTranslator{translations: map[string]string{"leningrad cord": "ленинград"}}
+	chain := NewChain([]Backend{
+		{Name: "dictionary", Translator: dictionary},
+		{Name: "remote", Translator: remote},
+	})
+
+	translation, err := chain.Translate(ctx, "zemfira")
+	require.NoError(t, err)
+	require.Equal(t, &Translation{Text: "Земфира", Backend: "dictionary"}, translation)
+	require.Zero(t, remote.calls)
+
+	translation, err = chain.Translate(ctx, "leningrad cord")
+	require.NoError(t, err)
+	require.Equal(t, &Translation{Text: "ленинград", Backend: "remote"}, translation)
+
+	_, err = chain.Translate(ctx, "radiohead")
+	require.ErrorIs(t, err, NoTranslationError)
+
+	require.Equal(t, map[string]int{"dictionary": 1, "remote": 1}, chain.Answers())
+
+	require.NoError(t, chain.Close())
+	require.True(t, remote.closed)
+}
+
+func TestChain_TranslateFailingBackend(t *testing.T) {
+	ctx := context.Background()
+	remoteErr := errors.New("remote is down")
+	failing := &countingTranslator{err: remoteErr}
+
+	chain := NewChain([]Backend{
+		{Name: "remote", Translator: failing},
+		{Name: "fallback", Translator: &countingTranslator{translations: map[string]string{"kino": "кино"}}},
+	}, WithCache(10, time.Hour))
+
+	translated, err := chain.TranslateEnToRu(ctx, "kino")
+	require.NoError(t, err)
+	require.Equal(t, "кино", translated)
+
+	// A failure is no translation, but it is not memoized.
+	_, err = chain.TranslateEnToRu(ctx, "splean")
+	require.ErrorIs(t, err, NoTranslationError)
+	require.ErrorIs(t, err, remoteErr)
+
+	_, err = chain.TranslateEnToRu(ctx, "splean")
+	require.ErrorIs(t, err, NoTranslationError)
+	require.Equal(t, 3, failing.calls)
+}
+
+func TestChain_TranslateCached(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	remote := &countingTranslator{translations: map[string]string{
+		"kino":  "кино",
+		"alisa": "алиса",
+		"chaif": "чайф",
+	}}
+	chain := NewChain([]Backend{{Name: "remote", Translator: remote}}, WithCache(2, time.Hour))
+	chain.cache.now = func() time.Time { return now }
+
+	translation, err := chain.Translate(ctx, "kino")
+	require.NoError(t, err)
+	require.Equal(t, "remote", translation.Backend)
+
+	translation, err = chain.Translate(ctx, "kino")
+	require.NoError(t, err)
+	require.Equal(t, &Translation{Text: "кино", Backend: CacheBackend}, translation)
+	require.Equal(t, 1, remote.calls)
+
+	_, err = chain.Translate(ctx, "radiohead")
+	require.ErrorIs(t, err, NoTranslationError)
+	_, err = chain.Translate(ctx, "radiohead")
+	require.ErrorIs(t, err, NoTranslationError)
+	require.Equal(t, 2, remote.calls)
+
+	// "kino" is the least recently used entry and is evicted over the size.
+	_, err = chain.Translate(ctx, "alisa")
+	require.NoError(t, err)
+	_, err = chain.Translate(ctx, "kino")
+	require.NoError(t, err)
+	require.Equal(t, 4, remote.calls)
+
+	now = now.Add(2 * time.Hour)
+	translation, err = chain.Translate(ctx, "kino")
+	require.NoError(t, err)
+	require.Equal(t, "remote", translation.Backend)
+	require.Equal(t, 5, remote.calls)
+
+	require.Equal(t, map[string]int{"remote": 4, CacheBackend: 1}, chain.Answers())
+}
+
+func TestChain_Check(t *testing.T) {
+	ctx := context.Background()
+	constructErr := errors.New("invalid credentials")
+	chain := NewChain([]Backend{
+		{Name: "dictionary", Translator: NewDictionary()},
+		{Name: "remote", Translator: NewLazyClient(func(context.Context) (Translator, error) {
+			return nil, constructErr
+		})},
+	})
+	require.ErrorIs(t, chain.Check(ctx), constructErr)
+
+	_, err := chain.TranslateEnToRu(ctx, "zemfira")
+	require.ErrorIs(t, err, NoTranslationError)
+}
@@ -0,0 +1,133 @@
+package translator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+var NoTranslationError = errors.New("no translation")
+
+//go:embed artists.tsv
+var defaultArtists []byte
+
+// Dictionary is a bilingual dictionary of artist names. Names are looked up case-insensitively
+// and translated to the spelling they were added with.
+type Dictionary struct {
+	mu     sync.RWMutex
+	enToRu map[string]string
+	ruToEn map[string]string
+}
+
+func NewDictionary() *Dictionary {
+	return &Dictionary{
+		enToRu: map[string]string{},
+		ruToEn: map[string]string{},
+	}
+}
+
+// DefaultDictionary returns a new dictionary holding the names built into the package.
+func DefaultDictionary() *Dictionary {
+	d := NewDictionary()
+	if err := d.Load(bytes.NewReader(defaultArtists)); err != nil {
+		panic(fmt.Sprintf("invalid default artists dictionary: %s", err))
+	}
+	return d
+}
+
+func LoadDictionary(r io.Reader) (*Dictionary, error) {
+	d := NewDictionary()
+	if err := d.Load(r); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func LoadDictionaryFile(path string) (*Dictionary, error) {
+	d := NewDictionary()
+	if err := d.LoadFile(path); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Load adds the names read from r. Every line holds an English and a Russian name separated
+// by a tab; empty lines and lines starting with # are skipped.
+func (d *Dictionary) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		en, ru, ok := strings.Cut(text, "\t")
+		en, ru = strings.TrimSpace(en), strings.TrimSpace(ru)
+		if !ok || en == "" || ru == "" {
+			return fmt.Errorf("invalid dictionary line %d: %q", line, text)
+		}
+		d.Add(en, ru)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read dictionary: %w", err)
+	}
+	return nil
+}
+
+func (d *Dictionary) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open dictionary: %w", err)
+	}
+	defer file.Close()
+
+	return d.Load(file)
+}
+
+// Add adds a pair of names. An English name added again is translated to the latest Russian
+// spelling, while a Russian name keeps the English spelling it was added with first.
+func (d *Dictionary) Add(en, ru string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.enToRu[strings.ToLower(en)] = ru
+	if _, ok := d.ruToEn[strings.ToLower(ru)]; !ok {
+		d.ruToEn[strings.ToLower(ru)] = en
+	}
+}
+
+func (d *Dictionary) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return len(d.enToRu)
+}
+
+func (d *Dictionary) TranslateEnToRu(_ context.Context, text string) (string, error) {
+	return d.lookup(d.enToRu, text)
+}
+
+func (d *Dictionary) TranslateRuToEn(_ context.Context, text string) (string, error) {
+	return d.lookup(d.ruToEn, text)
+}
+
+func (d *Dictionary) Close() error {
+	return nil
+}
+
+func (d *Dictionary) lookup(names map[string]string, text string) (string, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	translation, ok := names[strings.ToLower(strings.TrimSpace(text))]
+	if !ok {
+		return "", fmt.Errorf("%q is not in dictionary: %w", text, NoTranslationError)
+	}
+	return translation, nil
+}
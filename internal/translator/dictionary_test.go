@@ -0,0 +1,96 @@
+package translator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDictionary(t *testing.T) {
+	ctx := context.Background()
+	d, err := LoadDictionary(strings.NewReader("# comment\n\nZemfira\tЗемфира\nSplean\tСплин\nSplin\tСплин\n"))
+	require.NoError(t, err)
+	require.Equal(t, 3, d.Len())
+
+	tests := []struct {
+		name      string
+		translate func(context.Context, string) (string, error)
+		text      string
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "english name",
+			translate: d.TranslateEnToRu,
+			text:      "Zemfira",
+			want:      "Земфира",
+		},
+		{
+			name:      "english name in other case",
+			translate: d.TranslateEnToRu,
+			text:      "zemfira",
+			want:      "Земфира",
+		},
+		{
+			name:      "alternative spelling",
+			translate: d.TranslateEnToRu,
+			text:      "splin",
+			want:      "Сплин",
+		},
+		{
+			name:      "russian name",
+			translate: d.TranslateRuToEn,
+			text:      "сплин",
+			want:      "Splean",
+		},
+		{
+			name:      "unknown name",
+			translate: d.TranslateEnToRu,
+			text:      "Radiohead",
+			wantErr:   NoTranslationError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.translate(ctx, tt.text)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestLoadDictionary_invalidLine(t *testing.T) {
+	_, err := LoadDictionary(strings.NewReader("Zemfira\tЗемфира\nSplean Сплин\n"))
+	require.ErrorContains(t, err, "invalid dictionary line 2")
+}
+
+func TestLoadDictionaryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "artists.tsv")
+	require.NoError(t, os.WriteFile(path, []byte("Leningrad Cord\tЛенинград\n"), 0o600))
+
+	d, err := LoadDictionaryFile(path)
+	require.NoError(t, err)
+
+	result, err := d.TranslateEnToRu(context.Background(), "leningrad cord")
+	require.NoError(t, err)
+	require.Equal(t, "Ленинград", result)
+
+	_, err = LoadDictionaryFile(filepath.Join(t.TempDir(), "missing.tsv"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDefaultDictionary(t *testing.T) {
+	d := DefaultDictionary()
+	require.NotZero(t, d.Len())
+
+	result, err := d.TranslateEnToRu(context.Background(), "Zemfira")
+	require.NoError(t, err)
+	require.Equal(t, "Земфира", result)
+
+	d.Add("Sample Artist", "Сэмпл Артист")
+	require.NotEqual(t, d.Len(), DefaultDictionary().Len())
+}
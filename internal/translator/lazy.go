@@ -0,0 +1,70 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// LazyClient constructs the translator on first use, so the credentials are only checked
+// once a name actually needs to be translated. Failed construction is not retried: the
+// failure is returned by every later use and by Check.
+type LazyClient struct {
+	mu           sync.Mutex
+	construct    func(ctx context.Context) (Translator, error)
+	translator   Translator
+	constructErr error
+}
+
+func NewLazyClient(construct func(ctx context.Context) (Translator, error)) *LazyClient {
+	return &LazyClient{
+		construct: construct,
+	}
+}
+
+func (lc *LazyClient) TranslateEnToRu(ctx context.Context, text string) (string, error) {
+	translator, err := lc.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return translator.TranslateEnToRu(ctx, text)
+}
+
+// Check constructs the translator unless it is constructed already.
+func (lc *LazyClient) Check(ctx context.Context) error {
+	_, err := lc.get(ctx)
+	return err
+}
+
+func (lc *LazyClient) Close() error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if lc.translator == nil {
+		return nil
+	}
+	return lc.translator.Close()
+}
+
+func (lc *LazyClient) get(ctx context.Context) (Translator, error) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if lc.translator != nil {
+		return lc.translator, nil
+	}
+	if lc.constructErr != nil {
+		return nil, lc.constructErr
+	}
+	translator, err := lc.construct(ctx)
+	if err != nil {
+		err = fmt.Errorf("failed to construct translator: %w", err)
+		// Construction cut short by the context is retried.
+		if ctx.Err() == nil {
+			lc.constructErr = err
+		}
+		return nil, err
+	}
+	lc.translator = translator
+	return translator, nil
+}
@@ -0,0 +1,83 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type translatorStub struct {
+	closed bool
+}
+
+func (ts *translatorStub) TranslateEnToRu(_ context.Context, text string) (string, error) {
+	return "ru:" + text, nil
+}
+
+func (ts *translatorStub) Close() error {
+	ts.closed = true
+	return nil
+}
+
+func TestLazyClient(t *testing.T) {
+	ctx := context.Background()
+	constructErr := errors.New("invalid credentials")
+
+	t.Run("constructed", func(t *testing.T) {
+		constructed := 0
+		stub := &translatorStub{}
+		lc := NewLazyClient(func(context.Context) (Translator, error) {
+			constructed++
+			return stub, nil
+		})
+		require.NoError(t, lc.Close())
+		require.Zero(t, constructed)
+
+		translated, err := lc.TranslateEnToRu(ctx, "zemfira")
+		require.NoError(t, err)
+		require.Equal(t, "ru:zemfira", translated)
+
+		require.NoError(t, lc.Check(ctx))
+		require.Equal(t, 1, constructed)
+
+		require.NoError(t, lc.Close())
+		require.True(t, stub.closed)
+	})
+
+	t.Run("construction failed", func(t *testing.T) {
+		constructed := 0
+		lc := NewLazyClient(func(context.Context) (Translator, error) {
+			constructed++
+			return nil, constructErr
+		})
+
+		_, err := lc.TranslateEnToRu(ctx, "zemfira")
+		require.ErrorIs(t, err, constructErr)
+		_, err = lc.TranslateEnToRu(ctx, "zemfira")
+		require.ErrorIs(t, err, constructErr)
+		require.ErrorIs(t, lc.Check(ctx), constructErr)
+		require.Equal(t, 1, constructed)
+		require.NoError(t, lc.Close())
+	})
+
+	t.Run("construction canceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		constructed := 0
+		lc := NewLazyClient(func(ctx context.Context) (Translator, error) {
+			constructed++
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return &translatorStub{}, nil
+		})
+
+		_, err := lc.TranslateEnToRu(canceledCtx, "zemfira")
+		require.ErrorIs(t, err, context.Canceled)
+		require.NoError(t, lc.Check(ctx))
+		require.Equal(t, 2, constructed)
+	})
+}
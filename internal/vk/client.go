@@ -0,0 +1,280 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	defaultAPIURL = "https://api.vk.com/method"
+	apiVersion    = "5.199"
+
+	playlistTracksPageLimit = 100
+)
+
+var (
+	NotFoundError = errors.New("not found")
+)
+
+// API errors are reported in the body of responses with the 200 status.
+const (
+	authorizationFailedCode = 5
+	tooManyRequestsCode     = 6
+	floodControlCode        = 9
+	internalErrorCode       = 10
+	accessDeniedCode        = 15
+	pageRemovedCode         = 18
+	rateLimitReachedCode    = 29
+)
+
+type Client interface {
+	FetchTrack(ctx context.Context, id string) (*Track, error)
+	SearchTrack(ctx context.Context, query string) (*Track, error)
+	SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error)
+	FetchAlbum(ctx context.Context, id string) (*Playlist, error)
+	SearchAlbum(ctx context.Context, query string) (*Playlist, error)
+	SearchAlbums(ctx context.Context, query string, limit int) ([]*Playlist, error)
+	FetchArtist(ctx context.Context, id string) (*Artist, error)
+	SearchArtist(ctx context.Context, query string) (*Artist, error)
+	FetchPlaylist(ctx context.Context, id string) (*Playlist, error)
+}
+
+type HTTPClient struct {
+	apiURL      string
+	accessToken string
+	httpClient  *http.Client
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
+}
+
+type apiResponse[T any] struct {
+	Response T         `json:"response"`
+	Error    *apiError `json:"error"`
+}
+
+type apiError struct {
+	Code    int    `json:"error_code"`
+	Message string `json:"error_msg"`
+}
+
+type itemsResponse[T any] struct {
+	Count int  `json:"count"`
+	Items []*T `json:"items"`
+}
+
+func NewHTTPClient(accessToken string, opts ...ClientOption) *HTTPClient {
+	c := HTTPClient{
+		apiURL:      defaultAPIURL,
+		accessToken: accessToken,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
+
+	return &c
+}
+
+func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error) {
+	tracks, err := callMethod[[]*Track](ctx, c, "audio.getById", url.Values{"audios": []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(tracks) == 0 {
+		return nil, NotFoundError
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) SearchTrack(ctx context.Context, query string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error) {
+	return searchItems[Track](ctx, c, "audio.search", query, limit)
+}
+
+func (c *HTTPClient) FetchAlbum(ctx context.Context, id string) (*Playlist, error) {
+	return c.fetchPlaylist(ctx, id)
+}
+
+func (c *HTTPClient) SearchAlbum(ctx context.Context, query string) (*Playlist, error) {
+	albums, err := c.SearchAlbums(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) SearchAlbums(ctx context.Context, query string, limit int) ([]*Playlist, error) {
+	return searchItems[Playlist](ctx, c, "audio.searchAlbums", query, limit)
+}
+
+func (c *HTTPClient) FetchArtist(ctx context.Context, id string) (*Artist, error) {
+	artist, err := callMethod[*Artist](ctx, c, "audio.getArtistById", url.Values{"artist_id": []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	if artist == nil {
+		return nil, NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *HTTPClient) SearchArtist(ctx context.Context, query string) (*Artist, error) {
+	artists, err := searchItems[Artist](ctx, c, "audio.searchArtists", query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return artists[0], nil
+}
+
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	playlist, err := c.fetchPlaylist(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for offset := 0; ; offset += playlistTracksPageLimit {
+		query := url.Values{
+			"owner_id": []string{strconv.Itoa(playlist.OwnerID)},
+			"album_id": []string{strconv.Itoa(playlist.ID)},
+			"count":    []string{strconv.Itoa(playlistTracksPageLimit)},
+			"offset":   []string{strconv.Itoa(offset)},
+		}
+		if playlist.AccessKey != "" {
+			query.Set("access_key", playlist.AccessKey)
+		}
+		page, err := callMethod[itemsResponse[Track]](ctx, c, "audio.get", query)
+		if err != nil {
+			return nil, err
+		}
+		playlist.Tracks = append(playlist.Tracks, page.Items...)
+		if len(page.Items) == 0 || len(playlist.Tracks) >= page.Count {
+			break
+		}
+	}
+
+	return playlist, nil
+}
+
+func (c *HTTPClient) fetchPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	playlistID, ok := ParsePlaylistID(id)
+	if !ok {
+		return nil, NotFoundError
+	}
+
+	query := url.Values{
+		"owner_id":    []string{playlistID.OwnerID},
+		"playlist_id": []string{playlistID.ID},
+	}
+	if playlistID.AccessKey != "" {
+		query.Set("access_key", playlistID.AccessKey)
+	}
+	playlist, err := callMethod[*Playlist](ctx, c, "audio.getPlaylistById", query)
+	if err != nil {
+		return nil, err
+	}
+	if playlist == nil {
+		return nil, NotFoundError
+	}
+	if playlist.AccessKey == "" {
+		playlist.AccessKey = playlistID.AccessKey
+	}
+	return playlist, nil
+}
+
+func searchItems[T any](ctx context.Context, c *HTTPClient, method, query string, limit int) ([]*T, error) {
+	result, err := callMethod[itemsResponse[T]](ctx, c, method, url.Values{
+		"q":     []string{query},
+		"count": []string{strconv.Itoa(limit)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Items) == 0 {
+		return nil, NotFoundError
+	}
+	return result.Items[:min(limit, len(result.Items))], nil
+}
+
+func callMethod[T any](ctx context.Context, c *HTTPClient, method string, query url.Values) (T, error) {
+	var empty T
+
+	body, err := c.getAPI(ctx, method, query)
+	if err != nil {
+		return empty, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	response := apiResponse[T]{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return empty, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if response.Error != nil {
+		return empty, response.Error.classify()
+	}
+	return response.Response, nil
+}
+
+func (c *HTTPClient) getAPI(ctx context.Context, method string, query url.Values) ([]byte, error) {
+	query.Set("access_token", c.accessToken)
+	query.Set("v", apiVersion)
+
+	u := fmt.Sprintf("%s/%s", c.apiURL, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, NotFoundError
+	default:
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+}
+
+func (e *apiError) classify() error {
+	var err error
+	switch e.Code {
+	case accessDeniedCode, pageRemovedCode:
+		return NotFoundError
+	case authorizationFailedCode:
+		err = apierr.UnauthorizedError
+	case tooManyRequestsCode, floodControlCode, rateLimitReachedCode:
+		err = apierr.RateLimitedError
+	case internalErrorCode:
+		err = apierr.UpstreamUnavailableError
+	default:
+		return fmt.Errorf("unexpected API error %d: %s", e.Code, e.Message)
+	}
+	return fmt.Errorf("API error %d: %s: %w", e.Code, e.Message, err)
+}
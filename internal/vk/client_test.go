@@ -0,0 +1,203 @@
+package vk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClient_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      string
+		expectedTrack *Track
+		expectedErr   error
+	}{
+		{
+			name: "found track",
+			response: `{"response": [{
+				"id": 456239017,
+				"owner_id": -2001234567,
+				"access_key": "4f1c2b7e",
+				"artist": "Земфира",
+				"title": "Хочешь?",
+				"duration": 213,
+				"main_artists": [{"id": "4409542542404787437", "name": "Земфира", "domain": "zemfira"}],
+				"album": {"id": 13089, "owner_id": -2000413089, "title": "Спасибо", "thumb": {"photo_1200": "https://sun9-1.userapi.com/sample.jpg"}}
+			}]}`,
+			expectedTrack: &Track{
+				ID:          456239017,
+				OwnerID:     -2001234567,
+				AccessKey:   "4f1c2b7e",
+				Artist:      "Земфира",
+				Title:       "Хочешь?",
+				Duration:    213,
+				MainArtists: []Artist{{ID: "4409542542404787437", Name: "Земфира", Domain: "zemfira"}},
+				Album: &TrackAlbum{
+					ID:      13089,
+					OwnerID: -2000413089,
+					Title:   "Спасибо",
+					Thumb:   &Photo{Photo1200: "https://sun9-1.userapi.com/sample.jpg"},
+				},
+			},
+		},
+		{
+			name:        "empty response",
+			response:    `{"response": []}`,
+			expectedErr: NotFoundError,
+		},
+		{
+			name:        "access denied",
+			response:    `{"error": {"error_code": 15, "error_msg": "Access denied"}}`,
+			expectedErr: NotFoundError,
+		},
+		{
+			name:        "authorization failed",
+			response:    `{"error": {"error_code": 5, "error_msg": "User authorization failed: invalid access_token"}}`,
+			expectedErr: apierr.UnauthorizedError,
+		},
+		{
+			name:        "too many requests",
+			response:    `{"error": {"error_code": 6, "error_msg": "Too many requests per second"}}`,
+			expectedErr: apierr.RateLimitedError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/audio.getById", r.URL.Path)
+				require.Equal(t, "-2001234567_456239017_4f1c2b7e", r.URL.Query().Get("audios"))
+				require.Equal(t, "sampleToken", r.URL.Query().Get("access_token"))
+				require.Equal(t, apiVersion, r.URL.Query().Get("v"))
+				_, err := w.Write([]byte(tt.response))
+				require.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			client := NewHTTPClient("sampleToken", WithAPIURL(mockServer.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			track, err := client.FetchTrack(ctx, "-2001234567_456239017_4f1c2b7e")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, track)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, track)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchTracks(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/audio.search", r.URL.Path)
+		require.Equal(t, "земфира – хочешь?", r.URL.Query().Get("q"))
+		require.Equal(t, "2", r.URL.Query().Get("count"))
+		_, err := w.Write([]byte(`{"response": {"count": 120, "items": [
+			{"id": 1, "owner_id": -2001234567, "artist": "Земфира", "title": "Хочешь?"},
+			{"id": 2, "owner_id": 1234, "artist": "Земфира", "title": "Хочешь? (Live)"}
+		]}}`))
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient("sampleToken", WithAPIURL(mockServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tracks, err := client.SearchTracks(ctx, "земфира – хочешь?", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Track{
+		{ID: 1, OwnerID: -2001234567, Artist: "Земфира", Title: "Хочешь?"},
+		{ID: 2, OwnerID: 1234, Artist: "Земфира", Title: "Хочешь? (Live)"},
+	}, tracks)
+}
+
+func TestHTTPClient_SearchArtistNotFound(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/audio.searchArtists", r.URL.Path)
+		_, err := w.Write([]byte(`{"response": {"count": 0, "items": []}}`))
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient("sampleToken", WithAPIURL(mockServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.SearchArtist(ctx, "not found")
+	require.ErrorIs(t, err, NotFoundError)
+	require.Nil(t, artist)
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		require.Equal(t, "1234", query.Get("owner_id"))
+		require.Equal(t, "9a8b7c", query.Get("access_key"))
+
+		var err error
+		switch r.URL.Path {
+		case "/audio.getPlaylistById":
+			require.Equal(t, "58", query.Get("playlist_id"))
+			_, err = w.Write([]byte(`{"response": {"id": 58, "owner_id": 1234, "title": "sample playlist"}}`))
+		case "/audio.get":
+			require.Equal(t, "58", query.Get("album_id"))
+			if query.Get("offset") == "0" {
+				_, err = w.Write([]byte(`{"response": {"count": 2, "items": [{"id": 1, "owner_id": 1, "title": "first"}]}}`))
+			} else {
+				require.Equal(t, "100", query.Get("offset"))
+				_, err = w.Write([]byte(`{"response": {"count": 2, "items": [{"id": 2, "owner_id": 1, "title": "second"}]}}`))
+			}
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient("sampleToken", WithAPIURL(mockServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	playlist, err := client.FetchPlaylist(ctx, "1234_58_9a8b7c")
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{
+		ID:        58,
+		OwnerID:   1234,
+		AccessKey: "9a8b7c",
+		Title:     "sample playlist",
+		Tracks: []*Track{
+			{ID: 1, OwnerID: 1, Title: "first"},
+			{ID: 2, OwnerID: 1, Title: "second"},
+		},
+	}, playlist)
+}
+
+func TestHTTPClient_UnexpectedStatus(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient("sampleToken", WithAPIURL(mockServer.URL), WithRetryPolicy(retry.Policy{MaxAttempts: 1}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.FetchArtist(ctx, "zemfira")
+	require.ErrorIs(t, err, apierr.UpstreamUnavailableError)
+}
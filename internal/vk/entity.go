@@ -0,0 +1,210 @@
+package vk
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+const (
+	baseURL = "https://vk.com"
+
+	albumPlaylistType = 1
+)
+
+var (
+	TrackRe    = regexp.MustCompile(`https://(?:m\.)?vk\.(?:com|ru)/audio(-?\d+_\d+(?:_[0-9a-f]+)?)`)
+	AlbumRe    = regexp.MustCompile(`https://(?:m\.)?vk\.(?:com|ru)/music/album/(-?\d+_\d+(?:_[0-9a-f]+)?)`)
+	ArtistRe   = regexp.MustCompile(`https://(?:m\.)?vk\.(?:com|ru)/artist/([\w.]+)`)
+	PlaylistRe = regexp.MustCompile(`https://(?:m\.)?vk\.(?:com|ru)/music/playlist/(-?\d+_\d+(?:_[0-9a-f]+)?)`)
+
+	idRe = regexp.MustCompile(`^(-?\d+)_(\d+)(?:_([0-9a-f]+))?$`)
+)
+
+type Track struct {
+	ID          int         `json:"id"`
+	OwnerID     int         `json:"owner_id"`
+	AccessKey   string      `json:"access_key"`
+	Artist      string      `json:"artist"`
+	Title       string      `json:"title"`
+	Subtitle    string      `json:"subtitle"`
+	Duration    int         `json:"duration"`
+	IsExplicit  bool        `json:"is_explicit"`
+	MainArtists []Artist    `json:"main_artists"`
+	Album       *TrackAlbum `json:"album"`
+}
+
+type TrackAlbum struct {
+	ID        int    `json:"id"`
+	OwnerID   int    `json:"owner_id"`
+	AccessKey string `json:"access_key"`
+	Title     string `json:"title"`
+	Thumb     *Photo `json:"thumb"`
+}
+
+// Playlist is both an album and a user playlist, the API tells them apart by the type.
+type Playlist struct {
+	ID          int      `json:"id"`
+	OwnerID     int      `json:"owner_id"`
+	AccessKey   string   `json:"access_key"`
+	Type        int      `json:"type"`
+	Title       string   `json:"title"`
+	Year        int      `json:"year"`
+	IsExplicit  bool     `json:"is_explicit"`
+	MainArtists []Artist `json:"main_artists"`
+	Photo       *Photo   `json:"photo"`
+	Tracks      []*Track `json:"-"`
+}
+
+type Artist struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Domain string `json:"domain"`
+}
+
+type Photo struct {
+	Photo300  string `json:"photo_300"`
+	Photo600  string `json:"photo_600"`
+	Photo1200 string `json:"photo_1200"`
+}
+
+// PlaylistID is the owner and playlist IDs with the access key required for non-public playlists.
+type PlaylistID struct {
+	OwnerID   string
+	ID        string
+	AccessKey string
+}
+
+func DetectTrackID(trackURL string) string {
+	match := TrackRe.FindStringSubmatch(trackURL)
+	if match == nil || len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func DetectAlbumID(albumURL string) string {
+	match := AlbumRe.FindStringSubmatch(albumURL)
+	if match == nil || len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func DetectArtistID(artistURL string) string {
+	match := ArtistRe.FindStringSubmatch(artistURL)
+	if match == nil || len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	match := PlaylistRe.FindStringSubmatch(playlistURL)
+	if match == nil || len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func ParsePlaylistID(id string) (*PlaylistID, bool) {
+	match := idRe.FindStringSubmatch(id)
+	if match == nil {
+		return nil, false
+	}
+	return &PlaylistID{OwnerID: match[1], ID: match[2], AccessKey: match[3]}, true
+}
+
+func (t *Track) FullID() string {
+	return fullID(t.OwnerID, t.ID, t.AccessKey)
+}
+
+func (t *Track) URL() string {
+	return fmt.Sprintf("%s/audio%d_%d", baseURL, t.OwnerID, t.ID)
+}
+
+// ArtistNames returns the main artists or the artist line when the track has no catalog artists.
+func (t *Track) ArtistNames() []string {
+	if len(t.MainArtists) == 0 {
+		return []string{t.Artist}
+	}
+	names := make([]string, 0, len(t.MainArtists))
+	for _, artist := range t.MainArtists {
+		names = append(names, artist.Name)
+	}
+	return names
+}
+
+func (t *Track) ImageURL() string {
+	if t.Album == nil {
+		return ""
+	}
+	return t.Album.Thumb.largest()
+}
+
+func (p *Playlist) FullID() string {
+	return fullID(p.OwnerID, p.ID, p.AccessKey)
+}
+
+func (p *Playlist) IsAlbum() bool {
+	return p.Type == albumPlaylistType
+}
+
+func (p *Playlist) URL() string {
+	section := "playlist"
+	if p.IsAlbum() {
+		section = "album"
+	}
+	return fmt.Sprintf("%s/music/%s/%s", baseURL, section, p.FullID())
+}
+
+func (p *Playlist) ArtistNames() []string {
+	names := make([]string, 0, len(p.MainArtists))
+	for _, artist := range p.MainArtists {
+		names = append(names, artist.Name)
+	}
+	return names
+}
+
+func (p *Playlist) ImageURL() string {
+	return p.Photo.largest()
+}
+
+func (p *Playlist) ReleaseDate() string {
+	if p.Year == 0 {
+		return ""
+	}
+	return strconv.Itoa(p.Year)
+}
+
+// Handle returns the domain of the artist page, which the API accepts in place of the ID.
+func (a *Artist) Handle() string {
+	if a.Domain == "" {
+		return a.ID
+	}
+	return a.Domain
+}
+
+func (a *Artist) URL() string {
+	return fmt.Sprintf("%s/artist/%s", baseURL, a.Handle())
+}
+
+func (p *Photo) largest() string {
+	switch {
+	case p == nil:
+		return ""
+	case p.Photo1200 != "":
+		return p.Photo1200
+	case p.Photo600 != "":
+		return p.Photo600
+	default:
+		return p.Photo300
+	}
+}
+
+func fullID(ownerID, id int, accessKey string) string {
+	if accessKey == "" {
+		return fmt.Sprintf("%d_%d", ownerID, id)
+	}
+	return fmt.Sprintf("%d_%d_%s", ownerID, id, accessKey)
+}
@@ -0,0 +1,177 @@
+package vk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrack_URL(t *testing.T) {
+	track := Track{ID: 456239017, OwnerID: -2001234567, AccessKey: "4f1c2b7e"}
+	require.Equal(t, "https://vk.com/audio-2001234567_456239017", track.URL())
+	require.Equal(t, "-2001234567_456239017_4f1c2b7e", track.FullID())
+}
+
+func TestTrack_ArtistNames(t *testing.T) {
+	track := Track{Artist: "Земфира feat. Рената Литвинова"}
+	require.Equal(t, []string{"Земфира feat. Рената Литвинова"}, track.ArtistNames())
+
+	track.MainArtists = []Artist{{Name: "Земфира"}, {Name: "Рената Литвинова"}}
+	require.Equal(t, []string{"Земфира", "Рената Литвинова"}, track.ArtistNames())
+}
+
+func TestPlaylist_URL(t *testing.T) {
+	album := Playlist{ID: 13089, OwnerID: -2000413089, AccessKey: "c1e9a3f2", Type: albumPlaylistType}
+	require.Equal(t, "https://vk.com/music/album/-2000413089_13089_c1e9a3f2", album.URL())
+
+	playlist := Playlist{ID: 58, OwnerID: 1234}
+	require.Equal(t, "https://vk.com/music/playlist/1234_58", playlist.URL())
+}
+
+func TestArtist_URL(t *testing.T) {
+	artist := Artist{ID: "4409542542404787437", Domain: "zemfira"}
+	require.Equal(t, "https://vk.com/artist/zemfira", artist.URL())
+
+	artist = Artist{ID: "4409542542404787437"}
+	require.Equal(t, "https://vk.com/artist/4409542542404787437", artist.URL())
+}
+
+func TestPhoto_largest(t *testing.T) {
+	var photo *Photo
+	require.Equal(t, "", photo.largest())
+
+	photo = &Photo{Photo300: "300.jpg", Photo600: "600.jpg"}
+	require.Equal(t, "600.jpg", photo.largest())
+
+	photo.Photo1200 = "1200.jpg"
+	require.Equal(t, "1200.jpg", photo.largest())
+}
+
+func TestParsePlaylistID(t *testing.T) {
+	id, ok := ParsePlaylistID("-2000413089_13089_c1e9a3f2")
+	require.True(t, ok)
+	require.Equal(t, &PlaylistID{OwnerID: "-2000413089", ID: "13089", AccessKey: "c1e9a3f2"}, id)
+
+	id, ok = ParsePlaylistID("1234_58")
+	require.True(t, ok)
+	require.Equal(t, &PlaylistID{OwnerID: "1234", ID: "58"}, id)
+
+	_, ok = ParsePlaylistID("zemfira")
+	require.False(t, ok)
+}
+
+func Test_DetectTrackID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://vk.com/audio-2001234567_456239017",
+			expected: "-2001234567_456239017",
+		},
+		{
+			name:     "Valid URL with access key",
+			inputURL: "https://vk.com/audio-2001234567_456239017_4f1c2b7e",
+			expected: "-2001234567_456239017_4f1c2b7e",
+		},
+		{
+			name:     "Valid mobile URL on vk.ru",
+			inputURL: "https://m.vk.ru/audio1234_456239017",
+			expected: "1234_456239017",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://vk.com/music/album/-2000413089_13089",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Host",
+			inputURL: "https://example.com/audio1234_456239017",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectTrackID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectAlbumID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://vk.com/music/album/-2000413089_13089_c1e9a3f2",
+			expected: "-2000413089_13089_c1e9a3f2",
+		},
+		{
+			name:     "Valid URL without access key",
+			inputURL: "https://vk.com/music/album/-2000413089_13089",
+			expected: "-2000413089_13089",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://vk.com/music/playlist/1234_58",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectAlbumID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectArtistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://vk.com/artist/zemfira",
+			expected: "zemfira",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://vk.com/zemfira",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectArtistID(tt.inputURL))
+		})
+	}
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Valid URL",
+			inputURL: "https://vk.com/music/playlist/1234_58_9a8b7c",
+			expected: "1234_58_9a8b7c",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			inputURL: "https://vk.com/music/album/-2000413089_13089",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectPlaylistID(tt.inputURL))
+		})
+	}
+}
@@ -8,6 +8,10 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
 )
 
 const (
@@ -21,13 +25,21 @@ var (
 type Client interface {
 	FetchTrack(ctx context.Context, id string) (*Track, error)
 	SearchTrack(ctx context.Context, query string) (*Track, error)
+	SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error)
 	FetchAlbum(ctx context.Context, id string) (*Album, error)
 	SearchAlbum(ctx context.Context, query string) (*Album, error)
+	SearchAlbums(ctx context.Context, query string, limit int) ([]*Album, error)
+	FetchArtist(ctx context.Context, id string) (*Artist, error)
+	SearchArtist(ctx context.Context, query string) (*Artist, error)
+	FetchPlaylist(ctx context.Context, owner, kind string) (*Playlist, error)
 }
 
 type HTTPClient struct {
-	apiURL     string
-	httpClient *http.Client
+	apiURL      string
+	httpClient  *http.Client
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
 }
 
 type trackResponse struct {
@@ -38,13 +50,26 @@ type albumResponse struct {
 	Result *Album `json:"result"`
 }
 
+type artistResponse struct {
+	Result artistResult `json:"result"`
+}
+
+type artistResult struct {
+	Artist *Artist `json:"artist"`
+}
+
+type playlistResponse struct {
+	Result *Playlist `json:"result"`
+}
+
 type searchResponse struct {
 	Result searchResult `json:"result"`
 }
 
 type searchResult struct {
-	Tracks tracksSection `json:"tracks"`
-	Albums albumsSection `json:"albums"`
+	Tracks  tracksSection  `json:"tracks"`
+	Albums  albumsSection  `json:"albums"`
+	Artists artistsSection `json:"artists"`
 }
 
 type tracksSection struct {
@@ -55,15 +80,24 @@ type albumsSection struct {
 	Results []Album `json:"results"`
 }
 
+type artistsSection struct {
+	Results []Artist `json:"results"`
+}
+
 func NewHTTPClient(opts ...ClientOption) *HTTPClient {
 	c := HTTPClient{
-		apiURL:     defaultAPIURL,
-		httpClient: &http.Client{},
+		apiURL:      defaultAPIURL,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
 	}
 
 	for _, opt := range opts {
 		opt(&c)
 	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
 
 	return &c
 }
@@ -72,12 +106,12 @@ func (c *HTTPClient) FetchTrack(ctx context.Context, trackID string) (*Track, er
 	path := fmt.Sprintf("/tracks/%s", trackID)
 	body, err := c.getAPI(ctx, path, url.Values{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get api: %s", err)
+		return nil, fmt.Errorf("failed to get api: %w", err)
 	}
 
 	tr := trackResponse{}
 	if err = json.Unmarshal(body, &tr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 
 	if len(tr.Result) < 1 {
@@ -88,37 +122,50 @@ func (c *HTTPClient) FetchTrack(ctx context.Context, trackID string) (*Track, er
 }
 
 func (c *HTTPClient) SearchTrack(ctx context.Context, query string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error) {
 	body, err := c.getAPI(ctx, "/search", url.Values{
 		"type": []string{"track"},
 		"page": []string{"0"},
 		"text": []string{query},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get api: %s", err)
+		return nil, fmt.Errorf("failed to get api: %w", err)
 	}
 
 	sr := searchResponse{}
 	if err = json.Unmarshal(body, &sr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 
-	if len(sr.Result.Tracks.Results) == 0 {
+	results := sr.Result.Tracks.Results
+	if len(results) == 0 {
 		return nil, NotFoundError
 	}
 
-	return &sr.Result.Tracks.Results[0], nil
+	tracks := make([]*Track, 0, min(limit, len(results)))
+	for i := range results[:min(limit, len(results))] {
+		tracks = append(tracks, &results[i])
+	}
+	return tracks, nil
 }
 
 func (c *HTTPClient) FetchAlbum(ctx context.Context, albumID string) (*Album, error) {
 	path := fmt.Sprintf("/albums/%s", albumID)
 	body, err := c.getAPI(ctx, path, url.Values{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get api: %s", err)
+		return nil, fmt.Errorf("failed to get api: %w", err)
 	}
 
 	ar := albumResponse{}
 	if err = json.Unmarshal(body, &ar); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 	if ar.Result == nil {
 		return nil, NotFoundError
@@ -128,46 +175,134 @@ func (c *HTTPClient) FetchAlbum(ctx context.Context, albumID string) (*Album, er
 }
 
 func (c *HTTPClient) SearchAlbum(ctx context.Context, query string) (*Album, error) {
+	albums, err := c.SearchAlbums(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *HTTPClient) SearchAlbums(ctx context.Context, query string, limit int) ([]*Album, error) {
 	body, err := c.getAPI(ctx, "/search", url.Values{
 		"type": []string{"album"},
 		"page": []string{"0"},
 		"text": []string{query},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get api: %s", err)
+		return nil, fmt.Errorf("failed to get api: %w", err)
 	}
 
 	sr := searchResponse{}
 	if err = json.Unmarshal(body, &sr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %s", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
 	}
 
-	if len(sr.Result.Albums.Results) == 0 {
+	results := sr.Result.Albums.Results
+	if len(results) == 0 {
 		return nil, NotFoundError
 	}
 
-	return &sr.Result.Albums.Results[0], nil
+	albums := make([]*Album, 0, min(limit, len(results)))
+	for i := range results[:min(limit, len(results))] {
+		albums = append(albums, &results[i])
+	}
+	return albums, nil
+}
+
+func (c *HTTPClient) FetchArtist(ctx context.Context, artistID string) (*Artist, error) {
+	path := fmt.Sprintf("/artists/%s/brief-info", artistID)
+	body, err := c.getAPI(ctx, path, url.Values{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	ar := artistResponse{}
+	if err = json.Unmarshal(body, &ar); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if ar.Result.Artist == nil {
+		return nil, NotFoundError
+	}
+
+	return ar.Result.Artist, nil
+}
+
+func (c *HTTPClient) SearchArtist(ctx context.Context, query string) (*Artist, error) {
+	body, err := c.getAPI(ctx, "/search", url.Values{
+		"type": []string{"artist"},
+		"page": []string{"0"},
+		"text": []string{query},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	sr := searchResponse{}
+	if err = json.Unmarshal(body, &sr); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+
+	if len(sr.Result.Artists.Results) == 0 {
+		return nil, NotFoundError
+	}
+
+	return &sr.Result.Artists.Results[0], nil
+}
+
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, owner, kind string) (*Playlist, error) {
+	path := fmt.Sprintf("/users/%s/playlists/%s", url.PathEscape(owner), kind)
+	body, err := c.getAPI(ctx, path, url.Values{
+		"rich-tracks": []string{"true"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	pr := playlistResponse{}
+	if err = json.Unmarshal(body, &pr); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if pr.Result == nil {
+		return nil, NotFoundError
+	}
+
+	tracks := make([]PlaylistTrack, 0, len(pr.Result.Tracks))
+	for _, item := range pr.Result.Tracks {
+		if item.Track != nil {
+			tracks = append(tracks, item)
+		}
+	}
+	pr.Result.Tracks = tracks
+
+	return pr.Result, nil
 }
 
 func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values) ([]byte, error) {
 	u := fmt.Sprintf("%s%s?%s", c.apiURL, path, query.Encode())
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %s", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %s", err)
+		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	return io.ReadAll(resp.Body)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, NotFoundError
+	default:
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
 }
 
 func (ar *albumResponse) UnmarshalJSON(data []byte) error {
 	parsedResponse := map[string]map[string]any{}
 	if err := json.Unmarshal(data, &parsedResponse); err != nil {
-		return fmt.Errorf("failed to unmarshal album response: %s", err)
+		return fmt.Errorf("failed to unmarshal album response: %w", err)
 	}
 
 	result, hasResult := parsedResponse["result"]
@@ -189,11 +324,11 @@ func (ar *albumResponse) UnmarshalJSON(data []byte) error {
 
 	albumJSON, err := json.Marshal(result)
 	if err != nil {
-		return fmt.Errorf("failed to marshal album result: %s", err)
+		return fmt.Errorf("failed to marshal album result: %w", err)
 	}
 
 	if err = json.Unmarshal(albumJSON, &ar.Result); err != nil {
-		return fmt.Errorf("failed to unmarshal album: %s", err)
+		return fmt.Errorf("failed to unmarshal album: %w", err)
 	}
 
 	return nil
@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,18 +24,24 @@ func TestClient_FetchTrack(t *testing.T) {
 			name:    "when track found",
 			trackID: "foundID",
 			want: &Track{
-				ID:    "1",
-				Title: "sample title",
+				ID:             "1",
+				Title:          "sample title",
+				DurationMS:     215000,
+				ContentWarning: "explicit",
+				CoverURI:       "avatars.yandex.net/get-music-content/sample/%%",
 				Albums: []Album{
 					{
-						ID:    2,
-						Title: "sample title",
+						ID:          2,
+						Title:       "sample title",
+						Year:        2017,
+						ReleaseDate: "2017-06-23T00:00:00+03:00",
 						Artists: []Artist{
 							{
 								ID:   3,
 								Name: "sample artist",
 							},
 						},
+						TrackPosition: &TrackPosition{Volume: 1, Index: 3},
 					},
 				},
 				Artists: []Artist{
@@ -59,11 +68,17 @@ func TestClient_FetchTrack(t *testing.T) {
 						"result": [{
 							"id": "1",
 							"title": "sample title",
+							"durationMs": 215000,
+							"contentWarning": "explicit",
+							"coverUri": "avatars.yandex.net/get-music-content/sample/%%",
 							"albums": [
 								{
 									"id": 2,
 									"title": "sample title",
-									"artists": [{"id": 3, "name": "sample artist"}]
+									"year": 2017,
+									"releaseDate": "2017-06-23T00:00:00+03:00",
+									"artists": [{"id": 3, "name": "sample artist"}],
+									"trackPosition": {"volume": 1, "index": 3}
 								}
 							],
 							"artists": [{"id": 4, "name": "sample artist" }]
@@ -252,6 +267,40 @@ func TestClient_SearchTrack(t *testing.T) {
 	}
 }
 
+func TestClient_SearchTracks(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "track", r.URL.Query().Get("type"))
+		require.Equal(t, "sample query", r.URL.Query().Get("text"))
+
+		_, err := w.Write([]byte(`{
+			"result": {
+				"tracks":{
+					"results": [
+						{"id": "1", "title": "first"},
+						{"id": "2", "title": "second"},
+						{"id": "3", "title": "third"}
+					]
+				}
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.SearchTracks(ctx, "sample query", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Track{
+		{ID: "1", Title: "first"},
+		{ID: "2", Title: "second"},
+	}, result)
+}
+
 func TestClient_SearchAlbum(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -323,3 +372,279 @@ func TestClient_SearchAlbum(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_FetchArtist(t *testing.T) {
+	tests := []struct {
+		name     string
+		artistID string
+		want     *Artist
+		wantErr  error
+	}{
+		{
+			name:     "when artist found",
+			artistID: "foundID",
+			want: &Artist{
+				ID:   1,
+				Name: "Sample Artist",
+			},
+		},
+		{
+			name:     "when artist not found",
+			artistID: "notFoundID",
+			wantErr:  NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+
+				if r.URL.Path == "/artists/foundID/brief-info" {
+					_, err := w.Write([]byte(`{
+						"result": {
+							"artist": {"id": 1, "name": "Sample Artist"}
+						}
+					}`))
+					require.NoError(t, err)
+				} else {
+					w.WriteHeader(http.StatusNotFound)
+					_, err := w.Write([]byte(`{"error": {"name": "not-found"}}`))
+					require.NoError(t, err)
+				}
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchArtist(ctx, tt.artistID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestClient_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    *Artist
+		wantErr error
+	}{
+		{
+			name:  "when artist found",
+			query: "found query",
+			want: &Artist{
+				ID:   1,
+				Name: "Sample Artist",
+			},
+		},
+		{
+			name:    "when artist not found",
+			query:   "any not found query",
+			wantErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+
+				searchType := r.URL.Query().Get("type")
+				require.Equal(t, "artist", searchType)
+
+				query := r.URL.Query().Get("text")
+				if query == "found query" {
+					_, err := w.Write([]byte(`{
+						"result": {
+							"artists":{
+								"results": [{"id": 1, "name": "Sample Artist"}]
+							}
+						}
+					}`))
+					require.NoError(t, err)
+				} else {
+					_, err := w.Write([]byte(`{"result": {}}`))
+					require.NoError(t, err)
+				}
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.SearchArtist(ctx, tt.query)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestClient_FetchPlaylist(t *testing.T) {
+	tests := []struct {
+		name    string
+		owner   string
+		kind    string
+		want    *Playlist
+		wantErr error
+	}{
+		{
+			name:  "when playlist found",
+			owner: "sample.user",
+			kind:  "1000",
+			want: &Playlist{
+				Kind:  1000,
+				Title: "Sample Playlist",
+				Owner: Owner{
+					Login: "sample.user",
+					Name:  "Sample User",
+				},
+				Tracks: []PlaylistTrack{
+					{
+						Track: &Track{
+							ID:    "1",
+							Title: "Sample Track 1",
+							Albums: []Album{
+								{ID: 10, Title: "Sample Album"},
+							},
+							Artists: []Artist{
+								{ID: 100, Name: "Sample Artist"},
+							},
+						},
+					},
+					{
+						Track: &Track{
+							ID:    "2",
+							Title: "Sample Track 2",
+							Albums: []Album{
+								{ID: 10, Title: "Sample Album"},
+							},
+							Artists: []Artist{
+								{ID: 100, Name: "Sample Artist"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "when playlist not found",
+			owner:   "sample.user",
+			kind:    "404",
+			wantErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "true", r.URL.Query().Get("rich-tracks"))
+
+				if r.URL.Path == "/users/sample.user/playlists/1000" {
+					_, err := w.Write([]byte(`{
+						"result": {
+							"kind": 1000,
+							"title": "Sample Playlist",
+							"owner": {"login": "sample.user", "name": "Sample User"},
+							"tracks": [
+								{
+									"id": 1,
+									"track": {
+										"id": "1",
+										"title": "Sample Track 1",
+										"albums": [{"id": 10, "title": "Sample Album"}],
+										"artists": [{"id": 100, "name": "Sample Artist"}]
+									}
+								},
+								{
+									"id": 3
+								},
+								{
+									"id": 2,
+									"track": {
+										"id": "2",
+										"title": "Sample Track 2",
+										"albums": [{"id": 10, "title": "Sample Album"}],
+										"artists": [{"id": 100, "name": "Sample Artist"}]
+									}
+								}
+							]
+						}
+					}`))
+					require.NoError(t, err)
+				} else {
+					w.WriteHeader(http.StatusNotFound)
+					_, err := w.Write([]byte(`{"error": {"name": "not-found"}}`))
+					require.NoError(t, err)
+				}
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchPlaylist(ctx, tt.owner, tt.kind)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestClient_getAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseCode int
+		wantErr      error
+	}{
+		{
+			name:         "when not found",
+			responseCode: http.StatusNotFound,
+			wantErr:      NotFoundError,
+		},
+		{
+			name:         "when region restricted",
+			responseCode: http.StatusUnavailableForLegalReasons,
+			wantErr:      apierr.RegionRestrictedError,
+		},
+		{
+			name:         "when upstream unavailable",
+			responseCode: http.StatusInternalServerError,
+			wantErr:      apierr.UpstreamUnavailableError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.responseCode)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(WithAPIURL(apiServerMock.URL), WithRetryPolicy(retry.Policy{MaxAttempts: 1}))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := client.FetchTrack(ctx, "sampleID")
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Nil(t, result)
+		})
+	}
+}
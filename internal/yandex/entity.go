@@ -3,32 +3,61 @@ package yandex
 import (
 	"fmt"
 	"regexp"
+	"strings"
+)
+
+const (
+	explicitContentWarning = "explicit"
+	coverSizePlaceholder   = "%%"
 )
 
 var (
 	TrackRe = regexp.MustCompile(
 		fmt.Sprintf(
-			`https://music\.yandex\.(%s)/album/\d+/track/(\d+)`, allDomainZonesRe(),
+			`(?:https://music\.yandex\.(%s)/|yandexmusic://)album/\d+/track/(\d+)`, allDomainZonesRe(),
 		),
 	)
 	AlbumRe = regexp.MustCompile(
 		fmt.Sprintf(
-			`https://music\.yandex\.(%s)/album/(\d+)`, allDomainZonesRe(),
+			`(?:https://music\.yandex\.(%s)/|yandexmusic://)album/(\d+)`, allDomainZonesRe(),
+		),
+	)
+	ArtistRe = regexp.MustCompile(
+		fmt.Sprintf(
+			`(?:https://music\.yandex\.(%s)/|yandexmusic://)artist/(\d+)`, allDomainZonesRe(),
+		),
+	)
+	PlaylistRe = regexp.MustCompile(
+		fmt.Sprintf(
+			`(?:https://music\.yandex\.(%s)/|yandexmusic://)users/([\w.-]+)/playlists/(\d+)`, allDomainZonesRe(),
 		),
 	)
 )
 
 type Track struct {
-	Albums  []Album  `json:"albums"`
-	Artists []Artist `json:"artists"`
-	ID      any      `json:"id"`
-	Title   string   `json:"title"`
+	Albums         []Album  `json:"albums"`
+	Artists        []Artist `json:"artists"`
+	ID             any      `json:"id"`
+	Title          string   `json:"title"`
+	DurationMS     int      `json:"durationMs"`
+	ContentWarning string   `json:"contentWarning"`
+	CoverURI       string   `json:"coverUri"`
 }
 
 type Album struct {
-	ID      int      `json:"id"`
-	Title   string   `json:"title"`
-	Artists []Artist `json:"artists"`
+	ID             int            `json:"id"`
+	Title          string         `json:"title"`
+	Artists        []Artist       `json:"artists"`
+	Year           int            `json:"year"`
+	ReleaseDate    string         `json:"releaseDate"`
+	ContentWarning string         `json:"contentWarning"`
+	CoverURI       string         `json:"coverUri"`
+	TrackPosition  *TrackPosition `json:"trackPosition,omitempty"`
+}
+
+type TrackPosition struct {
+	Volume int `json:"volume"`
+	Index  int `json:"index"`
 }
 
 type Artist struct {
@@ -36,6 +65,22 @@ type Artist struct {
 	Name string `json:"name"`
 }
 
+type Playlist struct {
+	Kind   int             `json:"kind"`
+	Title  string          `json:"title"`
+	Owner  Owner           `json:"owner"`
+	Tracks []PlaylistTrack `json:"tracks"`
+}
+
+type Owner struct {
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+type PlaylistTrack struct {
+	Track *Track `json:"track"`
+}
+
 func DetectTrackID(trackURL string) string {
 	match := TrackRe.FindStringSubmatch(trackURL)
 	if match == nil || len(match) < 3 {
@@ -52,6 +97,22 @@ func DetectAlbumID(albumURL string) string {
 	return match[2]
 }
 
+func DetectArtistID(artistURL string) string {
+	match := ArtistRe.FindStringSubmatch(artistURL)
+	if match == nil || len(match) < 3 {
+		return ""
+	}
+	return match[2]
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	match := PlaylistRe.FindStringSubmatch(playlistURL)
+	if match == nil || len(match) < 4 {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", match[2], match[3])
+}
+
 func (a *Album) URL() string {
 	return fmt.Sprintf("https://music.yandex.%s/album/%d", noRegionDomainZone, a.ID)
 }
@@ -60,6 +121,14 @@ func (t *Track) URL() string {
 	return fmt.Sprintf("https://music.yandex.%s/album/%d/track/%s", noRegionDomainZone, t.Albums[0].ID, t.IDString())
 }
 
+func (a *Artist) URL() string {
+	return fmt.Sprintf("https://music.yandex.%s/artist/%d", noRegionDomainZone, a.ID)
+}
+
+func (p *Playlist) URL() string {
+	return fmt.Sprintf("https://music.yandex.%s/users/%s/playlists/%d", noRegionDomainZone, p.Owner.Login, p.Kind)
+}
+
 func (t *Track) IDString() string {
 	switch id := t.ID.(type) {
 	case int:
@@ -72,3 +141,26 @@ func (t *Track) IDString() string {
 		return ""
 	}
 }
+
+func (t *Track) IsExplicit() bool {
+	return t.ContentWarning == explicitContentWarning
+}
+
+func (t *Track) CoverURL() string {
+	return coverURL(t.CoverURI)
+}
+
+func (a *Album) IsExplicit() bool {
+	return a.ContentWarning == explicitContentWarning
+}
+
+func (a *Album) CoverURL() string {
+	return coverURL(a.CoverURI)
+}
+
+func coverURL(coverURI string) string {
+	if coverURI == "" {
+		return ""
+	}
+	return "https://" + strings.Replace(coverURI, coverSizePlaceholder, "{w}x{h}", 1)
+}
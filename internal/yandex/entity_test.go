@@ -22,6 +22,11 @@ func Test_DetectTrackID(t *testing.T) {
 			url:    "https://music.yandex.ru/album/3192570/track/1197793",
 			wantID: "1197793",
 		},
+		{
+			name:   "App URI",
+			url:    "yandexmusic://album/3192570/track/1197793",
+			wantID: "1197793",
+		},
 		{
 			name:   "Valid Track URL – .by",
 			url:    "https://music.yandex.by/album/3192570/track/1197793",
@@ -114,6 +119,78 @@ func TestYandexAdapter_DetectAlbumID(t *testing.T) {
 	}
 }
 
+func Test_DetectArtistID(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		wantID string
+	}{
+		{
+			name:   "Valid artist URL – .ru",
+			url:    "https://music.yandex.ru/artist/41191",
+			wantID: "41191",
+		},
+		{
+			name:   "Valid artist URL – .com with tab",
+			url:    "https://music.yandex.com/artist/41191/tracks",
+			wantID: "41191",
+		},
+		{
+			name:   "Invalid URL - Album",
+			url:    "https://music.yandex.ru/album/1197793",
+			wantID: "",
+		},
+		{
+			name:   "Invalid URL - Incorrect host",
+			url:    "https://example.com/artist/41191",
+			wantID: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectArtistID(tt.url)
+			require.Equal(t, tt.wantID, result)
+		})
+	}
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		wantID string
+	}{
+		{
+			name:   "Valid playlist URL – .ru",
+			url:    "https://music.yandex.ru/users/sample.user/playlists/1000",
+			wantID: "sample.user:1000",
+		},
+		{
+			name:   "Valid playlist URL – .by with query",
+			url:    "https://music.yandex.by/users/yamusic-daily/playlists/12345?utm_source=share",
+			wantID: "yamusic-daily:12345",
+		},
+		{
+			name:   "Invalid URL - Artist",
+			url:    "https://music.yandex.ru/artist/41191",
+			wantID: "",
+		},
+		{
+			name:   "Invalid URL - Incorrect host",
+			url:    "https://example.com/users/sample.user/playlists/1000",
+			wantID: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectPlaylistID(tt.url)
+			require.Equal(t, tt.wantID, result)
+		})
+	}
+}
+
 func TestTrack_URL(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -165,3 +242,32 @@ func TestAlbum_URL(t *testing.T) {
 	result := album.URL()
 	require.Equal(t, "https://music.yandex.com/album/42", result)
 }
+
+func TestArtist_URL(t *testing.T) {
+	artist := Artist{ID: 42}
+	result := artist.URL()
+	require.Equal(t, "https://music.yandex.com/artist/42", result)
+}
+
+func TestPlaylist_URL(t *testing.T) {
+	playlist := Playlist{Kind: 1000, Owner: Owner{Login: "sample.user"}}
+	result := playlist.URL()
+	require.Equal(t, "https://music.yandex.com/users/sample.user/playlists/1000", result)
+}
+
+func TestTrack_CoverURL(t *testing.T) {
+	track := Track{CoverURI: "avatars.yandex.net/get-music-content/sample/%%"}
+	require.Equal(t, "https://avatars.yandex.net/get-music-content/sample/{w}x{h}", track.CoverURL())
+	require.Equal(t, "", (&Track{}).CoverURL())
+}
+
+func TestAlbum_CoverURL(t *testing.T) {
+	album := Album{CoverURI: "avatars.yandex.net/get-music-content/sample/%%"}
+	require.Equal(t, "https://avatars.yandex.net/get-music-content/sample/{w}x{h}", album.CoverURL())
+	require.Equal(t, "", (&Album{}).CoverURL())
+}
+
+func TestTrack_IsExplicit(t *testing.T) {
+	require.True(t, (&Track{ContentWarning: "explicit"}).IsExplicit())
+	require.False(t, (&Track{}).IsExplicit())
+}
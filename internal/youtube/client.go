@@ -8,28 +8,52 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
 )
 
 const (
-	defaultAPIURL = "https://www.googleapis.com"
+	defaultAPIURL               = "https://www.googleapis.com"
+	playlistItemsPageMaxResults = "50"
+	listMaxIDs                  = 50
 )
 
 var (
 	NotFoundError = errors.New("not found")
+
+	albumPlaylistIDRe = regexp.MustCompile(`OLAK5uy_[a-zA-Z0-9_-]+`)
 )
 
 type Client interface {
 	GetVideo(ctx context.Context, id string) (*Video, error)
+	GetVideos(ctx context.Context, ids []string) ([]Video, error)
 	SearchVideo(ctx context.Context, term string) (*SearchResponse, error)
+	SearchVideos(ctx context.Context, term string, limit int) (*SearchResponse, error)
 	GetPlaylist(ctx context.Context, id string) (*Playlist, error)
+	GetPlaylists(ctx context.Context, ids []string) ([]Playlist, error)
 	SearchPlaylist(ctx context.Context, term string) (*SearchResponse, error)
+	SearchPlaylists(ctx context.Context, term string, limit int) (*SearchResponse, error)
 	GetPlaylistItems(ctx context.Context, id string) ([]Video, error)
+	GetFirstPlaylistItem(ctx context.Context, id string) (*Video, error)
+	GetChannel(ctx context.Context, id string) (*Channel, error)
+	SearchChannel(ctx context.Context, term string) (*SearchResponse, error)
+	GetAlbumPlaylistID(ctx context.Context, browseID string) (string, error)
 }
 
 type HTTPClient struct {
-	apiURL     string
-	apiKey     string
-	httpClient *http.Client
+	apiURL      string
+	musicURL    string
+	apiKey      string
+	httpClient  *http.Client
+	musicClient *http.Client
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
 }
 
 type getSnippetResponse struct {
@@ -37,8 +61,13 @@ type getSnippetResponse struct {
 }
 
 type getSnippetItem struct {
-	ID      string   `json:"id"`
-	Snippet *snippet `json:"snippet"`
+	ID             string          `json:"id"`
+	Snippet        *snippet        `json:"snippet"`
+	ContentDetails *contentDetails `json:"contentDetails"`
+}
+
+type contentDetails struct {
+	Duration string `json:"duration"`
 }
 
 type SearchResponse struct {
@@ -52,65 +81,115 @@ type SearchItem struct {
 type SearchID struct {
 	VideoID    string `json:"videoId"`
 	PlaylistID string `json:"playlistId"`
+	ChannelID  string `json:"channelId"`
 }
 
 type getPlaylistItemsResponse struct {
-	Items []*getSnippetItem `json:"items"`
+	Items         []*getSnippetItem `json:"items"`
+	NextPageToken string            `json:"nextPageToken"`
 }
 
 type snippet struct {
-	Title                  string `json:"title"`
-	ChannelTitle           string `json:"channelTitle"`
-	Description            string `json:"description"`
-	VideoOwnerChannelTitle string `json:"videoOwnerChannelTitle"`
+	Title                  string      `json:"title"`
+	ChannelTitle           string      `json:"channelTitle"`
+	Description            string      `json:"description"`
+	VideoOwnerChannelTitle string      `json:"videoOwnerChannelTitle"`
+	ResourceID             *resourceID `json:"resourceId"`
+	PublishedAt            string      `json:"publishedAt"`
+	Thumbnails             thumbnails  `json:"thumbnails"`
+}
+
+type thumbnails map[string]thumbnail
+
+type thumbnail struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type resourceID struct {
+	VideoID string `json:"videoId"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Errors []struct {
+			Reason string `json:"reason"`
+		} `json:"errors"`
+	} `json:"error"`
 }
 
 func NewHTTPClient(apiKey string, opts ...ClientOption) *HTTPClient {
 	c := HTTPClient{
-		apiKey:     apiKey,
-		apiURL:     defaultAPIURL,
-		httpClient: &http.Client{},
+		apiKey:      apiKey,
+		apiURL:      defaultAPIURL,
+		musicURL:    musicBaseURL,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
 	}
 	for _, opt := range opts {
 		opt(&c)
 	}
+	// The music.youtube.com pages are not served by the Data API and don't spend its quota.
+	c.musicClient = &http.Client{
+		Transport: retry.NewTransport(c.httpClient.Transport, c.retryPolicy),
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
 	return &c
 }
 
 // https://developers.google.com/youtube/v3/docs/videos/list
 func (c *HTTPClient) GetVideo(ctx context.Context, id string) (*Video, error) {
-	body, err := c.getWithKey(ctx, "/youtube/v3/videos", url.Values{
-		"part": {"snippet"},
-		"id":   {id},
-	})
+	videos, err := c.GetVideos(ctx, []string{id})
 	if err != nil {
 		return nil, err
 	}
+	return &videos[0], nil
+}
 
-	response := getSnippetResponse{}
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to decode api response: %w", err)
-	}
-	if len(response.Items) == 0 {
-		return nil, NotFoundError
+// GetVideos fetches videos in batches of up to 50 IDs per request and returns
+// the found ones in the order of the given IDs.
+// https://developers.google.com/youtube/v3/docs/videos/list
+func (c *HTTPClient) GetVideos(ctx context.Context, ids []string) ([]Video, error) {
+	items, err := c.listByIDs(ctx, "/youtube/v3/videos", "snippet,contentDetails", ids)
+	if err != nil {
+		return nil, err
 	}
 
-	return &Video{
-		ID:           id,
-		Title:        response.Items[0].Snippet.Title,
-		ChannelTitle: response.Items[0].Snippet.ownerChannelTitle(),
-		Description:  response.Items[0].Snippet.Description,
-	}, nil
+	videos := make([]Video, 0, len(items))
+	for _, item := range items {
+		video := Video{
+			ID:           item.ID,
+			Title:        item.Snippet.Title,
+			ChannelTitle: item.Snippet.ownerChannelTitle(),
+			Description:  item.Snippet.Description,
+			PublishedAt:  item.Snippet.PublishedAt,
+			ThumbnailURL: item.Snippet.Thumbnails.largestURL(),
+		}
+		if item.ContentDetails != nil {
+			video.Duration = parseISODuration(item.ContentDetails.Duration)
+		}
+		videos = append(videos, video)
+	}
+	return videos, nil
 }
 
 // https://developers.google.com/youtube/v3/docs/search/list
 func (c *HTTPClient) SearchVideo(ctx context.Context, query string) (*SearchResponse, error) {
+	return c.SearchVideos(ctx, query, 1)
+}
+
+// https://developers.google.com/youtube/v3/docs/search/list
+func (c *HTTPClient) SearchVideos(ctx context.Context, query string, limit int) (*SearchResponse, error) {
 	body, err := c.getWithKey(ctx, "/youtube/v3/search", url.Values{
 		"q":               {query},
 		"part":            {"snippet"},
 		"type":            {"video"},
 		"videoCategoryId": {"10"},
-		"maxResults":      {"1"},
+		"maxResults":      {strconv.Itoa(limit)},
 	})
 	if err != nil {
 		return nil, err
@@ -118,7 +197,7 @@ func (c *HTTPClient) SearchVideo(ctx context.Context, query string) (*SearchResp
 
 	response := SearchResponse{}
 	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to decode api response: %w", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to decode api response: %w", err))
 	}
 
 	if len(response.Items) == 0 {
@@ -130,37 +209,46 @@ func (c *HTTPClient) SearchVideo(ctx context.Context, query string) (*SearchResp
 
 // https://developers.google.com/youtube/v3/docs/playlists/list
 func (c *HTTPClient) GetPlaylist(ctx context.Context, id string) (*Playlist, error) {
-	body, err := c.getWithKey(ctx, "/youtube/v3/playlists", url.Values{
-		"part": {"snippet"},
-		"id":   {id},
-	})
+	playlists, err := c.GetPlaylists(ctx, []string{id})
 	if err != nil {
 		return nil, err
 	}
+	return &playlists[0], nil
+}
 
-	response := getSnippetResponse{}
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to decode api response: %w", err)
-	}
-	if len(response.Items) == 0 {
-		return nil, NotFoundError
+// GetPlaylists fetches playlists in batches of up to 50 IDs per request and returns
+// the found ones in the order of the given IDs.
+// https://developers.google.com/youtube/v3/docs/playlists/list
+func (c *HTTPClient) GetPlaylists(ctx context.Context, ids []string) ([]Playlist, error) {
+	items, err := c.listByIDs(ctx, "/youtube/v3/playlists", "snippet", ids)
+	if err != nil {
+		return nil, err
 	}
 
-	item := response.Items[0]
-	return &Playlist{
-		ID:           item.ID,
-		Title:        item.Snippet.Title,
-		ChannelTitle: item.Snippet.ownerChannelTitle(),
-	}, nil
+	playlists := make([]Playlist, 0, len(items))
+	for _, item := range items {
+		playlists = append(playlists, Playlist{
+			ID:           item.ID,
+			Title:        item.Snippet.Title,
+			ChannelTitle: item.Snippet.ownerChannelTitle(),
+			ThumbnailURL: item.Snippet.Thumbnails.largestURL(),
+		})
+	}
+	return playlists, nil
 }
 
 // https://developers.google.com/youtube/v3/docs/search/list
 func (c *HTTPClient) SearchPlaylist(ctx context.Context, query string) (*SearchResponse, error) {
+	return c.SearchPlaylists(ctx, query, 1)
+}
+
+// https://developers.google.com/youtube/v3/docs/search/list
+func (c *HTTPClient) SearchPlaylists(ctx context.Context, query string, limit int) (*SearchResponse, error) {
 	body, err := c.getWithKey(ctx, "/youtube/v3/search", url.Values{
 		"q":          {query},
 		"part":       {"snippet"},
 		"type":       {"playlist"},
-		"maxResults": {"1"},
+		"maxResults": {strconv.Itoa(limit)},
 	})
 	if err != nil {
 		return nil, err
@@ -168,7 +256,7 @@ func (c *HTTPClient) SearchPlaylist(ctx context.Context, query string) (*SearchR
 
 	response := SearchResponse{}
 	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to decode api response: %w", err)
+		return nil, apierr.Malformed(fmt.Errorf("failed to decode api response: %w", err))
 	}
 	if len(response.Items) == 0 {
 		return nil, NotFoundError
@@ -179,29 +267,183 @@ func (c *HTTPClient) SearchPlaylist(ctx context.Context, query string) (*SearchR
 
 // https://developers.google.com/youtube/v3/docs/playlistItems/list
 func (c *HTTPClient) GetPlaylistItems(ctx context.Context, id string) ([]Video, error) {
-	body, err := c.getWithKey(ctx, "/youtube/v3/playlistItems", url.Values{
+	videos := make([]Video, 0)
+	pageToken := ""
+	for {
+		page, nextPageToken, err := c.getPlaylistItemsPage(ctx, id, playlistItemsPageMaxResults, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		videos = append(videos, page...)
+
+		if nextPageToken == "" {
+			return videos, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// GetFirstPlaylistItem requests a single item of the playlist, spending the quota of one page.
+func (c *HTTPClient) GetFirstPlaylistItem(ctx context.Context, id string) (*Video, error) {
+	videos, _, err := c.getPlaylistItemsPage(ctx, id, "1", "")
+	if err != nil {
+		return nil, err
+	}
+	if len(videos) == 0 {
+		return nil, NotFoundError
+	}
+	return &videos[0], nil
+}
+
+// getPlaylistItemsPage skips the items of deleted and private videos, which have no video ID.
+func (c *HTTPClient) getPlaylistItemsPage(ctx context.Context, id, maxResults, pageToken string) ([]Video, string, error) {
+	values := url.Values{
 		"part":       {"snippet"},
 		"playlistId": {id},
-	})
+		"maxResults": {maxResults},
+	}
+	if pageToken != "" {
+		values.Set("pageToken", pageToken)
+	}
+
+	body, err := c.getWithKey(ctx, "/youtube/v3/playlistItems", values)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	response := getPlaylistItemsResponse{}
 	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to decode api response: %w", err)
+		return nil, "", apierr.Malformed(fmt.Errorf("failed to decode api response: %w", err))
 	}
 
 	videos := make([]Video, 0, len(response.Items))
 	for _, item := range response.Items {
+		if item.Snippet.ResourceID == nil || item.Snippet.ResourceID.VideoID == "" {
+			continue
+		}
 		videos = append(videos, Video{
-			ID:           item.ID,
+			ID:           item.Snippet.ResourceID.VideoID,
 			Title:        item.Snippet.Title,
 			ChannelTitle: item.Snippet.VideoOwnerChannelTitle,
 			Description:  item.Snippet.Description,
+			ThumbnailURL: item.Snippet.Thumbnails.largestURL(),
 		})
 	}
+	return videos, response.NextPageToken, nil
+}
 
-	return videos, nil
+// https://developers.google.com/youtube/v3/docs/channels/list
+func (c *HTTPClient) GetChannel(ctx context.Context, id string) (*Channel, error) {
+	body, err := c.getWithKey(ctx, "/youtube/v3/channels", url.Values{
+		"part": {"snippet"},
+		"id":   {id},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := getSnippetResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to decode api response: %w", err))
+	}
+	if len(response.Items) == 0 {
+		return nil, NotFoundError
+	}
+
+	item := response.Items[0]
+	return &Channel{
+		ID:    item.ID,
+		Title: item.Snippet.Title,
+	}, nil
+}
+
+// https://developers.google.com/youtube/v3/docs/search/list
+func (c *HTTPClient) SearchChannel(ctx context.Context, query string) (*SearchResponse, error) {
+	body, err := c.getWithKey(ctx, "/youtube/v3/search", url.Values{
+		"q":          {query},
+		"part":       {"snippet"},
+		"type":       {"channel"},
+		"maxResults": {"1"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := SearchResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to decode api response: %w", err))
+	}
+	if len(response.Items) == 0 {
+		return nil, NotFoundError
+	}
+
+	return &response, nil
+}
+
+// GetAlbumPlaylistID resolves a YouTube Music album browse ID to the ID of the album playlist.
+// The Data API knows nothing about browse IDs, so the ID is taken from the album page.
+func (c *HTTPClient) GetAlbumPlaylistID(ctx context.Context, browseID string) (string, error) {
+	u := fmt.Sprintf("%s/browse/%s", c.musicURL, url.PathEscape(browseID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	response, err := c.musicClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to perform get request: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", NotFoundError
+	default:
+		return "", apierr.FromStatus(response.StatusCode)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+	id := albumPlaylistIDRe.Find(body)
+	if id == nil {
+		return "", NotFoundError
+	}
+	return string(id), nil
+}
+
+func (c *HTTPClient) listByIDs(ctx context.Context, path, part string, ids []string) ([]*getSnippetItem, error) {
+	found := make(map[string]*getSnippetItem, len(ids))
+	for start := 0; start < len(ids); start += listMaxIDs {
+		batch := ids[start:min(start+listMaxIDs, len(ids))]
+		body, err := c.getWithKey(ctx, path, url.Values{
+			"part": {part},
+			"id":   {strings.Join(batch, ",")},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		response := getSnippetResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, apierr.Malformed(fmt.Errorf("failed to decode api response: %w", err))
+		}
+		for _, item := range response.Items {
+			found[item.ID] = item
+		}
+	}
+
+	items := make([]*getSnippetItem, 0, len(found))
+	for _, id := range ids {
+		if item, ok := found[id]; ok {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil, NotFoundError
+	}
+	return items, nil
 }
 
 func (c *HTTPClient) getWithKey(ctx context.Context, path string, values url.Values) ([]byte, error) {
@@ -218,11 +460,31 @@ func (c *HTTPClient) getWithKey(ctx context.Context, path string, values url.Val
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("non ok http status: %d", response.StatusCode)
+	switch response.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(response.Body)
+	case http.StatusNotFound:
+		return nil, NotFoundError
+	default:
+		return nil, statusError(response)
 	}
+}
 
-	return io.ReadAll(response.Body)
+// statusError classifies the error response by its reason since the API reports both
+// exhausted quota and invalid keys with generic 400 and 403 statuses.
+func statusError(response *http.Response) error {
+	er := errorResponse{}
+	if err := json.NewDecoder(response.Body).Decode(&er); err == nil {
+		for _, e := range er.Error.Errors {
+			switch e.Reason {
+			case "quotaExceeded", "dailyLimitExceeded", "rateLimitExceeded", "userRateLimitExceeded":
+				return &apierr.StatusError{StatusCode: response.StatusCode, Err: apierr.RateLimitedError}
+			case "keyInvalid", "keyExpired", "accessNotConfigured":
+				return &apierr.StatusError{StatusCode: response.StatusCode, Err: apierr.UnauthorizedError}
+			}
+		}
+	}
+	return apierr.FromStatus(response.StatusCode)
 }
 
 func (s *snippet) ownerChannelTitle() string {
@@ -231,3 +493,13 @@ func (s *snippet) ownerChannelTitle() string {
 	}
 	return s.ChannelTitle
 }
+
+func (t thumbnails) largestURL() string {
+	largest := thumbnail{}
+	for _, th := range t {
+		if th.Width > largest.Width {
+			largest = th
+		}
+	}
+	return largest.URL
+}
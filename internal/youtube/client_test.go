@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,7 +34,15 @@ func TestHTTPClient_GetVideo(t *testing.T) {
 						"id": "dQw4w9WgXcQ",
 						"snippet": {	
 							"title": "Rick Astley - Never Gonna Give You Up (Video)",	
-							"channelTitle": "RickAstleyVEVO"	
+							"channelTitle": "RickAstleyVEVO",
+							"publishedAt": "2009-10-25T06:57:33Z",
+							"thumbnails": {
+								"default": {"url": "https://i.ytimg.com/vi/dQw4w9WgXcQ/default.jpg", "width": 120, "height": 90},
+								"high": {"url": "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg", "width": 480, "height": 360}
+							}
+						},
+						"contentDetails": {
+							"duration": "PT3M33S"
 						}
 					}
 				]
@@ -39,6 +51,9 @@ func TestHTTPClient_GetVideo(t *testing.T) {
 				ID:           "dQw4w9WgXcQ",
 				Title:        "Rick Astley - Never Gonna Give You Up (Video)",
 				ChannelTitle: "RickAstleyVEVO",
+				Duration:     3*time.Minute + 33*time.Second,
+				PublishedAt:  "2009-10-25T06:57:33Z",
+				ThumbnailURL: "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg",
 			},
 		},
 		{
@@ -57,7 +72,7 @@ func TestHTTPClient_GetVideo(t *testing.T) {
 				require.Equal(t, http.MethodGet, r.Method)
 				require.Equal(t, "/youtube/v3/videos", r.URL.Path)
 				require.Equal(t, sampleAPIKey, r.URL.Query().Get("key"))
-				require.Equal(t, "snippet", r.URL.Query().Get("part"))
+				require.Equal(t, "snippet,contentDetails", r.URL.Query().Get("part"))
 				require.Equal(t, tt.inputID, r.URL.Query().Get("id"))
 
 				_, err := w.Write([]byte(tt.responseMock))
@@ -81,6 +96,50 @@ func TestHTTPClient_GetVideo(t *testing.T) {
 	}
 }
 
+func TestHTTPClient_GetVideos(t *testing.T) {
+	ids := make([]string, 0, 52)
+	for i := 0; i < 52; i++ {
+		ids = append(ids, fmt.Sprintf("video%d", i))
+	}
+
+	requestedBatches := make([][]string, 0)
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/youtube/v3/videos", r.URL.Path)
+		require.Equal(t, "snippet,contentDetails", r.URL.Query().Get("part"))
+
+		batch := strings.Split(r.URL.Query().Get("id"), ",")
+		requestedBatches = append(requestedBatches, batch)
+
+		items := make([]string, 0, len(batch))
+		for i := len(batch) - 1; i >= 0; i-- {
+			if batch[i] == "video1" {
+				continue
+			}
+			items = append(items, fmt.Sprintf(`{"id": %q, "snippet": {"title": %q}}`, batch[i], batch[i]))
+		}
+		_, err := w.Write([]byte(`{"items": [` + strings.Join(items, ",") + `]}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(sampleAPIKey, WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	videos, err := client.GetVideos(ctx, ids)
+	require.NoError(t, err)
+	require.Len(t, requestedBatches, 2)
+	require.Len(t, requestedBatches[0], 50)
+	require.Equal(t, []string{"video50", "video51"}, requestedBatches[1])
+
+	require.Len(t, videos, 51)
+	require.Equal(t, Video{ID: "video0", Title: "video0"}, videos[0])
+	require.Equal(t, Video{ID: "video2", Title: "video2"}, videos[1])
+	require.Equal(t, Video{ID: "video51", Title: "video51"}, videos[50])
+}
+
 func TestHTTPClient_SearchVideo(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -158,6 +217,39 @@ func TestHTTPClient_SearchVideo(t *testing.T) {
 	}
 }
 
+func TestHTTPClient_SearchVideos(t *testing.T) {
+	apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/youtube/v3/search", r.URL.Path)
+		require.Equal(t, "sample query", r.URL.Query().Get("q"))
+		require.Equal(t, "video", r.URL.Query().Get("type"))
+		require.Equal(t, "5", r.URL.Query().Get("maxResults"))
+
+		_, err := w.Write([]byte(`{
+			"items": [
+				{"id": {"videoId": "first"}},
+				{"id": {"videoId": "second"}}
+			]
+		}`))
+		require.NoError(t, err)
+	}))
+	defer apiServerMock.Close()
+
+	client := NewHTTPClient(sampleAPIKey, WithAPIURL(apiServerMock.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := client.SearchVideos(ctx, "sample query", 5)
+	require.NoError(t, err)
+	require.Equal(t, &SearchResponse{
+		Items: []SearchItem{
+			{ID: SearchID{VideoID: "first"}},
+			{ID: SearchID{VideoID: "second"}},
+		},
+	}, response)
+}
+
 func TestHTTPClient_GetPlaylist(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -303,11 +395,261 @@ func TestHTTPClient_SearchPlaylist(t *testing.T) {
 	}
 }
 
+func TestHTTPClient_Retry(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []ClientOption
+		wantErr      bool
+		wantAttempts int
+	}{
+		{
+			name: "retried with policy",
+			opts: []ClientOption{
+				WithRetryPolicy(retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+			},
+			wantAttempts: 2,
+		},
+		{
+			name:         "not retried when disabled",
+			opts:         []ClientOption{WithRetryPolicy(retry.Policy{MaxAttempts: 1})},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, err := w.Write([]byte(`{"items": [{"id": "sampleID", "snippet": {"title": "sample title"}}]}`))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			opts := append([]ClientOption{WithAPIURL(apiServerMock.URL)}, tt.opts...)
+			client := NewHTTPClient(sampleAPIKey, opts...)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, err := client.GetVideo(ctx, "sampleID")
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantAttempts, attempts)
+		})
+	}
+}
+
+func TestHTTPClient_GetFirstPlaylistItem(t *testing.T) {
+	tests := []struct {
+		name          string
+		responseMock  string
+		expectedVideo *Video
+		expectedError error
+	}{
+		{
+			name: "when playlist has items",
+			responseMock: `{
+				"items": [
+					{
+						"snippet": {
+							"title": "Space Oddity",
+							"description": "Auto-generated by YouTube.",
+							"videoOwnerChannelTitle": "David Bowie - Topic",
+							"resourceId": {"kind": "youtube#video", "videoId": "iYYRH4apXDo"}
+						}
+					}
+				],
+				"nextPageToken": "nextPageTokenMock"
+			}`,
+			expectedVideo: &Video{
+				ID:           "iYYRH4apXDo",
+				Title:        "Space Oddity",
+				ChannelTitle: "David Bowie - Topic",
+				Description:  "Auto-generated by YouTube.",
+			},
+		},
+		{
+			name:          "when playlist is empty",
+			responseMock:  `{"items": []}`,
+			expectedError: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				require.Equal(t, "/youtube/v3/playlistItems", r.URL.Path)
+				require.Equal(t, "1", r.URL.Query().Get("maxResults"))
+				require.Equal(t, "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU", r.URL.Query().Get("playlistId"))
+
+				_, err := w.Write([]byte(tt.responseMock))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(sampleAPIKey, WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			video, err := client.GetFirstPlaylistItem(ctx, "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU")
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedVideo, video)
+			require.Equal(t, 1, requests)
+		})
+	}
+}
+
+func TestHTTPClient_GetChannel(t *testing.T) {
+	tests := []struct {
+		name            string
+		inputID         string
+		responseMock    string
+		expectedChannel *Channel
+		expectedErr     error
+	}{
+		{
+			name:    "when channel found",
+			inputID: "UC8Yu1_yfN5qPh601Y4btsYw",
+			responseMock: `{
+				"items": [
+					{
+						"id": "UC8Yu1_yfN5qPh601Y4btsYw",
+						"snippet": {
+							"title": "David Bowie - Topic"
+						}
+					}
+				]
+			}`,
+			expectedChannel: &Channel{
+				ID:    "UC8Yu1_yfN5qPh601Y4btsYw",
+				Title: "David Bowie - Topic",
+			},
+		},
+		{
+			name:    "when channel not found",
+			inputID: "notFoundId",
+			responseMock: `{
+				"items": []
+			}`,
+			expectedChannel: nil,
+			expectedErr:     NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/youtube/v3/channels", r.URL.Path)
+				require.Equal(t, sampleAPIKey, r.URL.Query().Get("key"))
+				require.Equal(t, "snippet", r.URL.Query().Get("part"))
+				require.Equal(t, tt.inputID, r.URL.Query().Get("id"))
+
+				_, err := w.Write([]byte(tt.responseMock))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(sampleAPIKey, WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			channel, err := client.GetChannel(ctx, tt.inputID)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedChannel, channel)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchChannel(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		responseMock     string
+		expectedResponse *SearchResponse
+		expectedErr      error
+	}{
+		{
+			name:  "when channel found",
+			query: "david bowie",
+			responseMock: `{
+				"items": [
+					{
+						"id": {
+							"channelId": "UC8Yu1_yfN5qPh601Y4btsYw"
+						}
+					}
+				]
+			}`,
+			expectedResponse: &SearchResponse{
+				Items: []SearchItem{
+					{
+						ID: SearchID{
+							ChannelID: "UC8Yu1_yfN5qPh601Y4btsYw",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "when channel not found",
+			query: "notFoundId",
+			responseMock: `{
+				"items": []
+			}`,
+			expectedResponse: nil,
+			expectedErr:      NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/youtube/v3/search", r.URL.Path)
+				require.Equal(t, sampleAPIKey, r.URL.Query().Get("key"))
+				require.Equal(t, tt.query, r.URL.Query().Get("q"))
+				require.Equal(t, "channel", r.URL.Query().Get("type"))
+
+				_, err := w.Write([]byte(tt.responseMock))
+				require.NoError(t, err)
+			}))
+			defer apiServerMock.Close()
+
+			client := NewHTTPClient(sampleAPIKey, WithAPIURL(apiServerMock.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			response, err := client.SearchChannel(ctx, tt.query)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedResponse, response)
+			}
+		})
+	}
+}
+
 func TestHTTPClient_GetPlaylistItems(t *testing.T) {
 	tests := []struct {
 		name           string
 		inputID        string
-		responseMock   string
+		responseMocks  map[string]string
 		responseCode   int
 		expectedVideos []Video
 		expectedError  error
@@ -316,38 +658,71 @@ func TestHTTPClient_GetPlaylistItems(t *testing.T) {
 			name:         "when playlist found",
 			inputID:      "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
 			responseCode: http.StatusOK,
-			responseMock: `{	
-				"items": [
-					{	
-						"id": "T0xBSzV1eV9uNHhhdXVzVEpTajZNdHQ0Y0l1cTRLWnppU2ZqQUJZV1UuQjcxRUYzNEU1RkQxODA0OQ",
-						"snippet": {	
-							"title": "Space Oddity",	
-							"channelTitle": "YouTube",	
-							"description": "Provided to YouTube by Revolver Records\n\nSpace Oddity · David Bowie · David Bowie · David Bowie\n\nSpace Oddity\n\n℗ 2018 Revolver Records\n\nReleased on: 2020-01-01\n\nAuto-generated by YouTube.",
-							"videoOwnerChannelTitle": "David Bowie - Topic"
+			responseMocks: map[string]string{
+				"": `{
+					"items": [
+						{
+							"id": "T0xBSzV1eV9uNHhhdXVzVEpTajZNdHQ0Y0l1cTRLWnppU2ZqQUJZV1UuQjcxRUYzNEU1RkQxODA0OQ",
+							"snippet": {
+								"title": "Space Oddity",
+								"channelTitle": "YouTube",
+								"description": "Provided to YouTube by Revolver Records\n\nSpace Oddity · David Bowie · David Bowie · David Bowie\n\nSpace Oddity\n\n℗ 2018 Revolver Records\n\nReleased on: 2020-01-01\n\nAuto-generated by YouTube.",
+								"videoOwnerChannelTitle": "David Bowie - Topic",
+								"resourceId": {"kind": "youtube#video", "videoId": "iYYRH4apXDo"}
+							}
 						}
-					}
-				]
-			}`,
+					],
+					"nextPageToken": "nextPageTokenMock"
+				}`,
+				"nextPageTokenMock": `{
+					"items": [
+						{
+							"id": "deletedItemID",
+							"snippet": {
+								"title": "Deleted video",
+								"description": "This video is unavailable."
+							}
+						},
+						{
+							"id": "T0xBSzV1eV9uNHhhdXVzVEpTajZNdHQ0Y0l1cTRLWnppU2ZqQUJZV1UuNTI3QjVGRjJGMkU2MTQxNg",
+							"snippet": {
+								"title": "Starman",
+								"channelTitle": "YouTube",
+								"description": "Auto-generated by YouTube.",
+								"videoOwnerChannelTitle": "David Bowie - Topic",
+								"resourceId": {"kind": "youtube#video", "videoId": "sI66hcu9fIs"}
+							}
+						}
+					]
+				}`,
+			},
 			expectedError: nil,
 			expectedVideos: []Video{
 				{
-					ID:           "T0xBSzV1eV9uNHhhdXVzVEpTajZNdHQ0Y0l1cTRLWnppU2ZqQUJZV1UuQjcxRUYzNEU1RkQxODA0OQ",
+					ID:           "iYYRH4apXDo",
 					Title:        "Space Oddity",
 					ChannelTitle: "David Bowie - Topic",
 					Description: "Provided to YouTube by Revolver Records\n\nSpace Oddity · David Bowie · David Bowie " +
 						"· David Bowie\n\nSpace Oddity\n\n℗ 2018 Revolver Records\n\nReleased on: 2020-01-01\n\n" +
 						"Auto-generated by YouTube.",
 				},
+				{
+					ID:           "sI66hcu9fIs",
+					Title:        "Starman",
+					ChannelTitle: "David Bowie - Topic",
+					Description:  "Auto-generated by YouTube.",
+				},
 			},
 		},
 		{
-			name:           "when playlist not found",
-			inputID:        "notFoundId",
-			responseCode:   http.StatusNotFound,
-			responseMock:   "nevermind",
+			name:         "when playlist not found",
+			inputID:      "notFoundId",
+			responseCode: http.StatusNotFound,
+			responseMocks: map[string]string{
+				"": "nevermind",
+			},
 			expectedVideos: nil,
-			expectedError:  fmt.Errorf("non ok http status: 404"),
+			expectedError:  NotFoundError,
 		},
 	}
 	for _, tt := range tests {
@@ -357,10 +732,14 @@ func TestHTTPClient_GetPlaylistItems(t *testing.T) {
 				require.Equal(t, "/youtube/v3/playlistItems", r.URL.Path)
 				require.Equal(t, sampleAPIKey, r.URL.Query().Get("key"))
 				require.Equal(t, "snippet", r.URL.Query().Get("part"))
+				require.Equal(t, "50", r.URL.Query().Get("maxResults"))
 				require.Equal(t, tt.inputID, r.URL.Query().Get("playlistId"))
 
+				responseMock, ok := tt.responseMocks[r.URL.Query().Get("pageToken")]
+				require.True(t, ok)
+
 				w.WriteHeader(tt.responseCode)
-				_, err := w.Write([]byte(tt.responseMock))
+				_, err := w.Write([]byte(responseMock))
 				require.NoError(t, err)
 			}))
 			defer apiServerMock.Close()
@@ -381,3 +760,63 @@ func TestHTTPClient_GetPlaylistItems(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPClient_GetAlbumPlaylistID(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseCode int
+		responseMock string
+		expectedID   string
+		expectedErr  error
+	}{
+		{
+			name:         "when album page contains playlist ID",
+			responseCode: http.StatusOK,
+			responseMock: `<html><script>var data = '{"playlistId":"OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU"}';</script></html>`,
+			expectedID:   "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+		},
+		{
+			name:         "when album page has no playlist ID",
+			responseCode: http.StatusOK,
+			responseMock: `<html></html>`,
+			expectedErr:  NotFoundError,
+		},
+		{
+			name:         "when album not found",
+			responseCode: http.StatusNotFound,
+			expectedErr:  NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			musicServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/browse/MPREb_9lLdAHqeAh6", r.URL.Path)
+
+				w.WriteHeader(tt.responseCode)
+				_, err := w.Write([]byte(tt.responseMock))
+				require.NoError(t, err)
+			}))
+			defer musicServerMock.Close()
+
+			quota := throttle.NewQuota(10, nil, time.UTC)
+			client := NewHTTPClient(
+				sampleAPIKey,
+				WithMusicURL(musicServerMock.URL),
+				WithThrottle(nil, quota),
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			id, err := client.GetAlbumPlaylistID(ctx, "MPREb_9lLdAHqeAh6")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedID, id)
+			}
+			require.Zero(t, quota.Used())
+		})
+	}
+}
@@ -3,18 +3,34 @@ package youtube
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
 	autogenVideoDescriptionSubstring = "Auto-generated by YouTube"
 	autogenVideoChannelTitleSuffix   = " - Topic"
 	autogenPlaylistTitlePrefix       = "Album - "
+	autogenAlbumPlaylistIDPrefix     = "OLAK5uy_"
+	albumBrowseIDPrefix              = "MPREb_"
+
+	musicBaseURL = "https://music.youtube.com"
 )
 
 var (
-	VideoRe    = regexp.MustCompile(`(?:youtu\.be/|youtube\.com/watch\?v=)([a-zA-Z0-9_-]{11})`)
+	VideoRe    = regexp.MustCompile(`(?:youtu\.be/|youtube\.com/watch\?v=|vnd\.youtube:(?://)?)([a-zA-Z0-9_-]{11})`)
 	PlaylistRe = regexp.MustCompile(`(?:youtube\.com/playlist\?list=|youtu\.be/playlist\?list=)([a-zA-Z0-9_-]+)`)
+	ChannelRe  = regexp.MustCompile(`youtube\.com/channel/(UC[a-zA-Z0-9_-]{22})`)
+
+	MusicVideoRe    = regexp.MustCompile(`music\.youtube\.com/watch\?(?:\S*&)?v=([a-zA-Z0-9_-]{11})`)
+	MusicPlaylistRe = regexp.MustCompile(`music\.youtube\.com/playlist\?(?:\S*&)?list=([a-zA-Z0-9_-]+)`)
+	MusicBrowseRe   = regexp.MustCompile(`music\.youtube\.com/browse/(MPREb_[a-zA-Z0-9_-]+)`)
+	MusicChannelRe  = regexp.MustCompile(`music\.youtube\.com/channel/(UC[a-zA-Z0-9_-]{22})`)
+
+	musicHostRe = regexp.MustCompile(`music\.youtube\.com/`)
+
+	isoDurationRe = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
 )
 
 type Video struct {
@@ -22,14 +38,27 @@ type Video struct {
 	Title        string
 	ChannelTitle string
 	Description  string
+	Duration     time.Duration
+	PublishedAt  string
+	ThumbnailURL string
 }
+
 type Playlist struct {
 	ID           string
 	Title        string
 	ChannelTitle string
+	ThumbnailURL string
+}
+
+type Channel struct {
+	ID    string
+	Title string
 }
 
 func DetectTrackID(trackURL string) string {
+	if musicHostRe.MatchString(trackURL) {
+		return ""
+	}
 	if matches := VideoRe.FindStringSubmatch(trackURL); len(matches) > 1 {
 		return matches[1]
 	}
@@ -37,16 +66,89 @@ func DetectTrackID(trackURL string) string {
 }
 
 func DetectAlbumID(albumURL string) string {
+	if musicHostRe.MatchString(albumURL) {
+		return ""
+	}
 	if matches := PlaylistRe.FindStringSubmatch(albumURL); len(matches) > 1 {
+		if strings.HasPrefix(matches[1], autogenAlbumPlaylistIDPrefix) {
+			return matches[1]
+		}
+	}
+	return ""
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	if musicHostRe.MatchString(playlistURL) {
+		return ""
+	}
+	if matches := PlaylistRe.FindStringSubmatch(playlistURL); len(matches) > 1 {
+		if !strings.HasPrefix(matches[1], autogenAlbumPlaylistIDPrefix) {
+			return matches[1]
+		}
+	}
+	return ""
+}
+
+func DetectArtistID(artistURL string) string {
+	if musicHostRe.MatchString(artistURL) {
+		return ""
+	}
+	if matches := ChannelRe.FindStringSubmatch(artistURL); len(matches) > 1 {
+		return matches[1]
+	}
+	return ""
+}
+
+// DetectMusicTrackID, DetectMusicAlbumID, DetectMusicArtistID and DetectMusicPlaylistID detect
+// the links on music.youtube.com, which the Detect functions above leave out.
+func DetectMusicTrackID(trackURL string) string {
+	if matches := MusicVideoRe.FindStringSubmatch(trackURL); len(matches) > 1 {
+		return matches[1]
+	}
+	return ""
+}
+
+// DetectMusicAlbumID returns either the album playlist ID or the album browse ID.
+func DetectMusicAlbumID(albumURL string) string {
+	if matches := MusicBrowseRe.FindStringSubmatch(albumURL); len(matches) > 1 {
+		return matches[1]
+	}
+	if matches := MusicPlaylistRe.FindStringSubmatch(albumURL); len(matches) > 1 {
+		if strings.HasPrefix(matches[1], autogenAlbumPlaylistIDPrefix) {
+			return matches[1]
+		}
+	}
+	return ""
+}
+
+func DetectMusicArtistID(artistURL string) string {
+	if matches := MusicChannelRe.FindStringSubmatch(artistURL); len(matches) > 1 {
 		return matches[1]
 	}
 	return ""
 }
 
+func DetectMusicPlaylistID(playlistURL string) string {
+	if matches := MusicPlaylistRe.FindStringSubmatch(playlistURL); len(matches) > 1 {
+		if !strings.HasPrefix(matches[1], autogenAlbumPlaylistIDPrefix) {
+			return matches[1]
+		}
+	}
+	return ""
+}
+
+func IsAlbumBrowseID(id string) bool {
+	return strings.HasPrefix(id, albumBrowseIDPrefix)
+}
+
 func (v *Video) URL() string {
 	return fmt.Sprintf("https://www.youtube.com/watch?v=%s", v.ID)
 }
 
+func (v *Video) MusicURL() string {
+	return fmt.Sprintf("%s/watch?v=%s", musicBaseURL, v.ID)
+}
+
 func (v *Video) IsAutogenerated() bool {
 	return strings.Contains(v.Description, autogenVideoDescriptionSubstring)
 }
@@ -62,6 +164,10 @@ func (p *Playlist) URL() string {
 	return fmt.Sprintf("https://www.youtube.com/playlist?list=%s", p.ID)
 }
 
+func (p *Playlist) MusicURL() string {
+	return fmt.Sprintf("%s/playlist?list=%s", musicBaseURL, p.ID)
+}
+
 func (p *Playlist) IsAutogenerated() bool {
 	return strings.HasPrefix(p.Title, autogenPlaylistTitlePrefix)
 }
@@ -72,3 +178,40 @@ func (p *Playlist) Album() string {
 	}
 	return strings.TrimPrefix(p.Title, autogenPlaylistTitlePrefix)
 }
+
+func (c *Channel) URL() string {
+	return fmt.Sprintf("https://www.youtube.com/channel/%s", c.ID)
+}
+
+func (c *Channel) MusicURL() string {
+	return fmt.Sprintf("%s/channel/%s", musicBaseURL, c.ID)
+}
+
+func (c *Channel) IsAutogenerated() bool {
+	return strings.HasSuffix(c.Title, autogenVideoChannelTitleSuffix)
+}
+
+func (c *Channel) Artist() string {
+	return strings.TrimSuffix(c.Title, autogenVideoChannelTitleSuffix)
+}
+
+func parseISODuration(duration string) time.Duration {
+	matches := isoDurationRe.FindStringSubmatch(duration)
+	if matches == nil {
+		return 0
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	result := time.Duration(0)
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		value, err := strconv.Atoi(matches[i+1])
+		if err != nil {
+			return 0
+		}
+		result += time.Duration(value) * unit
+	}
+	return result
+}
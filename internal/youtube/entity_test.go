@@ -2,6 +2,7 @@ package youtube
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -124,6 +125,41 @@ func TestPlaylist_Album(t *testing.T) {
 	}
 }
 
+func TestChannel_URL(t *testing.T) {
+	channel := Channel{ID: "UC8Yu1_yfN5qPh601Y4btsYw"}
+	result := channel.URL()
+	require.Equal(t, "https://www.youtube.com/channel/UC8Yu1_yfN5qPh601Y4btsYw", result)
+}
+
+func TestChannel_Artist(t *testing.T) {
+	tests := []struct {
+		name              string
+		title             string
+		wantArtist        string
+		wantAutogenerated bool
+	}{
+		{
+			name:              "not autogenerated",
+			title:             "David Bowie",
+			wantArtist:        "David Bowie",
+			wantAutogenerated: false,
+		},
+		{
+			name:              "autogenerated",
+			title:             "David Bowie - Topic",
+			wantArtist:        "David Bowie",
+			wantAutogenerated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Channel{Title: tt.title}
+			require.Equal(t, tt.wantArtist, c.Artist())
+			require.Equal(t, tt.wantAutogenerated, c.IsAutogenerated())
+		})
+	}
+}
+
 func Test_DetectTrackID(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -141,6 +177,16 @@ func Test_DetectTrackID(t *testing.T) {
 			input:    "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
 			expected: "dQw4w9WgXcQ",
 		},
+		{
+			name:     "App URI",
+			input:    "vnd.youtube:dQw4w9WgXcQ",
+			expected: "dQw4w9WgXcQ",
+		},
+		{
+			name:     "App URI with slashes",
+			input:    "vnd.youtube://dQw4w9WgXcQ",
+			expected: "dQw4w9WgXcQ",
+		},
 		{
 			name:     "URL with extra parameters",
 			input:    "https://www.youtube.com/watch?v=dQw4w9WgXcQ&feature=youtu.be",
@@ -149,7 +195,7 @@ func Test_DetectTrackID(t *testing.T) {
 		{
 			name:     "Youtube music URL",
 			input:    "https://music.youtube.com/watch?v=5PgdZDXg0z0&si=LkthPMI6H_I04dhP",
-			expected: "5PgdZDXg0z0",
+			expected: "",
 		},
 		{
 			name:     "Invalid URL",
@@ -182,6 +228,57 @@ func Test_DetectTrackID(t *testing.T) {
 }
 
 func Test_DetectAlbumID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Standard URL",
+			input:    "https://www.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			expected: "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+		},
+		{
+			name:     "Shortened URL",
+			input:    "https://youtu.be/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			expected: "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+		},
+		{
+			name:     "URL with extra parameters",
+			input:    "https://www.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU&feature=share",
+			expected: "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+		},
+		{
+			name:     "Youtube music URL",
+			input:    "https://music.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			expected: "",
+		},
+		{
+			name:     "User playlist URL",
+			input:    "https://www.youtube.com/playlist?list=PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL",
+			input:    "https://www.example.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			expected: "",
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectAlbumID(tt.input)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_DetectPlaylistID(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    string
@@ -203,9 +300,9 @@ func Test_DetectAlbumID(t *testing.T) {
 			expected: "PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj",
 		},
 		{
-			name:     "Youtube music URL",
+			name:     "Album playlist URL",
 			input:    "https://music.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
-			expected: "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			expected: "",
 		},
 		{
 			name:     "Invalid URL",
@@ -221,8 +318,149 @@ func Test_DetectAlbumID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := DetectAlbumID(tt.input)
+			result := DetectPlaylistID(tt.input)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_DetectArtistID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Channel URL",
+			input:    "https://www.youtube.com/channel/UC8Yu1_yfN5qPh601Y4btsYw",
+			expected: "UC8Yu1_yfN5qPh601Y4btsYw",
+		},
+		{
+			name:     "Youtube music channel URL",
+			input:    "https://music.youtube.com/channel/UC8Yu1_yfN5qPh601Y4btsYw",
+			expected: "",
+		},
+		{
+			name:     "Playlist URL",
+			input:    "https://www.youtube.com/playlist?list=PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj",
+			expected: "",
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DetectArtistID(tt.input)
 			require.Equal(t, tt.expected, result)
 		})
 	}
 }
+
+func Test_parseISODuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{input: "PT3M33S", expected: 3*time.Minute + 33*time.Second},
+		{input: "PT1H2M3S", expected: time.Hour + 2*time.Minute + 3*time.Second},
+		{input: "PT45S", expected: 45 * time.Second},
+		{input: "P1DT1H", expected: 25 * time.Hour},
+		{input: "P0D", expected: 0},
+		{input: "invalid", expected: 0},
+		{input: "", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			require.Equal(t, tt.expected, parseISODuration(tt.input))
+		})
+	}
+}
+
+func Test_DetectMusicTrackID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Watch URL",
+			input:    "https://music.youtube.com/watch?v=5PgdZDXg0z0&si=LkthPMI6H_I04dhP",
+			expected: "5PgdZDXg0z0",
+		},
+		{
+			name:     "Watch URL within album",
+			input:    "https://music.youtube.com/watch?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU&v=5PgdZDXg0z0",
+			expected: "5PgdZDXg0z0",
+		},
+		{
+			name:     "Youtube URL",
+			input:    "https://www.youtube.com/watch?v=5PgdZDXg0z0",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectMusicTrackID(tt.input))
+		})
+	}
+}
+
+func Test_DetectMusicAlbumID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Browse URL",
+			input:    "https://music.youtube.com/browse/MPREb_9lLdAHqeAh6",
+			expected: "MPREb_9lLdAHqeAh6",
+		},
+		{
+			name:     "Album playlist URL",
+			input:    "https://music.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			expected: "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+		},
+		{
+			name:     "User playlist URL",
+			input:    "https://music.youtube.com/playlist?list=PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj",
+			expected: "",
+		},
+		{
+			name:     "Youtube album playlist URL",
+			input:    "https://www.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DetectMusicAlbumID(tt.input))
+		})
+	}
+}
+
+func Test_DetectMusicArtistID(t *testing.T) {
+	require.Equal(t, "UC8Yu1_yfN5qPh601Y4btsYw", DetectMusicArtistID("https://music.youtube.com/channel/UC8Yu1_yfN5qPh601Y4btsYw"))
+	require.Equal(t, "", DetectMusicArtistID("https://www.youtube.com/channel/UC8Yu1_yfN5qPh601Y4btsYw"))
+}
+
+func Test_DetectMusicPlaylistID(t *testing.T) {
+	require.Equal(t, "PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj", DetectMusicPlaylistID("https://music.youtube.com/playlist?list=PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj"))
+	require.Equal(t, "", DetectMusicPlaylistID("https://music.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU"))
+}
+
+func TestMusicURL(t *testing.T) {
+	video := Video{ID: "5PgdZDXg0z0"}
+	require.Equal(t, "https://music.youtube.com/watch?v=5PgdZDXg0z0", video.MusicURL())
+
+	playlist := Playlist{ID: "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU"}
+	require.Equal(t, "https://music.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU", playlist.MusicURL())
+
+	channel := Channel{ID: "UC8Yu1_yfN5qPh601Y4btsYw"}
+	require.Equal(t, "https://music.youtube.com/channel/UC8Yu1_yfN5qPh601Y4btsYw", channel.MusicURL())
+}
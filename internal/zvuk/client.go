@@ -0,0 +1,346 @@
+package zvuk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	defaultAPIURL = "https://zvuk.com"
+
+	authTokenHeader = "X-Auth-Token"
+
+	tracksBatchSize = 100
+)
+
+var (
+	NotFoundError = errors.New("not found")
+)
+
+type Client interface {
+	FetchTrack(ctx context.Context, id string) (*Track, error)
+	SearchTrack(ctx context.Context, query string) (*Track, error)
+	SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error)
+	FetchRelease(ctx context.Context, id string) (*Release, error)
+	SearchRelease(ctx context.Context, query string) (*Release, error)
+	SearchReleases(ctx context.Context, query string, limit int) ([]*Release, error)
+	FetchArtist(ctx context.Context, id string) (*Artist, error)
+	SearchArtist(ctx context.Context, query string) (*Artist, error)
+	FetchPlaylist(ctx context.Context, id string) (*Playlist, error)
+}
+
+// HTTPClient uses the anonymous session token the web player gets, so it needs no credentials.
+type HTTPClient struct {
+	apiURL      string
+	httpClient  *http.Client
+	tokenMu     sync.Mutex
+	token       string
+	retryPolicy retry.Policy
+	limiter     *throttle.Limiter
+	quota       *throttle.Quota
+}
+
+type response struct {
+	Result result `json:"result"`
+}
+
+type result struct {
+	Tracks    map[string]*Track    `json:"tracks"`
+	Releases  map[string]*Release  `json:"releases"`
+	Artists   map[string]*Artist   `json:"artists"`
+	Playlists map[string]*Playlist `json:"playlists"`
+	Search    *searchResult        `json:"search"`
+}
+
+type searchResult struct {
+	Tracks   searchSection `json:"tracks"`
+	Releases searchSection `json:"releases"`
+	Artists  searchSection `json:"artists"`
+}
+
+type searchSection struct {
+	Items []searchItem `json:"items"`
+}
+
+type searchItem struct {
+	ID int `json:"id"`
+}
+
+type profileResponse struct {
+	Result profile `json:"result"`
+}
+
+type profile struct {
+	Token string `json:"token"`
+}
+
+func NewHTTPClient(opts ...ClientOption) *HTTPClient {
+	c := HTTPClient{
+		apiURL:      defaultAPIURL,
+		httpClient:  &http.Client{},
+		retryPolicy: retry.DefaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	c.httpClient.Transport = retry.NewTransport(
+		throttle.NewTransport(c.httpClient.Transport, c.limiter, c.quota),
+		c.retryPolicy,
+	)
+
+	return &c
+}
+
+func (c *HTTPClient) FetchTrack(ctx context.Context, id string) (*Track, error) {
+	res, err := c.getAPI(ctx, "/api/tiny/tracks", url.Values{"ids": []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	return lookup(res.Tracks, id)
+}
+
+func (c *HTTPClient) SearchTrack(ctx context.Context, query string) (*Track, error) {
+	tracks, err := c.SearchTracks(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *HTTPClient) SearchTracks(ctx context.Context, query string, limit int) ([]*Track, error) {
+	res, err := c.search(ctx, query, "track", limit)
+	if err != nil {
+		return nil, err
+	}
+	return searchItems(res.Search.Tracks, res.Tracks, limit)
+}
+
+func (c *HTTPClient) FetchRelease(ctx context.Context, id string) (*Release, error) {
+	res, err := c.getAPI(ctx, "/api/tiny/releases", url.Values{"ids": []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	return lookup(res.Releases, id)
+}
+
+func (c *HTTPClient) SearchRelease(ctx context.Context, query string) (*Release, error) {
+	releases, err := c.SearchReleases(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return releases[0], nil
+}
+
+func (c *HTTPClient) SearchReleases(ctx context.Context, query string, limit int) ([]*Release, error) {
+	res, err := c.search(ctx, query, "release", limit)
+	if err != nil {
+		return nil, err
+	}
+	return searchItems(res.Search.Releases, res.Releases, limit)
+}
+
+func (c *HTTPClient) FetchArtist(ctx context.Context, id string) (*Artist, error) {
+	res, err := c.getAPI(ctx, "/api/tiny/artists", url.Values{"ids": []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	return lookup(res.Artists, id)
+}
+
+func (c *HTTPClient) SearchArtist(ctx context.Context, query string) (*Artist, error) {
+	res, err := c.search(ctx, query, "artist", 1)
+	if err != nil {
+		return nil, err
+	}
+	artists, err := searchItems(res.Search.Artists, res.Artists, 1)
+	if err != nil {
+		return nil, err
+	}
+	return artists[0], nil
+}
+
+// FetchPlaylist returns the playlist with its tracks, which are listed by IDs and fetched in batches.
+func (c *HTTPClient) FetchPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	res, err := c.getAPI(ctx, "/api/tiny/playlists", url.Values{"ids": []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := lookup(res.Playlists, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for start := 0; start < len(playlist.TrackIDs); start += tracksBatchSize {
+		batch := playlist.TrackIDs[start:min(start+tracksBatchSize, len(playlist.TrackIDs))]
+		ids := make([]string, 0, len(batch))
+		for _, trackID := range batch {
+			ids = append(ids, strconv.Itoa(trackID))
+		}
+
+		res, err := c.getAPI(ctx, "/api/tiny/tracks", url.Values{"ids": []string{strings.Join(ids, ",")}})
+		if err != nil {
+			return nil, err
+		}
+		for _, trackID := range ids {
+			if track, ok := res.Tracks[trackID]; ok {
+				playlist.Tracks = append(playlist.Tracks, track)
+			}
+		}
+	}
+
+	return playlist, nil
+}
+
+func (c *HTTPClient) search(ctx context.Context, query, include string, limit int) (*result, error) {
+	res, err := c.getAPI(ctx, "/api/tiny/search", url.Values{
+		"query":   []string{query},
+		"include": []string{include},
+		"limit":   []string{strconv.Itoa(limit)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Search == nil {
+		return nil, NotFoundError
+	}
+	return res, nil
+}
+
+func lookup[T any](items map[string]*T, id string) (*T, error) {
+	item, ok := items[id]
+	if !ok || item == nil {
+		return nil, NotFoundError
+	}
+	return item, nil
+}
+
+// searchItems returns the found items in the order of the search section.
+func searchItems[T any](section searchSection, items map[string]*T, limit int) ([]*T, error) {
+	found := make([]*T, 0, min(limit, len(section.Items)))
+	for _, item := range section.Items {
+		if len(found) == limit {
+			break
+		}
+		if entity, ok := items[strconv.Itoa(item.ID)]; ok {
+			found = append(found, entity)
+		}
+	}
+	if len(found) == 0 {
+		return nil, NotFoundError
+	}
+	return found, nil
+}
+
+func (c *HTTPClient) getAPI(ctx context.Context, path string, query url.Values) (*result, error) {
+	body, err := c.getWithToken(ctx, path, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	res := response{}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	return &res.Result, nil
+}
+
+// getWithToken requests a new session token once when the current one is rejected.
+func (c *HTTPClient) getWithToken(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	token, err := c.cachedToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token: %w", err)
+	}
+	body, err := c.get(ctx, path, query, token)
+	if errors.Is(err, apierr.UnauthorizedError) {
+		c.resetToken(token)
+		if token, err = c.cachedToken(ctx); err != nil {
+			return nil, fmt.Errorf("failed to fetch token: %w", err)
+		}
+		body, err = c.get(ctx, path, query, token)
+	}
+	return body, err
+}
+
+// cachedToken returns the cached session token, fetching it when there is none.
+// Concurrent requests wait for a single fetch.
+func (c *HTTPClient) cachedToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == "" {
+		token, err := c.fetchToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.token = token
+	}
+	return c.token, nil
+}
+
+// resetToken drops the cached token when it is the rejected one, unless another request
+// has replaced it already.
+func (c *HTTPClient) resetToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == token {
+		c.token = ""
+	}
+}
+
+// get sends the request with the session token unless the token is empty.
+func (c *HTTPClient) get(ctx context.Context, path string, query url.Values, token string) ([]byte, error) {
+	u := fmt.Sprintf("%s%s?%s", c.apiURL, path, query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if token != "" {
+		req.Header.Set(authTokenHeader, token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, NotFoundError
+	default:
+		return nil, apierr.FromStatus(resp.StatusCode)
+	}
+}
+
+func (c *HTTPClient) fetchToken(ctx context.Context) (string, error) {
+	body, err := c.get(ctx, "/api/tiny/profile", url.Values{}, "")
+	if err != nil {
+		return "", err
+	}
+
+	pr := profileResponse{}
+	if err := json.Unmarshal(body, &pr); err != nil {
+		return "", apierr.Malformed(fmt.Errorf("failed to unmarshal response body: %w", err))
+	}
+	if pr.Result.Token == "" {
+		return "", apierr.Malformed(errors.New("profile has no token"))
+	}
+	return pr.Result.Token, nil
+}
@@ -0,0 +1,250 @@
+package zvuk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/apierr"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClient_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		response      string
+		expectedTrack *Track
+		expectedErr   error
+	}{
+		{
+			name: "found track",
+			id:   "125683251",
+			response: `{"result": {"tracks": {"125683251": {
+				"id": 125683251,
+				"title": "Хочешь?",
+				"artist_names": ["Земфира"],
+				"release_id": 27464102,
+				"release_title": "Спасибо",
+				"duration": 213,
+				"position": 3,
+				"image": {"src": "https://cdn-image.zvuk.com/pic?type=release&id=27464102&size={size}&ext=jpg"}
+			}}}}`,
+			expectedTrack: &Track{
+				ID:           125683251,
+				Title:        "Хочешь?",
+				ArtistNames:  []string{"Земфира"},
+				ReleaseID:    27464102,
+				ReleaseTitle: "Спасибо",
+				Duration:     213,
+				Position:     3,
+				Image:        &Image{Src: "https://cdn-image.zvuk.com/pic?type=release&id=27464102&size={size}&ext=jpg"},
+			},
+		},
+		{
+			name:        "not found track",
+			id:          "0",
+			response:    `{"result": {"tracks": {}}}`,
+			expectedErr: NotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/tiny/profile" {
+					_, err := w.Write([]byte(`{"result": {"token": "anonymous_token"}}`))
+					require.NoError(t, err)
+					return
+				}
+				require.Equal(t, "/api/tiny/tracks", r.URL.Path)
+				require.Equal(t, tt.id, r.URL.Query().Get("ids"))
+				require.Equal(t, "anonymous_token", r.Header.Get(authTokenHeader))
+				_, err := w.Write([]byte(tt.response))
+				require.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			client := NewHTTPClient(WithAPIURL(mockServer.URL))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			track, err := client.FetchTrack(ctx, tt.id)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, track)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, track)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_SearchReleases(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tiny/profile" {
+			_, err := w.Write([]byte(`{"result": {"token": "anonymous_token"}}`))
+			require.NoError(t, err)
+			return
+		}
+		require.Equal(t, "/api/tiny/search", r.URL.Path)
+		require.Equal(t, "земфира – спасибо", r.URL.Query().Get("query"))
+		require.Equal(t, "release", r.URL.Query().Get("include"))
+		_, err := w.Write([]byte(`{"result": {
+			"search": {"releases": {"items": [{"id": 2}, {"id": 1}, {"id": 3}]}},
+			"releases": {
+				"1": {"id": 1, "title": "Спасибо (Deluxe)"},
+				"2": {"id": 2, "title": "Спасибо"},
+				"3": {"id": 3, "title": "Спасибо (Live)"}
+			}
+		}}`))
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient(WithAPIURL(mockServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	releases, err := client.SearchReleases(ctx, "земфира – спасибо", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Release{
+		{ID: 2, Title: "Спасибо"},
+		{ID: 1, Title: "Спасибо (Deluxe)"},
+	}, releases)
+}
+
+func TestHTTPClient_FetchPlaylist(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.URL.Path {
+		case "/api/tiny/profile":
+			_, err = w.Write([]byte(`{"result": {"token": "anonymous_token"}}`))
+		case "/api/tiny/playlists":
+			require.Equal(t, "7429361", r.URL.Query().Get("ids"))
+			_, err = w.Write([]byte(`{"result": {"playlists": {"7429361": {"id": 7429361, "title": "sample playlist", "track_ids": [2, 1, 3]}}}}`))
+		case "/api/tiny/tracks":
+			require.Equal(t, "2,1,3", r.URL.Query().Get("ids"))
+			_, err = w.Write([]byte(`{"result": {"tracks": {
+				"1": {"id": 1, "title": "second"},
+				"2": {"id": 2, "title": "first"}
+			}}}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient(WithAPIURL(mockServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	playlist, err := client.FetchPlaylist(ctx, "7429361")
+	require.NoError(t, err)
+	require.Equal(t, &Playlist{
+		ID:       7429361,
+		Title:    "sample playlist",
+		TrackIDs: []int{2, 1, 3},
+		Tracks: []*Track{
+			{ID: 2, Title: "first"},
+			{ID: 1, Title: "second"},
+		},
+	}, playlist)
+}
+
+func TestHTTPClient_RefreshTokenWhenUnauthorized(t *testing.T) {
+	profileRequests := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tiny/profile" {
+			profileRequests++
+			_, err := w.Write([]byte(`{"result": {"token": "fresh_token"}}`))
+			require.NoError(t, err)
+			return
+		}
+		if r.Header.Get(authTokenHeader) != "fresh_token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(`{"result": {"artists": {"210424": {"id": 210424, "title": "Земфира"}}}}`))
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient(WithAPIURL(mockServer.URL))
+	client.token = "expired_token"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := client.FetchArtist(ctx, "210424")
+	require.NoError(t, err)
+	require.Equal(t, &Artist{ID: 210424, Title: "Земфира"}, artist)
+	require.Equal(t, 1, profileRequests)
+}
+
+func TestHTTPClient_ConcurrentRequests(t *testing.T) {
+	var profileRequests atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tiny/profile" {
+			_, err := w.Write([]byte(fmt.Sprintf(`{"result": {"token": "token_%d"}}`, profileRequests.Add(1))))
+			require.NoError(t, err)
+			return
+		}
+		if r.Header.Get(authTokenHeader) == "token_1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(`{"result": {"artists": {"210424": {"id": 210424, "title": "Земфира"}}}}`))
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient(WithAPIURL(mockServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.FetchArtist(ctx, "210424")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), profileRequests.Load())
+}
+
+func TestHTTPClient_UnexpectedStatus(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	client := NewHTTPClient(WithAPIURL(mockServer.URL), WithRetryPolicy(retry.Policy{MaxAttempts: 1}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.FetchTrack(ctx, "125683251")
+	require.ErrorIs(t, err, apierr.UpstreamUnavailableError)
+}
@@ -0,0 +1,115 @@
+package zvuk
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	baseURL = "https://zvuk.com"
+
+	imageSizePlaceholder = "{size}"
+)
+
+var (
+	TrackRe    = regexp.MustCompile(`https://(?:www\.)?(?:sber-)?zvuk\.com/track/(\d+)`)
+	ReleaseRe  = regexp.MustCompile(`https://(?:www\.)?(?:sber-)?zvuk\.com/release/(\d+)`)
+	ArtistRe   = regexp.MustCompile(`https://(?:www\.)?(?:sber-)?zvuk\.com/artist/(\d+)`)
+	PlaylistRe = regexp.MustCompile(`https://(?:www\.)?(?:sber-)?zvuk\.com/playlist/(\d+)`)
+)
+
+type Track struct {
+	ID           int      `json:"id"`
+	Title        string   `json:"title"`
+	ArtistNames  []string `json:"artist_names"`
+	ReleaseID    int      `json:"release_id"`
+	ReleaseTitle string   `json:"release_title"`
+	Duration     int      `json:"duration"`
+	Position     int      `json:"position"`
+	Explicit     bool     `json:"explicit"`
+	Image        *Image   `json:"image"`
+}
+
+type Release struct {
+	ID          int      `json:"id"`
+	Title       string   `json:"title"`
+	ArtistNames []string `json:"artist_names"`
+	Date        int      `json:"date"`
+	Explicit    bool     `json:"explicit"`
+	TrackIDs    []int    `json:"track_ids"`
+	Image       *Image   `json:"image"`
+}
+
+type Artist struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	Image *Image `json:"image"`
+}
+
+type Playlist struct {
+	ID       int      `json:"id"`
+	Title    string   `json:"title"`
+	TrackIDs []int    `json:"track_ids"`
+	Tracks   []*Track `json:"-"`
+}
+
+type Image struct {
+	Src string `json:"src"`
+}
+
+func DetectTrackID(trackURL string) string {
+	return detectID(TrackRe, trackURL)
+}
+
+func DetectReleaseID(releaseURL string) string {
+	return detectID(ReleaseRe, releaseURL)
+}
+
+func DetectArtistID(artistURL string) string {
+	return detectID(ArtistRe, artistURL)
+}
+
+func DetectPlaylistID(playlistURL string) string {
+	return detectID(PlaylistRe, playlistURL)
+}
+
+func (t *Track) URL() string {
+	return fmt.Sprintf("%s/track/%d", baseURL, t.ID)
+}
+
+func (r *Release) URL() string {
+	return fmt.Sprintf("%s/release/%d", baseURL, r.ID)
+}
+
+// ReleaseDate formats the date the API returns as a YYYYMMDD number.
+func (r *Release) ReleaseDate() string {
+	if r.Date < 10000101 {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", r.Date/10000, r.Date/100%100, r.Date%100)
+}
+
+func (a *Artist) URL() string {
+	return fmt.Sprintf("%s/artist/%d", baseURL, a.ID)
+}
+
+func (p *Playlist) URL() string {
+	return fmt.Sprintf("%s/playlist/%d", baseURL, p.ID)
+}
+
+// URL returns the image URL templated with {w}x{h} in place of the size.
+func (i *Image) URL() string {
+	if i == nil {
+		return ""
+	}
+	return strings.Replace(i.Src, imageSizePlaceholder, "{w}x{h}", 1)
+}
+
+func detectID(re *regexp.Regexp, u string) string {
+	match := re.FindStringSubmatch(u)
+	if match == nil || len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
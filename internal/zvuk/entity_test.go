@@ -0,0 +1,94 @@
+package zvuk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntity_URL(t *testing.T) {
+	track := Track{ID: 125683251}
+	require.Equal(t, "https://zvuk.com/track/125683251", track.URL())
+
+	release := Release{ID: 27464102}
+	require.Equal(t, "https://zvuk.com/release/27464102", release.URL())
+
+	artist := Artist{ID: 210424}
+	require.Equal(t, "https://zvuk.com/artist/210424", artist.URL())
+
+	playlist := Playlist{ID: 7429361}
+	require.Equal(t, "https://zvuk.com/playlist/7429361", playlist.URL())
+}
+
+func TestRelease_ReleaseDate(t *testing.T) {
+	release := Release{Date: 20221021}
+	require.Equal(t, "2022-10-21", release.ReleaseDate())
+
+	release = Release{}
+	require.Equal(t, "", release.ReleaseDate())
+}
+
+func TestImage_URL(t *testing.T) {
+	var image *Image
+	require.Equal(t, "", image.URL())
+
+	image = &Image{Src: "https://cdn-image.zvuk.com/pic?type=release&id=27464102&size={size}&ext=jpg"}
+	require.Equal(t, "https://cdn-image.zvuk.com/pic?type=release&id=27464102&size={w}x{h}&ext=jpg", image.URL())
+}
+
+func Test_DetectID(t *testing.T) {
+	tests := []struct {
+		name     string
+		detect   func(string) string
+		inputURL string
+		expected string
+	}{
+		{
+			name:     "Track",
+			detect:   DetectTrackID,
+			inputURL: "https://zvuk.com/track/125683251",
+			expected: "125683251",
+		},
+		{
+			name:     "Track on legacy domain",
+			detect:   DetectTrackID,
+			inputURL: "https://sber-zvuk.com/track/125683251",
+			expected: "125683251",
+		},
+		{
+			name:     "Release",
+			detect:   DetectReleaseID,
+			inputURL: "https://zvuk.com/release/27464102?utm_source=share",
+			expected: "27464102",
+		},
+		{
+			name:     "Artist",
+			detect:   DetectArtistID,
+			inputURL: "https://www.zvuk.com/artist/210424",
+			expected: "210424",
+		},
+		{
+			name:     "Playlist",
+			detect:   DetectPlaylistID,
+			inputURL: "https://zvuk.com/playlist/7429361",
+			expected: "7429361",
+		},
+		{
+			name:     "Invalid URL - Entity",
+			detect:   DetectTrackID,
+			inputURL: "https://zvuk.com/release/27464102",
+			expected: "",
+		},
+		{
+			name:     "Invalid URL - Host",
+			detect:   DetectTrackID,
+			inputURL: "https://example.com/track/125683251",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.detect(tt.inputURL))
+		})
+	}
+}
@@ -1,9 +1,21 @@
 package streamnx
 
-import "errors"
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/bandcamp"
+)
+
+const urlTrailingPunctuation = ".,;:!?)]}>'\""
 
 var (
 	UnknownLinkError = errors.New("unknown entity link")
+
+	urlInTextRe = regexp.MustCompile(
+		`(?i)(?:[a-z][a-z0-9.+-]*://|spotify:|vnd\.youtube:|\b(?:[a-z0-9-]+\.)+[a-z]{2,}/)[^\s<>"«»]+`,
+	)
 )
 
 type Link struct {
@@ -11,10 +23,79 @@ type Link struct {
 	Provider   *Provider
 	EntityID   string
 	EntityType EntityType
+
+	// Offset is the byte offset of the URL in the text it was extracted from.
+	Offset int
+}
+
+// ExtractLinks finds links to entities of any provider in free text. Links are returned
+// in the order of appearance and links to an already found entity are skipped. Links
+// without a scheme, like open.spotify.com/track/..., are recognized too, and the URL of
+// every extracted link has an https or app scheme and a lowercase host.
+func ExtractLinks(text string) []*Link {
+	return extractLinks(text, ParseLink)
+}
+
+// ExtractLinks finds links to entities of the providers enabled in the registry in free text.
+func (r *Registry) ExtractLinks(text string) []*Link {
+	return extractLinks(text, r.ParseLink)
 }
 
 func ParseLink(url string) (*Link, error) {
-	for _, provider := range Providers {
+	return parseLink(url, registeredProviders())
+}
+
+// ParseLink recognizes links of the providers enabled in the registry only, including
+// links on the Bandcamp custom domains of the registry.
+func (r *Registry) ParseLink(url string) (*Link, error) {
+	link, err := parseLink(url, r.EnabledProviders())
+	if errors.Is(err, UnknownLinkError) && len(r.bandcampDomains) > 0 && r.adapter(Bandcamp) != nil {
+		return parseBandcampLink(url, r.bandcampDomains)
+	}
+	return link, err
+}
+
+func extractLinks(text string, parse func(url string) (*Link, error)) []*Link {
+	links := []*Link{}
+	seen := map[string]bool{}
+	for _, loc := range urlInTextRe.FindAllStringIndex(text, -1) {
+		url := strings.TrimRight(text[loc[0]:loc[1]], urlTrailingPunctuation)
+		link, err := parse(normalizeURL(url))
+		if err != nil {
+			continue
+		}
+
+		key := cacheKey(link.Provider.сode, string(link.EntityType), link.EntityID)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		link.Offset = loc[0]
+		links = append(links, link)
+	}
+	return links
+}
+
+func normalizeURL(url string) string {
+	lower := strings.ToLower(url)
+	if strings.HasPrefix(lower, "spotify:") || strings.HasPrefix(lower, "vnd.youtube:") {
+		return url
+	}
+
+	scheme, rest, ok := strings.Cut(url, "://")
+	if !ok {
+		scheme, rest = "https", url
+	}
+	host, path := rest, ""
+	if i := strings.Index(rest, "/"); i >= 0 {
+		host, path = rest[:i], rest[i:]
+	}
+	return strings.ToLower(scheme) + "://" + strings.ToLower(host) + path
+}
+
+func parseLink(url string, providers []*Provider) (*Link, error) {
+	for _, provider := range providers {
 		if id := provider.DetectTrackID(url); id != "" {
 			return &Link{
 				URL:        url,
@@ -31,7 +112,36 @@ func ParseLink(url string) (*Link, error) {
 				EntityType: Album,
 			}, nil
 		}
+		if id := provider.DetectArtistID(url); id != "" {
+			return &Link{
+				URL:        url,
+				Provider:   provider,
+				EntityID:   id,
+				EntityType: Artist,
+			}, nil
+		}
+		if id := provider.DetectPlaylistID(url); id != "" {
+			return &Link{
+				URL:        url,
+				Provider:   provider,
+				EntityID:   id,
+				EntityType: Playlist,
+			}, nil
+		}
 	}
 
 	return nil, UnknownLinkError
 }
+
+func parseBandcampLink(url string, domains bandcamp.Domains) (*Link, error) {
+	if id := domains.DetectTrackID(url); id != "" {
+		return &Link{URL: url, Provider: Bandcamp, EntityID: id, EntityType: Track}, nil
+	}
+	if id := domains.DetectAlbumID(url); id != "" {
+		return &Link{URL: url, Provider: Bandcamp, EntityID: id, EntityType: Album}, nil
+	}
+	if id := domains.DetectArtistID(url); id != "" {
+		return &Link{URL: url, Provider: Bandcamp, EntityID: id, EntityType: Artist}, nil
+	}
+	return nil, UnknownLinkError
+}
@@ -0,0 +1,84 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const defaultResolveMaxHops = 10
+
+type linkResolver struct {
+	httpClient *http.Client
+	maxHops    int
+}
+
+func newLinkResolver() *linkResolver {
+	return &linkResolver{
+		httpClient: &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		maxHops: defaultResolveMaxHops,
+	}
+}
+
+// ResolveLink follows redirects of short and share links (spotify.link, apple.co, ...) until
+// a link recognized by one of the providers is reached. Every hop is tried with a HEAD request
+// first and with GET when HEAD is not redirected. UnknownLinkError is returned when a page is
+// reached that no provider recognizes or the redirects don't end within the hops limit.
+func (r *Registry) ResolveLink(ctx context.Context, rawURL string) (*Link, error) {
+	for hop := 0; ; hop++ {
+		if link, err := r.ParseLink(rawURL); err == nil {
+			return link, nil
+		}
+		if hop == r.resolver.maxHops {
+			return nil, fmt.Errorf("no link recognized after %d redirects: %w", hop, UnknownLinkError)
+		}
+
+		location, err := r.resolver.next(ctx, rawURL)
+		if err != nil {
+			return nil, err
+		}
+		if location == "" {
+			return nil, UnknownLinkError
+		}
+		rawURL = location
+	}
+}
+
+// next returns the location the URL redirects to or an empty string when it isn't redirected.
+func (r *linkResolver) next(ctx context.Context, rawURL string) (string, error) {
+	location, err := r.location(ctx, http.MethodHead, rawURL)
+	if err != nil || location != "" {
+		return location, err
+	}
+	return r.location(ctx, http.MethodGet, rawURL)
+}
+
+func (r *linkResolver) location(ctx context.Context, method, rawURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusMultipleChoices || resp.StatusCode >= http.StatusBadRequest {
+		return "", nil
+	}
+	location, err := resp.Location()
+	if err != nil {
+		if errors.Is(err, http.ErrNoLocation) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get redirect location: %w", err)
+	}
+	return location.String(), nil
+}
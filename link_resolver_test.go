@@ -0,0 +1,154 @@
+package streamnx
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_ResolveLink(t *testing.T) {
+	methods := []string{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.Host+r.URL.Path)
+		switch r.Host + r.URL.Path {
+		case "spotify.link/sample":
+			http.Redirect(w, r, "/intermediate", http.StatusTemporaryRedirect)
+		case "spotify.link/intermediate":
+			http.Redirect(w, r, "https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg?si=abc", http.StatusFound)
+		case "apple.co/sample":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			http.Redirect(w, r, "https://music.apple.com/us/album/song-name/1234567890?i=987654321", http.StatusMovedPermanently)
+		case "deezer.page.link/aBcD1234eFgH":
+			http.Redirect(w, r, "https://www.deezer.com/album/302127", http.StatusFound)
+		case "on.soundcloud.com/AbC123xYz":
+			http.Redirect(w, r, "https://soundcloud.com/flume/sets/skin?utm_source=clipboard", http.StatusFound)
+		case "example.com/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithResolveHTTPTransport(transport),
+		WithResolveMaxHops(3),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		url             string
+		expectedLink    *Link
+		expectedMethods []string
+		expectedErr     error
+	}{
+		{
+			name: "recognized link",
+			url:  "https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg",
+			expectedLink: &Link{
+				URL:        "https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg",
+				Provider:   Spotify,
+				EntityID:   "7uv632EkfwYhXoqf8rhYrg",
+				EntityType: Track,
+			},
+			expectedMethods: []string{},
+		},
+		{
+			name: "redirects followed with HEAD",
+			url:  "https://spotify.link/sample",
+			expectedLink: &Link{
+				URL:        "https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg?si=abc",
+				Provider:   Spotify,
+				EntityID:   "7uv632EkfwYhXoqf8rhYrg",
+				EntityType: Track,
+			},
+			expectedMethods: []string{"HEAD spotify.link/sample", "HEAD spotify.link/intermediate"},
+		},
+		{
+			name: "redirect followed with GET when HEAD is not allowed",
+			url:  "https://apple.co/sample",
+			expectedLink: &Link{
+				URL:        "https://music.apple.com/us/album/song-name/1234567890?i=987654321",
+				Provider:   Apple,
+				EntityID:   "us-987654321",
+				EntityType: Track,
+			},
+			expectedMethods: []string{"HEAD apple.co/sample", "GET apple.co/sample"},
+		},
+		{
+			name: "opaque Deezer short link to album",
+			url:  "https://deezer.page.link/aBcD1234eFgH",
+			expectedLink: &Link{
+				URL:        "https://www.deezer.com/album/302127",
+				Provider:   Deezer,
+				EntityID:   "302127",
+				EntityType: Album,
+			},
+			expectedMethods: []string{"HEAD deezer.page.link/aBcD1234eFgH"},
+		},
+		{
+			name: "SoundCloud short link to set",
+			url:  "https://on.soundcloud.com/AbC123xYz",
+			expectedLink: &Link{
+				URL:        "https://soundcloud.com/flume/sets/skin?utm_source=clipboard",
+				Provider:   SoundCloud,
+				EntityID:   "flume/sets/skin",
+				EntityType: Album,
+			},
+			expectedMethods: []string{"HEAD on.soundcloud.com/AbC123xYz"},
+		},
+		{
+			name:            "page not redirected",
+			url:             "https://example.com/page",
+			expectedMethods: []string{"HEAD example.com/page", "GET example.com/page"},
+			expectedErr:     UnknownLinkError,
+		},
+		{
+			name: "too many redirects",
+			url:  "https://example.com/loop",
+			expectedMethods: []string{
+				"HEAD example.com/loop",
+				"HEAD example.com/loop",
+				"HEAD example.com/loop",
+			},
+			expectedErr: UnknownLinkError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			methods = []string{}
+
+			link, err := registry.ResolveLink(ctx, tt.url)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedLink, link)
+			}
+			require.Equal(t, tt.expectedMethods, methods)
+		})
+	}
+}
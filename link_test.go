@@ -45,19 +45,329 @@ func TestParseLink(t *testing.T) {
 		},
 		{
 			name: "Youtube album",
+			url:  "https://www.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			want: &Link{
+				URL:        "https://www.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+				Provider:   Youtube,
+				EntityID:   "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Youtube playlist",
 			url:  "https://www.youtube.com/playlist?list=PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj",
 			want: &Link{
 				URL:        "https://www.youtube.com/playlist?list=PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj",
 				Provider:   Youtube,
 				EntityID:   "PLMC9KNkIncKtPzgY-5rmhvj7fax8fdxoj",
+				EntityType: Playlist,
+			},
+		},
+		{
+			name: "Spotify playlist",
+			url:  "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M",
+			want: &Link{
+				URL:        "https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M",
+				Provider:   Spotify,
+				EntityID:   "37i9dQZF1DXcBWIGoYBM5M",
+				EntityType: Playlist,
+			},
+		},
+		{
+			name: "Yandex artist",
+			url:  "https://music.yandex.ru/artist/41191",
+			want: &Link{
+				URL:        "https://music.yandex.ru/artist/41191",
+				Provider:   Yandex,
+				EntityID:   "41191",
+				EntityType: Artist,
+			},
+		},
+		{
+			name: "Deezer track",
+			url:  "https://www.deezer.com/en/track/3135556",
+			want: &Link{
+				URL:        "https://www.deezer.com/en/track/3135556",
+				Provider:   Deezer,
+				EntityID:   "3135556",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Deezer album short link",
+			url:  "https://deezer.page.link/?link=https%3A%2F%2Fwww.deezer.com%2Falbum%2F302127",
+			want: &Link{
+				URL:        "https://deezer.page.link/?link=https%3A%2F%2Fwww.deezer.com%2Falbum%2F302127",
+				Provider:   Deezer,
+				EntityID:   "302127",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "SoundCloud track",
+			url:  "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+			want: &Link{
+				URL:        "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+				Provider:   SoundCloud,
+				EntityID:   "flume/never-be-like-you-feat-kai",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "SoundCloud set",
+			url:  "https://soundcloud.com/flume/sets/skin",
+			want: &Link{
+				URL:        "https://soundcloud.com/flume/sets/skin",
+				Provider:   SoundCloud,
+				EntityID:   "flume/sets/skin",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "SoundCloud artist",
+			url:  "https://soundcloud.com/flume",
+			want: &Link{
+				URL:        "https://soundcloud.com/flume",
+				Provider:   SoundCloud,
+				EntityID:   "flume",
+				EntityType: Artist,
+			},
+		},
+		{
+			name: "Tidal track",
+			url:  "https://tidal.com/browse/track/77646169?u",
+			want: &Link{
+				URL:        "https://tidal.com/browse/track/77646169?u",
+				Provider:   Tidal,
+				EntityID:   "77646169",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Tidal track within album",
+			url:  "https://listen.tidal.com/album/77646164/track/77646169",
+			want: &Link{
+				URL:        "https://listen.tidal.com/album/77646164/track/77646169",
+				Provider:   Tidal,
+				EntityID:   "77646169",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Tidal album",
+			url:  "https://listen.tidal.com/album/77646164",
+			want: &Link{
+				URL:        "https://listen.tidal.com/album/77646164",
+				Provider:   Tidal,
+				EntityID:   "77646164",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Bandcamp track",
+			url:  "https://tychomusic.bandcamp.com/track/awake?from=search",
+			want: &Link{
+				URL:        "https://tychomusic.bandcamp.com/track/awake?from=search",
+				Provider:   Bandcamp,
+				EntityID:   "tychomusic.bandcamp.com/track/awake",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Bandcamp album",
+			url:  "https://tychomusic.bandcamp.com/album/awake",
+			want: &Link{
+				URL:        "https://tychomusic.bandcamp.com/album/awake",
+				Provider:   Bandcamp,
+				EntityID:   "tychomusic.bandcamp.com/album/awake",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Bandcamp artist",
+			url:  "https://tychomusic.bandcamp.com/",
+			want: &Link{
+				URL:        "https://tychomusic.bandcamp.com/",
+				Provider:   Bandcamp,
+				EntityID:   "tychomusic.bandcamp.com",
+				EntityType: Artist,
+			},
+		},
+		{
+			name: "Amazon track in album",
+			url:  "https://music.amazon.co.uk/albums/B0BDHWDR3R?trackAsin=B0BDHX1B2S",
+			want: &Link{
+				URL:        "https://music.amazon.co.uk/albums/B0BDHWDR3R?trackAsin=B0BDHX1B2S",
+				Provider:   Amazon,
+				EntityID:   "gb-B0BDHX1B2S",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Amazon album",
+			url:  "https://music.amazon.de/albums/B0BDHWDR3R",
+			want: &Link{
+				URL:        "https://music.amazon.de/albums/B0BDHWDR3R",
+				Provider:   Amazon,
+				EntityID:   "de-B0BDHWDR3R",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Amazon artist",
+			url:  "https://music.amazon.co.jp/artists/B000QJPTJ2/taylor-swift",
+			want: &Link{
+				URL:        "https://music.amazon.co.jp/artists/B000QJPTJ2/taylor-swift",
+				Provider:   Amazon,
+				EntityID:   "jp-B000QJPTJ2",
+				EntityType: Artist,
+			},
+		},
+		{
+			name: "VK track",
+			url:  "https://vk.com/audio-2001234567_456239017",
+			want: &Link{
+				URL:        "https://vk.com/audio-2001234567_456239017",
+				Provider:   VK,
+				EntityID:   "-2001234567_456239017",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "VK album",
+			url:  "https://vk.com/music/album/-2000413089_13089_c1e9a3f2",
+			want: &Link{
+				URL:        "https://vk.com/music/album/-2000413089_13089_c1e9a3f2",
+				Provider:   VK,
+				EntityID:   "-2000413089_13089_c1e9a3f2",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Zvuk track",
+			url:  "https://zvuk.com/track/125683251",
+			want: &Link{
+				URL:        "https://zvuk.com/track/125683251",
+				Provider:   Zvuk,
+				EntityID:   "125683251",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Zvuk release",
+			url:  "https://zvuk.com/release/27464102",
+			want: &Link{
+				URL:        "https://zvuk.com/release/27464102",
+				Provider:   Zvuk,
+				EntityID:   "27464102",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Youtube Music track",
+			url:  "https://music.youtube.com/watch?v=5PgdZDXg0z0&si=LkthPMI6H_I04dhP",
+			want: &Link{
+				URL:        "https://music.youtube.com/watch?v=5PgdZDXg0z0&si=LkthPMI6H_I04dhP",
+				Provider:   YoutubeMusic,
+				EntityID:   "5PgdZDXg0z0",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Youtube Music album browse",
+			url:  "https://music.youtube.com/browse/MPREb_9lLdAHqeAh6",
+			want: &Link{
+				URL:        "https://music.youtube.com/browse/MPREb_9lLdAHqeAh6",
+				Provider:   YoutubeMusic,
+				EntityID:   "MPREb_9lLdAHqeAh6",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Youtube Music album playlist",
+			url:  "https://music.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+			want: &Link{
+				URL:        "https://music.youtube.com/playlist?list=OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
+				Provider:   YoutubeMusic,
+				EntityID:   "OLAK5uy_n4xauusTJSj6Mtt4cIuq4KZziSfjABYWU",
 				EntityType: Album,
 			},
 		},
+		{
+			name: "Spotify track URI",
+			url:  "spotify:track:7uv632EkfwYhXoqf8rhYrg",
+			want: &Link{
+				URL:        "spotify:track:7uv632EkfwYhXoqf8rhYrg",
+				Provider:   Spotify,
+				EntityID:   "7uv632EkfwYhXoqf8rhYrg",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Spotify user playlist URI",
+			url:  "spotify:user:spotify:playlist:37i9dQZF1DXcBWIGoYBM5M",
+			want: &Link{
+				URL:        "spotify:user:spotify:playlist:37i9dQZF1DXcBWIGoYBM5M",
+				Provider:   Spotify,
+				EntityID:   "37i9dQZF1DXcBWIGoYBM5M",
+				EntityType: Playlist,
+			},
+		},
+		{
+			name: "Youtube app URI",
+			url:  "vnd.youtube:dQw4w9WgXcQ",
+			want: &Link{
+				URL:        "vnd.youtube:dQw4w9WgXcQ",
+				Provider:   Youtube,
+				EntityID:   "dQw4w9WgXcQ",
+				EntityType: Track,
+			},
+		},
+		{
+			name: "Yandex app URI",
+			url:  "yandexmusic://artist/36800",
+			want: &Link{
+				URL:        "yandexmusic://artist/36800",
+				Provider:   Yandex,
+				EntityID:   "36800",
+				EntityType: Artist,
+			},
+		},
+		{
+			name: "Deezer app URI",
+			url:  "deezer://www.deezer.com/album/302127",
+			want: &Link{
+				URL:        "deezer://www.deezer.com/album/302127",
+				Provider:   Deezer,
+				EntityID:   "302127",
+				EntityType: Album,
+			},
+		},
+		{
+			name: "Tidal app URI",
+			url:  "tidal://track/77646169",
+			want: &Link{
+				URL:        "tidal://track/77646169",
+				Provider:   Tidal,
+				EntityID:   "77646169",
+				EntityType: Track,
+			},
+		},
 		{
 			name:          "Unknown provider",
 			url:           "https://example.com/track/123456789",
 			expectedError: UnknownLinkError,
 		},
+		{
+			name:          "SoundCloud short link",
+			url:           "https://on.soundcloud.com/AbC123xYz",
+			expectedError: UnknownLinkError,
+		},
+		{
+			name:          "Deezer opaque short link",
+			url:           "https://deezer.page.link/aBcD1234eFgH",
+			expectedError: UnknownLinkError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -72,3 +382,118 @@ func TestParseLink(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []*Link
+	}{
+		{
+			name: "links in text",
+			text: "check this out https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg and also https://youtu.be/dQw4w9WgXcQ!",
+			want: []*Link{
+				{
+					URL:        "https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg",
+					Provider:   Spotify,
+					EntityID:   "7uv632EkfwYhXoqf8rhYrg",
+					EntityType: Track,
+					Offset:     15,
+				},
+				{
+					URL:        "https://youtu.be/dQw4w9WgXcQ",
+					Provider:   Youtube,
+					EntityID:   "dQw4w9WgXcQ",
+					EntityType: Track,
+					Offset:     78,
+				},
+			},
+		},
+		{
+			name: "link in parentheses at the end of sentence",
+			text: "new album (https://tidal.com/browse/album/77646164).",
+			want: []*Link{
+				{
+					URL:        "https://tidal.com/browse/album/77646164",
+					Provider:   Tidal,
+					EntityID:   "77646164",
+					EntityType: Album,
+					Offset:     11,
+				},
+			},
+		},
+		{
+			name: "app URIs",
+			text: "spotify:album:7uv632EkfwYhXoqf8rhYrg, yandexmusic://album/3192570/track/1197793",
+			want: []*Link{
+				{
+					URL:        "spotify:album:7uv632EkfwYhXoqf8rhYrg",
+					Provider:   Spotify,
+					EntityID:   "7uv632EkfwYhXoqf8rhYrg",
+					EntityType: Album,
+				},
+				{
+					URL:        "yandexmusic://album/3192570/track/1197793",
+					Provider:   Yandex,
+					EntityID:   "1197793",
+					EntityType: Track,
+					Offset:     38,
+				},
+			},
+		},
+		{
+			name: "duplicated entity",
+			text: "https://youtu.be/dQw4w9WgXcQ\nhttps://www.youtube.com/watch?v=dQw4w9WgXcQ",
+			want: []*Link{
+				{
+					URL:        "https://youtu.be/dQw4w9WgXcQ",
+					Provider:   Youtube,
+					EntityID:   "dQw4w9WgXcQ",
+					EntityType: Track,
+				},
+			},
+		},
+		{
+			name: "uppercase scheme and host",
+			text: "HTTPS://OPEN.SPOTIFY.COM/track/7uv632EkfwYhXoqf8rhYrg",
+			want: []*Link{
+				{
+					URL:        "https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg",
+					Provider:   Spotify,
+					EntityID:   "7uv632EkfwYhXoqf8rhYrg",
+					EntityType: Track,
+				},
+			},
+		},
+		{
+			name: "links without scheme",
+			text: "open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg, tidal.com/browse/album/77646164",
+			want: []*Link{
+				{
+					URL:        "https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg",
+					Provider:   Spotify,
+					EntityID:   "7uv632EkfwYhXoqf8rhYrg",
+					EntityType: Track,
+				},
+				{
+					URL:        "https://tidal.com/browse/album/77646164",
+					Provider:   Tidal,
+					EntityID:   "77646164",
+					EntityType: Album,
+					Offset:     47,
+				},
+			},
+		},
+		{
+			name: "unknown links and no links",
+			text: "see https://example.com/track/123, example.com/track/456 or just listen to the radio",
+			want: []*Link{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ExtractLinks(tt.text))
+		})
+	}
+}
@@ -1,45 +1,144 @@
 package streamnx
 
 import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/amazon"
 	"github.com/GeorgeGorbanev/streamnx/internal/apple"
+	"github.com/GeorgeGorbanev/streamnx/internal/bandcamp"
+	"github.com/GeorgeGorbanev/streamnx/internal/deezer"
+	"github.com/GeorgeGorbanev/streamnx/internal/soundcloud"
 	"github.com/GeorgeGorbanev/streamnx/internal/spotify"
+	"github.com/GeorgeGorbanev/streamnx/internal/tidal"
+	"github.com/GeorgeGorbanev/streamnx/internal/vk"
 	"github.com/GeorgeGorbanev/streamnx/internal/yandex"
 	"github.com/GeorgeGorbanev/streamnx/internal/youtube"
+	"github.com/GeorgeGorbanev/streamnx/internal/zvuk"
 )
 
 var (
+	DuplicateProviderError = errors.New("provider code is already registered")
+
+	// thirdPartyProviders are the providers registered with RegisterProvider.
+	thirdPartyProviders   []*Provider
+	thirdPartyProvidersMu sync.RWMutex
+
+	// Providers are the providers built into the library.
 	Providers = []*Provider{
 		Apple,
 		Spotify,
 		Yandex,
 		Youtube,
+		Deezer,
+		SoundCloud,
+		Tidal,
+		Bandcamp,
+		Amazon,
+		VK,
+		Zvuk,
+		YoutubeMusic,
 	}
 
 	Apple = &Provider{
-		name:          "Apple",
-		сode:          "ap",
-		regions:       apple.ISO3166codes,
-		trackIDParser: apple.DetectTrackID,
-		albumIDParser: apple.DetectAlbumID,
+		name:             "Apple",
+		сode:             "ap",
+		regions:          apple.ISO3166codes,
+		trackIDParser:    apple.DetectTrackID,
+		albumIDParser:    apple.DetectAlbumID,
+		artistIDParser:   apple.DetectArtistID,
+		playlistIDParser: apple.DetectPlaylistID,
 	}
 	Spotify = &Provider{
-		name:          "Spotify",
-		сode:          "sf",
-		trackIDParser: spotify.DetectTrackID,
-		albumIDParser: spotify.DetectAlbumID,
+		name:             "Spotify",
+		сode:             "sf",
+		trackIDParser:    spotify.DetectTrackID,
+		albumIDParser:    spotify.DetectAlbumID,
+		artistIDParser:   spotify.DetectArtistID,
+		playlistIDParser: spotify.DetectPlaylistID,
 	}
 	Yandex = &Provider{
-		name:          "Yandex",
-		сode:          "ya",
-		regions:       yandex.Regions,
-		trackIDParser: yandex.DetectTrackID,
-		albumIDParser: yandex.DetectAlbumID,
+		name:             "Yandex",
+		сode:             "ya",
+		regions:          yandex.Regions,
+		trackIDParser:    yandex.DetectTrackID,
+		albumIDParser:    yandex.DetectAlbumID,
+		artistIDParser:   yandex.DetectArtistID,
+		playlistIDParser: yandex.DetectPlaylistID,
 	}
 	Youtube = &Provider{
-		name:          "Youtube",
-		сode:          "yt",
-		trackIDParser: youtube.DetectTrackID,
-		albumIDParser: youtube.DetectAlbumID,
+		name:             "Youtube",
+		сode:             "yt",
+		trackIDParser:    youtube.DetectTrackID,
+		albumIDParser:    youtube.DetectAlbumID,
+		artistIDParser:   youtube.DetectArtistID,
+		playlistIDParser: youtube.DetectPlaylistID,
+	}
+	Deezer = &Provider{
+		name:             "Deezer",
+		сode:             "dz",
+		trackIDParser:    deezer.DetectTrackID,
+		albumIDParser:    deezer.DetectAlbumID,
+		artistIDParser:   deezer.DetectArtistID,
+		playlistIDParser: deezer.DetectPlaylistID,
+	}
+	SoundCloud = &Provider{
+		name:             "SoundCloud",
+		сode:             "sc",
+		trackIDParser:    soundcloud.DetectTrackID,
+		albumIDParser:    soundcloud.DetectSetID,
+		artistIDParser:   soundcloud.DetectArtistID,
+		playlistIDParser: soundcloud.DetectPlaylistID,
+	}
+	Tidal = &Provider{
+		name:             "Tidal",
+		сode:             "td",
+		trackIDParser:    tidal.DetectTrackID,
+		albumIDParser:    tidal.DetectAlbumID,
+		artistIDParser:   tidal.DetectArtistID,
+		playlistIDParser: tidal.DetectPlaylistID,
+	}
+	Bandcamp = &Provider{
+		name:             "Bandcamp",
+		сode:             "bc",
+		trackIDParser:    bandcamp.DetectTrackID,
+		albumIDParser:    bandcamp.DetectAlbumID,
+		artistIDParser:   bandcamp.DetectArtistID,
+		playlistIDParser: bandcamp.DetectPlaylistID,
+	}
+	Amazon = &Provider{
+		name:             "Amazon",
+		сode:             "az",
+		regions:          amazon.Regions,
+		trackIDParser:    amazon.DetectTrackID,
+		albumIDParser:    amazon.DetectAlbumID,
+		artistIDParser:   amazon.DetectArtistID,
+		playlistIDParser: amazon.DetectPlaylistID,
+	}
+	VK = &Provider{
+		name:             "VK",
+		сode:             "vk",
+		trackIDParser:    vk.DetectTrackID,
+		albumIDParser:    vk.DetectAlbumID,
+		artistIDParser:   vk.DetectArtistID,
+		playlistIDParser: vk.DetectPlaylistID,
+	}
+	Zvuk = &Provider{
+		name:             "Zvuk",
+		сode:             "zv",
+		trackIDParser:    zvuk.DetectTrackID,
+		albumIDParser:    zvuk.DetectReleaseID,
+		artistIDParser:   zvuk.DetectArtistID,
+		playlistIDParser: zvuk.DetectPlaylistID,
+	}
+	YoutubeMusic = &Provider{
+		name:             "YoutubeMusic",
+		сode:             "ytm",
+		trackIDParser:    youtube.DetectMusicTrackID,
+		albumIDParser:    youtube.DetectMusicAlbumID,
+		artistIDParser:   youtube.DetectMusicArtistID,
+		playlistIDParser: youtube.DetectMusicPlaylistID,
 	}
 )
 
@@ -48,8 +147,43 @@ type Provider struct {
 	сode    string
 	regions []string
 
-	trackIDParser func(trackURL string) string
-	albumIDParser func(albumURL string) string
+	trackIDParser    func(trackURL string) string
+	albumIDParser    func(albumURL string) string
+	artistIDParser   func(artistURL string) string
+	playlistIDParser func(playlistURL string) string
+}
+
+// NewProvider creates a provider of a service not built into the library. Its links are
+// recognized by the detectors given with options once it is registered with RegisterProvider
+// or added to a registry with WithProvider.
+func NewProvider(name, code string, opts ...ProviderOption) *Provider {
+	p := Provider{
+		name:             name,
+		сode:             code,
+		trackIDParser:    detectNoID,
+		albumIDParser:    detectNoID,
+		artistIDParser:   detectNoID,
+		playlistIDParser: detectNoID,
+	}
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return &p
+}
+
+// RegisterProvider registers the provider process-wide, so its links are recognized by ParseLink
+// and it is found by FindProviderByCode, e.g. on init. Providers itself lists the built-in
+// providers only.
+func RegisterProvider(p *Provider) error {
+	thirdPartyProvidersMu.Lock()
+	defer thirdPartyProvidersMu.Unlock()
+
+	registered, err := findRegistered(p, Providers, thirdPartyProviders)
+	if err != nil || registered {
+		return err
+	}
+	thirdPartyProviders = append(thirdPartyProviders, p)
+	return nil
 }
 
 func (p *Provider) Name() string {
@@ -72,11 +206,49 @@ func (p *Provider) DetectAlbumID(albumURL string) string {
 	return p.albumIDParser(albumURL)
 }
 
+func (p *Provider) DetectArtistID(artistURL string) string {
+	return p.artistIDParser(artistURL)
+}
+
+func (p *Provider) DetectPlaylistID(playlistURL string) string {
+	return p.playlistIDParser(playlistURL)
+}
+
 func FindProviderByCode(code string) *Provider {
-	for _, provider := range Providers {
+	for _, provider := range registeredProviders() {
 		if provider.сode == code {
 			return provider
 		}
 	}
 	return nil
 }
+
+// registeredProviders returns the built-in providers followed by the ones registered with
+// RegisterProvider.
+func registeredProviders() []*Provider {
+	thirdPartyProvidersMu.RLock()
+	defer thirdPartyProvidersMu.RUnlock()
+
+	providers := make([]*Provider, 0, len(Providers)+len(thirdPartyProviders))
+	return append(append(providers, Providers...), thirdPartyProviders...)
+}
+
+// findRegistered tells whether the provider is among the given ones and fails when another
+// provider has its code.
+func findRegistered(p *Provider, providerLists ...[]*Provider) (bool, error) {
+	for _, providers := range providerLists {
+		for _, registered := range providers {
+			if registered == p {
+				return true, nil
+			}
+			if registered.сode == p.сode {
+				return false, fmt.Errorf("%w: %s", DuplicateProviderError, p.сode)
+			}
+		}
+	}
+	return false, nil
+}
+
+func detectNoID(string) string {
+	return ""
+}
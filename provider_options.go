@@ -0,0 +1,33 @@
+package streamnx
+
+type ProviderOption func(provider *Provider)
+
+func WithProviderRegions(regions ...string) ProviderOption {
+	return func(provider *Provider) {
+		provider.regions = regions
+	}
+}
+
+func WithTrackIDDetector(detect func(trackURL string) string) ProviderOption {
+	return func(provider *Provider) {
+		provider.trackIDParser = detect
+	}
+}
+
+func WithAlbumIDDetector(detect func(albumURL string) string) ProviderOption {
+	return func(provider *Provider) {
+		provider.albumIDParser = detect
+	}
+}
+
+func WithArtistIDDetector(detect func(artistURL string) string) ProviderOption {
+	return func(provider *Provider) {
+		provider.artistIDParser = detect
+	}
+}
+
+func WithPlaylistIDDetector(detect func(playlistURL string) string) ProviderOption {
+	return func(provider *Provider) {
+		provider.playlistIDParser = detect
+	}
+}
@@ -1,6 +1,8 @@
 package streamnx
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -27,6 +29,38 @@ func TestFindProviderByCode(t *testing.T) {
 			code: "yt",
 			want: Youtube,
 		},
+		{
+			code: "dz",
+			want: Deezer,
+		},
+		{
+			code: "sc",
+			want: SoundCloud,
+		},
+		{
+			code: "td",
+			want: Tidal,
+		},
+		{
+			code: "bc",
+			want: Bandcamp,
+		},
+		{
+			code: "az",
+			want: Amazon,
+		},
+		{
+			code: "vk",
+			want: VK,
+		},
+		{
+			code: "zv",
+			want: Zvuk,
+		},
+		{
+			code: "ytm",
+			want: YoutubeMusic,
+		},
 		{
 			code: "unknown",
 			want: nil,
@@ -39,3 +73,94 @@ func TestFindProviderByCode(t *testing.T) {
 		})
 	}
 }
+
+var catalogTrackRe = regexp.MustCompile(`https://catalog\.example\.org/tracks/(\d+)`)
+
+func TestRegisterProvider(t *testing.T) {
+	t.Cleanup(func() {
+		thirdPartyProvidersMu.Lock()
+		defer thirdPartyProvidersMu.Unlock()
+		thirdPartyProviders = nil
+	})
+
+	catalog := NewProvider("Catalog", "ct", WithTrackIDDetector(detectCatalogTrackID))
+	require.NoError(t, RegisterProvider(catalog))
+	require.NoError(t, RegisterProvider(catalog))
+	require.Equal(t, catalog, FindProviderByCode("ct"))
+	require.NotContains(t, Providers, catalog)
+
+	link, err := ParseLink("https://catalog.example.org/tracks/42")
+	require.NoError(t, err)
+	require.Equal(t, catalog, link.Provider)
+
+	err = RegisterProvider(NewProvider("Another Catalog", "ct"))
+	require.ErrorIs(t, err, DuplicateProviderError)
+	err = RegisterProvider(NewProvider("Fake Apple", "ap"))
+	require.ErrorIs(t, err, DuplicateProviderError)
+}
+
+func TestWithProvider(t *testing.T) {
+	catalog := NewProvider(
+		"Catalog",
+		"ct",
+		WithProviderRegions("us", "gb"),
+		WithTrackIDDetector(detectCatalogTrackID),
+	)
+	require.Equal(t, "Catalog", catalog.Name())
+	require.Equal(t, "ct", catalog.Code())
+	require.Equal(t, []string{"us", "gb"}, catalog.Regions())
+	require.Equal(t, "", catalog.DetectAlbumID("https://catalog.example.org/albums/1"))
+
+	track := &Entity{ID: "42", Title: "sample track", Provider: catalog, Type: Track}
+	ctx := context.Background()
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithProvider(catalog, &adapterMock{fetchTrack: map[string]*Entity{"42": track}}),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, catalog, registry.FindProviderByCode("ct"))
+	require.Contains(t, registry.Providers(), catalog)
+
+	link, err := registry.ParseLink("https://catalog.example.org/tracks/42")
+	require.NoError(t, err)
+	require.Equal(t, &Link{
+		URL:        "https://catalog.example.org/tracks/42",
+		Provider:   catalog,
+		EntityID:   "42",
+		EntityType: Track,
+	}, link)
+
+	entity, err := registry.Fetch(ctx, link.Provider, link.EntityType, link.EntityID)
+	require.NoError(t, err)
+	require.Equal(t, track, entity)
+
+	require.Nil(t, FindProviderByCode("ct"))
+	_, err = ParseLink("https://catalog.example.org/tracks/42")
+	require.ErrorIs(t, err, UnknownLinkError)
+
+	other, err := NewRegistry(ctx, Credentials{}, WithTranslator(&translatorMock{}))
+	require.NoError(t, err)
+	require.NotContains(t, other.Providers(), catalog)
+
+	_, err = NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithProvider(catalog, &adapterMock{}),
+		WithProvider(NewProvider("Another Catalog", "ct"), &adapterMock{}),
+	)
+	require.ErrorIs(t, err, DuplicateProviderError)
+
+	_, err = NewRegistry(ctx, Credentials{}, WithTranslator(&translatorMock{}), WithProvider(NewProvider("Fake Apple", "ap"), &adapterMock{}))
+	require.ErrorIs(t, err, DuplicateProviderError)
+}
+
+func detectCatalogTrackID(trackURL string) string {
+	if match := catalogTrackRe.FindStringSubmatch(trackURL); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package streamnx
+
+import (
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+)
+
+const (
+	youtubeDailyQuota     = 10000
+	youtubeQuotaTimezone  = "America/Los_Angeles"
+	youtubeQuotaUTCOffset = -8 * 60 * 60
+)
+
+// https://developers.google.com/youtube/v3/determine_quota_cost
+var youtubeQuotaCosts = map[string]int{
+	"/youtube/v3/search": 100,
+}
+
+type QuotaStatus struct {
+	Budget    int
+	Used      int
+	Remaining int
+	ResetAt   time.Time
+}
+
+// Quota returns the quota status of the provider or false if its quota is not tracked.
+func (r *Registry) Quota(p *Provider) (QuotaStatus, bool) {
+	quota, ok := r.quotas[p.сode]
+	if !ok {
+		return QuotaStatus{}, false
+	}
+	return QuotaStatus{
+		Budget:    quota.Budget(),
+		Used:      quota.Used(),
+		Remaining: quota.Remaining(),
+		ResetAt:   quota.ResetAt(),
+	}, true
+}
+
+func (r *Registry) throttle(p *Provider) (*throttle.Limiter, *throttle.Quota) {
+	return r.limiters[p.сode], r.quotas[p.сode]
+}
+
+// newYoutubeQuota tracks the default YouTube Data API quota reset at midnight Pacific Time.
+func newYoutubeQuota() *throttle.Quota {
+	location, err := time.LoadLocation(youtubeQuotaTimezone)
+	if err != nil {
+		location = time.FixedZone("PT", youtubeQuotaUTCOffset)
+	}
+	return throttle.NewQuota(youtubeDailyQuota, youtubeQuotaCosts, location)
+}
@@ -0,0 +1,71 @@
+package streamnx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_Quota(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default youtube quota", func(t *testing.T) {
+		registry, err := NewRegistry(ctx, Credentials{}, WithTranslator(&translatorMock{}))
+		require.NoError(t, err)
+
+		status, ok := registry.Quota(Youtube)
+		require.True(t, ok)
+		require.Equal(t, youtubeDailyQuota, status.Budget)
+		require.Equal(t, youtubeDailyQuota, status.Remaining)
+		require.True(t, status.ResetAt.After(time.Now()))
+
+		_, ok = registry.Quota(Spotify)
+		require.False(t, ok)
+	})
+
+	t.Run("youtube music shares youtube quota", func(t *testing.T) {
+		registry, err := NewRegistry(ctx, Credentials{}, WithTranslator(&translatorMock{}))
+		require.NoError(t, err)
+		require.Same(t, registry.quotas[Youtube.сode], registry.quotas[YoutubeMusic.сode])
+	})
+
+	t.Run("quota exhausted", func(t *testing.T) {
+		requests := 0
+		apiServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			response := `{"items": [{"id": "sampleID", "snippet": {"title": "artist - first"}}]}`
+			if r.URL.Path == "/youtube/v3/search" {
+				response = `{"items": [{"id": {"videoId": "sampleID"}}]}`
+			}
+			_, err := w.Write([]byte(response))
+			require.NoError(t, err)
+		}))
+		defer apiServerMock.Close()
+
+		registry, err := NewRegistry(
+			ctx,
+			Credentials{},
+			WithTranslator(&translatorMock{}),
+			WithYoutubeAPIURL(apiServerMock.URL),
+			WithQuota(Youtube, 150, youtubeQuotaCosts, nil),
+		)
+		require.NoError(t, err)
+
+		entity, err := registry.Search(ctx, Youtube, Track, "artist", "first")
+		require.NoError(t, err)
+		require.Equal(t, "sampleID", entity.ID)
+
+		_, err = registry.Search(ctx, Youtube, Track, "artist", "second")
+		require.ErrorIs(t, err, QuotaExceededError)
+		require.Equal(t, 2, requests)
+
+		status, ok := registry.Quota(Youtube)
+		require.True(t, ok)
+		require.Equal(t, 101, status.Used)
+		require.Equal(t, 49, status.Remaining)
+	})
+}
@@ -0,0 +1,153 @@
+package streamnx
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/translator"
+)
+
+const (
+	searchCandidatesLimit = 5
+
+	titleWeight    = 0.5
+	artistWeight   = 0.35
+	durationWeight = 0.15
+
+	durationTolerance = 2 * time.Second
+	maxDurationDelta  = 30 * time.Second
+)
+
+var (
+	featuringRe     = regexp.MustCompile(`\s+(feat\.?|ft\.?|featuring)\s.*$`)
+	versionSuffixRe = regexp.MustCompile(`\s+-\s+.*\b(remaster|remastered|version|edit|live|mono|stereo)\b.*$`)
+)
+
+// rankCandidates returns a copy of the candidate with the highest confidence for the source entity.
+// Candidates with equal confidence keep the provider's relevance order.
+func rankCandidates(source *Entity, et EntityType, candidates []*Entity) *Entity {
+	var best *Entity
+	bestConfidence := -1.0
+	for _, candidate := range candidates {
+		c := confidence(source, et, candidate)
+		if c > bestConfidence {
+			best, bestConfidence = candidate, c
+		}
+	}
+	if best == nil {
+		return nil
+	}
+
+	ranked := *best
+	ranked.Confidence = bestConfidence
+	return &ranked
+}
+
+// confidence scores the candidate by normalized title and artist similarity and by duration delta.
+// Candidates of another entity type get zero confidence.
+func confidence(source *Entity, et EntityType, candidate *Entity) float64 {
+	if candidate.Type != "" && candidate.Type != et {
+		return 0
+	}
+
+	artist := artistSimilarity(source, candidate)
+	if et == Artist {
+		return artist
+	}
+
+	title := similarity(normalizeTitle(source.Title), normalizeTitle(candidate.Title))
+	score := titleWeight*title + artistWeight*artist
+	if source.Duration == 0 || candidate.Duration == 0 {
+		return score / (titleWeight + artistWeight)
+	}
+	return score + durationWeight*durationSimilarity(source.Duration, candidate.Duration)
+}
+
+func artistSimilarity(source, candidate *Entity) float64 {
+	best := 0.0
+	for _, sourceArtist := range entityArtists(source) {
+		for _, candidateArtist := range entityArtists(candidate) {
+			best = max(best, similarity(normalizeName(sourceArtist), normalizeName(candidateArtist)))
+		}
+	}
+	return best
+}
+
+func entityArtists(e *Entity) []string {
+	if len(e.Artists) > 0 {
+		return e.Artists
+	}
+	return []string{e.Artist}
+}
+
+func durationSimilarity(a, b time.Duration) float64 {
+	delta := a - b
+	if delta < 0 {
+		delta = -delta
+	}
+	switch {
+	case delta <= durationTolerance:
+		return 1
+	case delta >= maxDurationDelta:
+		return 0
+	default:
+		return 1 - float64(delta-durationTolerance)/float64(maxDurationDelta-durationTolerance)
+	}
+}
+
+func normalizeTitle(title string) string {
+	title = strings.ToLower(title)
+	title = nonTitleContentRe.ReplaceAllString(title, "")
+	title = featuringRe.ReplaceAllString(title, "")
+	title = versionSuffixRe.ReplaceAllString(title, "")
+	return normalizeName(title)
+}
+
+// normalizeName lowercases and transliterates the name to latin and drops punctuation.
+func normalizeName(name string) string {
+	name = translator.TranslitCyrToLat(strings.ToLower(name))
+	name = strings.ReplaceAll(name, "&", " and ")
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return ' '
+	}, name)
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// similarity returns the Levenshtein similarity ratio of two strings in [0, 1].
+func similarity(a, b string) float64 {
+	if a == b {
+		if a == "" {
+			return 0
+		}
+		return 1
+	}
+
+	ar, br := []rune(a), []rune(b)
+	longest := max(len(ar), len(br))
+	return 1 - float64(levenshtein(ar, br))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,192 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type candidateAdapterMock struct {
+	adapterMock
+	searchTrackCandidates map[string]map[string][]*Entity
+	searchAlbumCandidates map[string]map[string][]*Entity
+}
+
+func (a *candidateAdapterMock) SearchTrackCandidates(_ context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	candidates, ok := a.searchTrackCandidates[artistName][trackName]
+	if !ok {
+		return nil, EntityNotFoundError
+	}
+	return candidates[:min(limit, len(candidates))], nil
+}
+
+func (a *candidateAdapterMock) SearchAlbumCandidates(_ context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	candidates, ok := a.searchAlbumCandidates[artistName][albumName]
+	if !ok {
+		return nil, EntityNotFoundError
+	}
+	return candidates[:min(limit, len(candidates))], nil
+}
+
+func TestRegistry_MatchCandidates(t *testing.T) {
+	source := &Entity{Type: Track, Artist: "Radiohead", Title: "Creep", Duration: 238 * time.Second}
+
+	tests := []struct {
+		name          string
+		candidates    []*Entity
+		minConfidence float64
+		wantID        string
+		wantErr       error
+	}{
+		{
+			name: "best candidate chosen over the first one",
+			candidates: []*Entity{
+				{ID: "cover", Type: Track, Artist: "Postmodern Jukebox", Title: "Creep", Duration: 270 * time.Second},
+				{ID: "live", Type: Track, Artist: "Radiohead", Title: "Creep - Live", Duration: 250 * time.Second},
+				{ID: "original", Type: Track, Artist: "Radiohead", Title: "Creep", Duration: 239 * time.Second},
+			},
+			wantID: "original",
+		},
+		{
+			name: "candidates of another type ignored",
+			candidates: []*Entity{
+				{ID: "album", Type: Album, Artist: "Radiohead", Title: "Creep"},
+				{ID: "track", Type: Track, Artist: "Radiohead", Title: "Creep (Remastered)"},
+			},
+			wantID: "track",
+		},
+		{
+			name: "best candidate below min confidence",
+			candidates: []*Entity{
+				{ID: "cover", Type: Track, Artist: "Postmodern Jukebox", Title: "Creep", Duration: 270 * time.Second},
+			},
+			minConfidence: 0.8,
+			wantErr:       EntityNotFoundError,
+		},
+		{
+			name:    "no candidates",
+			wantErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mock := candidateAdapterMock{}
+			if tt.candidates != nil {
+				mock.searchTrackCandidates = map[string]map[string][]*Entity{
+					"Radiohead": {"Creep": tt.candidates},
+				}
+			}
+
+			registry, err := NewRegistry(
+				ctx,
+				Credentials{},
+				WithTranslator(&translatorMock{}),
+				WithProviderAdapter(Apple, &adapterMock{}),
+				WithProviderAdapter(Spotify, &mock),
+				WithProviderAdapter(Yandex, &adapterMock{}),
+				WithProviderAdapter(Youtube, &adapterMock{}),
+				WithProviderAdapter(Deezer, &adapterMock{}),
+				WithProviderAdapter(SoundCloud, &adapterMock{}),
+				WithProviderAdapter(Tidal, &adapterMock{}),
+				WithProviderAdapter(Bandcamp, &adapterMock{}),
+				WithProviderAdapter(Amazon, &adapterMock{}),
+				WithProviderAdapter(VK, &adapterMock{}),
+				WithProviderAdapter(Zvuk, &adapterMock{}),
+				WithProviderAdapter(YoutubeMusic, &adapterMock{}),
+				WithMinConfidence(tt.minConfidence),
+			)
+			require.NoError(t, err)
+
+			result, err := registry.Match(ctx, Spotify, source)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantID, result.ID)
+			require.Greater(t, result.Confidence, 0.9)
+			require.Zero(t, tt.candidates[0].Confidence)
+		})
+	}
+}
+
+func Test_confidence(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    *Entity
+		candidate *Entity
+		et        EntityType
+		want      float64
+	}{
+		{
+			name:      "identical",
+			source:    &Entity{Artist: "Zemfira", Title: "Iskala", Duration: 3 * time.Minute},
+			candidate: &Entity{Type: Track, Artist: "Zemfira", Title: "Iskala", Duration: 3*time.Minute + time.Second},
+			et:        Track,
+			want:      1,
+		},
+		{
+			name:      "cyrillic transliterated",
+			source:    &Entity{Artist: "Zemfira", Title: "Iskala"},
+			candidate: &Entity{Type: Track, Artist: "Земфира", Title: "Искала"},
+			et:        Track,
+			want:      1,
+		},
+		{
+			name:      "featuring and brackets ignored",
+			source:    &Entity{Artist: "Daft Punk", Title: "Get Lucky"},
+			candidate: &Entity{Type: Track, Artists: []string{"Daft Punk", "Pharrell Williams"}, Title: "Get Lucky (feat. Pharrell Williams)"},
+			et:        Track,
+			want:      1,
+		},
+		{
+			name:      "duration beyond max delta",
+			source:    &Entity{Artist: "artist", Title: "title", Duration: 3 * time.Minute},
+			candidate: &Entity{Type: Track, Artist: "artist", Title: "title", Duration: 4 * time.Minute},
+			et:        Track,
+			want:      titleWeight + artistWeight,
+		},
+		{
+			name:      "another type",
+			source:    &Entity{Artist: "artist", Title: "title"},
+			candidate: &Entity{Type: Album, Artist: "artist", Title: "title"},
+			et:        Track,
+			want:      0,
+		},
+		{
+			name:      "artist",
+			source:    &Entity{Artist: "Beyonce", Title: "Beyonce"},
+			candidate: &Entity{Type: Artist, Artist: "Beyoncé", Title: "Beyoncé"},
+			et:        Artist,
+			want:      1 - 1.0/7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.InDelta(t, tt.want, confidence(tt.source, tt.et, tt.candidate), 0.0001)
+		})
+	}
+}
+
+func Test_normalizeTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Creep", want: "creep"},
+		{title: "Hey Jude - Remastered 2015", want: "hey jude"},
+		{title: "Get Lucky (feat. Pharrell Williams) [Radio Edit]", want: "get lucky"},
+		{title: "Lose Yourself ft. Eminem", want: "lose yourself"},
+		{title: "Simon & Garfunkel", want: "simon and garfunkel"},
+		{title: "Хочешь?", want: "hochesh"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			require.Equal(t, tt.want, normalizeTitle(tt.title))
+		})
+	}
+}
@@ -3,64 +3,205 @@ package streamnx
 import (
 	"context"
 	"errors"
-	"fmt"
+	"time"
 
+	"github.com/GeorgeGorbanev/streamnx/internal/amazon"
 	"github.com/GeorgeGorbanev/streamnx/internal/apple"
+	"github.com/GeorgeGorbanev/streamnx/internal/bandcamp"
+	"github.com/GeorgeGorbanev/streamnx/internal/deezer"
+	"github.com/GeorgeGorbanev/streamnx/internal/soundcloud"
 	"github.com/GeorgeGorbanev/streamnx/internal/spotify"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+	"github.com/GeorgeGorbanev/streamnx/internal/tidal"
 	"github.com/GeorgeGorbanev/streamnx/internal/translator"
+	"github.com/GeorgeGorbanev/streamnx/internal/vk"
 	"github.com/GeorgeGorbanev/streamnx/internal/yandex"
 	"github.com/GeorgeGorbanev/streamnx/internal/youtube"
+	"github.com/GeorgeGorbanev/streamnx/internal/zvuk"
+)
+
+const (
+	defaultTranslationCacheSize = 1000
+	defaultTranslationCacheTTL  = 24 * time.Hour
 )
 
 var (
 	InvalidProviderError   = errors.New("invalid provider")
 	InvalidEntityTypeError = errors.New("invalid entity type")
 	EntityNotFoundError    = errors.New("entity not found")
+	QuotaExceededError     = throttle.QuotaExceededError
 )
 
 type Registry struct {
-	adapters      map[string]Adapter
-	clientOptions clientOptions
-	translator    translator.Translator
+	adapters            map[string]Adapter
+	clientOptions       clientOptions
+	translator          translator.Translator
+	noTranslator        bool
+	credentialsRequired bool
+	dictionary          *translator.Dictionary
+	chainOptions        []translator.ChainOption
+	translations        *translator.Chain
+	minConfidence       float64
+	cache               *registryCache
+	limiters            map[string]*throttle.Limiter
+	quotas              map[string]*throttle.Quota
+	resolver            *linkResolver
+	disabled            map[string]bool
+	bandcampDomains     bandcamp.Domains
+	thirdPartyProviders []*Provider
+	err                 error
 }
 
-func NewRegistry(ctx context.Context, cred Credentials, opts ...RegistryOption) (*Registry, error) {
+func NewRegistry(_ context.Context, cred Credentials, opts ...RegistryOption) (*Registry, error) {
 	registry := Registry{
-		adapters: map[string]Adapter{},
+		adapters:   map[string]Adapter{},
+		resolver:   newLinkResolver(),
+		dictionary: translator.DefaultDictionary(),
+		chainOptions: []translator.ChainOption{
+			translator.WithCache(defaultTranslationCacheSize, defaultTranslationCacheTTL),
+		},
+		disabled: map[string]bool{},
+		limiters: map[string]*throttle.Limiter{},
+		quotas: map[string]*throttle.Quota{
+			Youtube.сode: newYoutubeQuota(),
+		},
 	}
 	for _, opt := range opts {
 		opt(&registry)
 	}
-
-	if registry.translator == nil {
-		translatorClient, err := translator.NewGoogleClient(ctx, cred.google())
-		if err != nil {
-			return nil, fmt.Errorf("failed to create google translator client: %w", err)
+	if registry.err != nil {
+		return nil, registry.err
+	}
+	for code := range registry.disabled {
+		delete(registry.adapters, code)
+	}
+	if registry.credentialsRequired {
+		for _, provider := range Providers {
+			if registry.adapter(provider) == nil && !cred.provided(provider) {
+				registry.disabled[provider.сode] = true
+			}
 		}
-		registry.translator = translatorClient
 	}
+	// YouTube Music is served by the same Data API key, so both providers spend one quota.
+	if _, ok := registry.quotas[YoutubeMusic.сode]; !ok {
+		registry.quotas[YoutubeMusic.сode] = registry.quotas[Youtube.сode]
+	}
+
+	if registry.noTranslator {
+		registry.translator = nil
+	} else if registry.translator == nil && cred.GoogleTranslatorAPIKeyJSON != "" {
+		google := cred.google()
+		registry.translator = translator.NewLazyClient(func(ctx context.Context) (translator.Translator, error) {
+			return translator.NewGoogleClient(ctx, google)
+		})
+	}
+	// Names known to the dictionary are matched without calling the translator.
+	backends := []translator.Backend{}
+	if registry.dictionary != nil {
+		backends = append(backends, translator.Backend{Name: DictionaryBackend, Translator: registry.dictionary})
+	}
+	if registry.translator != nil {
+		backends = append(backends, translator.Backend{Name: TranslatorBackend, Translator: registry.translator})
+	}
+	registry.translations = translator.NewChain(backends, registry.chainOptions...)
 
-	if registry.adapter(Apple) == nil {
-		client := apple.NewHTTPClient(registry.clientOptions.apple...)
+	if registry.needsAdapter(Apple) {
+		opts := append(registry.clientOptions.apple, apple.WithThrottle(registry.throttle(Apple)))
+		client := apple.NewHTTPClient(opts...)
 		registry.adapters[Apple.сode] = newAppleAdapter(client)
 	}
-	if registry.adapter(Spotify) == nil {
-		client := spotify.NewHTTPClient(cred.spotify(), registry.clientOptions.spotify...)
+	if registry.needsAdapter(Spotify) {
+		opts := append(registry.clientOptions.spotify, spotify.WithThrottle(registry.throttle(Spotify)))
+		client := spotify.NewHTTPClient(cred.spotify(), opts...)
 		registry.adapters[Spotify.сode] = newSpotifyAdapter(client)
 	}
-	if registry.adapter(Yandex) == nil {
-		client := yandex.NewHTTPClient(registry.clientOptions.yandex...)
-		registry.adapters[Yandex.сode] = newYandexAdapter(client, registry.translator)
+	if registry.needsAdapter(Yandex) {
+		opts := append(registry.clientOptions.yandex, yandex.WithThrottle(registry.throttle(Yandex)))
+		client := yandex.NewHTTPClient(opts...)
+		registry.adapters[Yandex.сode] = newYandexAdapter(client, registry.translations)
 	}
-	if registry.adapter(Youtube) == nil {
-		client := youtube.NewHTTPClient(cred.YoutubeAPIKey, registry.clientOptions.youtube...)
+	if registry.needsAdapter(Youtube) {
+		opts := append(registry.clientOptions.youtube, youtube.WithThrottle(registry.throttle(Youtube)))
+		client := youtube.NewHTTPClient(cred.YoutubeAPIKey, opts...)
 		registry.adapters[Youtube.сode] = newYoutubeAdapter(client)
 	}
+	if registry.needsAdapter(Deezer) {
+		opts := append(registry.clientOptions.deezer, deezer.WithThrottle(registry.throttle(Deezer)))
+		client := deezer.NewHTTPClient(opts...)
+		registry.adapters[Deezer.сode] = newDeezerAdapter(client)
+	}
+	if registry.needsAdapter(SoundCloud) {
+		opts := append(registry.clientOptions.soundcloud, soundcloud.WithThrottle(registry.throttle(SoundCloud)))
+		client := soundcloud.NewHTTPClient(cred.SoundCloudClientID, opts...)
+		registry.adapters[SoundCloud.сode] = newSoundCloudAdapter(client)
+	}
+	if registry.needsAdapter(Tidal) {
+		opts := append(registry.clientOptions.tidal, tidal.WithThrottle(registry.throttle(Tidal)))
+		client := tidal.NewHTTPClient(cred.tidal(), opts...)
+		registry.adapters[Tidal.сode] = newTidalAdapter(client)
+	}
+	if registry.needsAdapter(Bandcamp) {
+		opts := append(registry.clientOptions.bandcamp, bandcamp.WithThrottle(registry.throttle(Bandcamp)))
+		client := bandcamp.NewHTTPClient(opts...)
+		registry.adapters[Bandcamp.сode] = newBandcampAdapter(client)
+	}
+	if registry.needsAdapter(Amazon) {
+		opts := append(registry.clientOptions.amazon, amazon.WithThrottle(registry.throttle(Amazon)))
+		client := amazon.NewHTTPClient(cred.amazon(), opts...)
+		registry.adapters[Amazon.сode] = newAmazonAdapter(client)
+	}
+	if registry.needsAdapter(VK) {
+		opts := append(registry.clientOptions.vk, vk.WithThrottle(registry.throttle(VK)))
+		client := vk.NewHTTPClient(cred.VKAccessToken, opts...)
+		registry.adapters[VK.сode] = newVKAdapter(client, registry.translations)
+	}
+	if registry.needsAdapter(Zvuk) {
+		opts := append(registry.clientOptions.zvuk, zvuk.WithThrottle(registry.throttle(Zvuk)))
+		client := zvuk.NewHTTPClient(opts...)
+		registry.adapters[Zvuk.сode] = newZvukAdapter(client, registry.translations)
+	}
+	if registry.needsAdapter(YoutubeMusic) {
+		opts := append([]youtube.ClientOption{}, registry.clientOptions.youtube...)
+		opts = append(opts, registry.clientOptions.youtubeMusic...)
+		opts = append(opts, youtube.WithThrottle(registry.throttle(YoutubeMusic)))
+		client := youtube.NewHTTPClient(cred.YoutubeAPIKey, opts...)
+		registry.adapters[YoutubeMusic.сode] = newYoutubeMusicAdapter(client)
+	}
 
 	return &registry, nil
 }
 
+// Providers returns every provider known to the registry, the disabled ones included: the built-in
+// providers, the ones registered with RegisterProvider and the ones added with WithProvider.
+func (r *Registry) Providers() []*Provider {
+	return r.knownProviders()
+}
+
+// EnabledProviders returns the providers served by an adapter of the registry.
+func (r *Registry) EnabledProviders() []*Provider {
+	providers := []*Provider{}
+	for _, provider := range r.knownProviders() {
+		if r.adapter(provider) != nil {
+			providers = append(providers, provider)
+		}
+	}
+	return providers
+}
+
+// FindProviderByCode finds the provider among the ones enabled in the registry.
+func (r *Registry) FindProviderByCode(code string) *Provider {
+	for _, provider := range r.EnabledProviders() {
+		if provider.сode == code {
+			return provider
+		}
+	}
+	return nil
+}
+
 func (r *Registry) Close() error {
+	if r.translator == nil {
+		return nil
+	}
 	return r.translator.Close()
 }
 
@@ -70,14 +211,21 @@ func (r *Registry) Fetch(ctx context.Context, p *Provider, et EntityType, id str
 		return nil, InvalidProviderError
 	}
 
-	switch et {
-	case Track:
-		return adapter.FetchTrack(ctx, id)
-	case Album:
-		return adapter.FetchAlbum(ctx, id)
-	default:
-		return nil, InvalidEntityTypeError
-	}
+	entity, err := cached(r.cache, cacheKey("fetch", p.сode, string(et), id), func() (*Entity, error) {
+		switch et {
+		case Track:
+			return adapter.FetchTrack(ctx, id)
+		case Album:
+			return adapter.FetchAlbum(ctx, id)
+		case Artist:
+			return adapter.FetchArtist(ctx, id)
+		case Playlist:
+			return adapter.FetchPlaylist(ctx, id)
+		default:
+			return nil, InvalidEntityTypeError
+		}
+	})
+	return entity, providerError(p, err)
 }
 
 func (r *Registry) Search(ctx context.Context, p *Provider, et EntityType, artist, name string) (*Entity, error) {
@@ -86,16 +234,110 @@ func (r *Registry) Search(ctx context.Context, p *Provider, et EntityType, artis
 		return nil, InvalidProviderError
 	}
 
-	switch et {
-	case Track:
-		return adapter.SearchTrack(ctx, artist, name)
-	case Album:
-		return adapter.SearchAlbum(ctx, artist, name)
+	key := cacheKey("search", p.сode, string(et), normalizeQuery(artist), normalizeQuery(name))
+	entity, err := cached(r.cache, key, func() (*Entity, error) {
+		switch et {
+		case Track:
+			return adapter.SearchTrack(ctx, artist, name)
+		case Album:
+			return adapter.SearchAlbum(ctx, artist, name)
+		case Artist:
+			return adapter.SearchArtist(ctx, artist)
+		default:
+			return nil, InvalidEntityTypeError
+		}
+	})
+	return entity, providerError(p, err)
+}
+
+// Match looks up the source entity on the provider, trying exact identifiers (ISRC for tracks,
+// UPC for albums) first and falling back to the text search by artist and title. Text search
+// candidates are ranked by confidence and EntityNotFoundError is returned when the best one
+// is below the minimum confidence set with WithMinConfidence.
+func (r *Registry) Match(ctx context.Context, p *Provider, source *Entity) (*Entity, error) {
+	return r.match(ctx, p, source.Type, source)
+}
+
+func (r *Registry) match(ctx context.Context, p *Provider, et EntityType, source *Entity) (*Entity, error) {
+	adapter := r.adapter(p)
+	if adapter == nil {
+		return nil, InvalidProviderError
+	}
+
+	if searcher, ok := adapter.(IdentifierSearcher); ok {
+		entity, err := r.searchByIdentifier(ctx, p, searcher, et, source)
+		if err == nil {
+			exact := *entity
+			exact.Confidence = 1
+			return &exact, nil
+		}
+		if !errors.Is(err, EntityNotFoundError) {
+			return nil, providerError(p, err)
+		}
+	}
+
+	candidates, err := r.searchCandidates(ctx, p, et, source)
+	if err != nil {
+		return nil, err
+	}
+
+	best := rankCandidates(source, et, candidates)
+	if best == nil || best.Confidence < r.minConfidence {
+		return nil, EntityNotFoundError
+	}
+	return best, nil
+}
+
+func (r *Registry) searchCandidates(ctx context.Context, p *Provider, et EntityType, source *Entity) ([]*Entity, error) {
+	if searcher, ok := r.adapter(p).(CandidateSearcher); ok && (et == Track || et == Album) {
+		key := cacheKey("candidates", p.сode, string(et), normalizeQuery(source.Artist), normalizeQuery(source.Title))
+		candidates, err := cached(r.cache, key, func() ([]*Entity, error) {
+			if et == Track {
+				return searcher.SearchTrackCandidates(ctx, source.Artist, source.Title, searchCandidatesLimit)
+			}
+			return searcher.SearchAlbumCandidates(ctx, source.Artist, source.Title, searchCandidatesLimit)
+		})
+		return candidates, providerError(p, err)
+	}
+
+	entity, err := r.Search(ctx, p, et, source.Artist, source.Title)
+	if err != nil {
+		return nil, err
+	}
+	return []*Entity{entity}, nil
+}
+
+func (r *Registry) searchByIdentifier(ctx context.Context, p *Provider, searcher IdentifierSearcher, et EntityType, source *Entity) (*Entity, error) {
+	switch {
+	case et == Track && source.ISRC != "":
+		return cached(r.cache, cacheKey("isrc", p.сode, source.ISRC), func() (*Entity, error) {
+			return searcher.SearchTrackByISRC(ctx, source.ISRC)
+		})
+	case et == Album && source.UPC != "":
+		return cached(r.cache, cacheKey("upc", p.сode, source.UPC), func() (*Entity, error) {
+			return searcher.SearchAlbumByUPC(ctx, source.UPC)
+		})
 	default:
-		return nil, InvalidEntityTypeError
+		return nil, EntityNotFoundError
 	}
 }
 
+func (r *Registry) knownProviders() []*Provider {
+	return append(registeredProviders(), r.thirdPartyProviders...)
+}
+
 func (r *Registry) adapter(p *Provider) Adapter {
 	return r.adapters[p.сode]
 }
+
+func (r *Registry) needsAdapter(p *Provider) bool {
+	return !r.disabled[p.сode] && r.adapter(p) == nil
+}
+
+// artistDictionary returns the dictionary to add names to, a new one when it was left out.
+func (r *Registry) artistDictionary() *translator.Dictionary {
+	if r.dictionary == nil {
+		r.dictionary = translator.NewDictionary()
+	}
+	return r.dictionary
+}
@@ -1,22 +1,44 @@
 package streamnx
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"time"
 
+	"github.com/GeorgeGorbanev/streamnx/internal/amazon"
 	"github.com/GeorgeGorbanev/streamnx/internal/apple"
+	"github.com/GeorgeGorbanev/streamnx/internal/bandcamp"
+	"github.com/GeorgeGorbanev/streamnx/internal/deezer"
+	"github.com/GeorgeGorbanev/streamnx/internal/retry"
+	"github.com/GeorgeGorbanev/streamnx/internal/soundcloud"
 	"github.com/GeorgeGorbanev/streamnx/internal/spotify"
+	"github.com/GeorgeGorbanev/streamnx/internal/throttle"
+	"github.com/GeorgeGorbanev/streamnx/internal/tidal"
 	"github.com/GeorgeGorbanev/streamnx/internal/translator"
+	"github.com/GeorgeGorbanev/streamnx/internal/vk"
 	"github.com/GeorgeGorbanev/streamnx/internal/yandex"
 	"github.com/GeorgeGorbanev/streamnx/internal/youtube"
+	"github.com/GeorgeGorbanev/streamnx/internal/zvuk"
 )
 
 type RegistryOption func(registry *Registry)
 
 type clientOptions struct {
-	apple   []apple.ClientOption
-	spotify []spotify.ClientOption
-	yandex  []yandex.ClientOption
-	youtube []youtube.ClientOption
+	apple      []apple.ClientOption
+	spotify    []spotify.ClientOption
+	yandex     []yandex.ClientOption
+	youtube    []youtube.ClientOption
+	deezer     []deezer.ClientOption
+	soundcloud []soundcloud.ClientOption
+	tidal      []tidal.ClientOption
+	bandcamp   []bandcamp.ClientOption
+	amazon     []amazon.ClientOption
+	vk         []vk.ClientOption
+	zvuk       []zvuk.ClientOption
+
+	// youtubeMusic is applied on top of youtube since both providers share the Data API.
+	youtubeMusic []youtube.ClientOption
 }
 
 func WithProviderAdapter(provider *Provider, adapter Adapter) RegistryOption {
@@ -25,12 +47,177 @@ func WithProviderAdapter(provider *Provider, adapter Adapter) RegistryOption {
 	}
 }
 
+// WithProvider adds the provider of a service not built into the library to the registry along
+// with the adapter serving it. The provider is known to the registry only, the package-level
+// ParseLink and FindProviderByCode don't recognize it. NewRegistry fails when another provider
+// has the same code.
+func WithProvider(provider *Provider, adapter Adapter) RegistryOption {
+	return func(r *Registry) {
+		registered, err := findRegistered(provider, registeredProviders(), r.thirdPartyProviders)
+		if err != nil {
+			r.err = errors.Join(r.err, err)
+			return
+		}
+		if !registered {
+			r.thirdPartyProviders = append(r.thirdPartyProviders, provider)
+		}
+		r.adapters[provider.сode] = adapter
+	}
+}
+
+// WithProvidersDisabled leaves the providers out of the registry: their clients are not
+// constructed and their links are not recognized by the registry.
+func WithProvidersDisabled(providers ...*Provider) RegistryOption {
+	return func(r *Registry) {
+		for _, provider := range providers {
+			r.disabled[provider.сode] = true
+		}
+	}
+}
+
+// WithCredentialsRequired disables the providers whose credentials are missing instead of
+// constructing their clients with empty credentials, unless an adapter is given for them.
+func WithCredentialsRequired() RegistryOption {
+	return func(r *Registry) {
+		r.credentialsRequired = true
+	}
+}
+
 func WithTranslator(translator translator.Translator) RegistryOption {
 	return func(r *Registry) {
 		r.translator = translator
 	}
 }
 
+// WithoutTranslator makes matching of Cyrillic artist names rely on transliteration and
+// the artist dictionary only, even when the Google Translator credentials are given.
+func WithoutTranslator() RegistryOption {
+	return func(r *Registry) {
+		r.noTranslator = true
+	}
+}
+
+// WithArtistDictionary adds artist names to the dictionary consulted before the translator.
+// Every line holds an English and a Russian name separated by a tab; empty lines and lines
+// starting with # are skipped.
+func WithArtistDictionary(reader io.Reader) RegistryOption {
+	return func(r *Registry) {
+		r.err = errors.Join(r.err, r.artistDictionary().Load(reader))
+	}
+}
+
+// WithArtistDictionaryFile adds artist names from the file in the WithArtistDictionary format.
+func WithArtistDictionaryFile(path string) RegistryOption {
+	return func(r *Registry) {
+		r.err = errors.Join(r.err, r.artistDictionary().LoadFile(path))
+	}
+}
+
+// WithTranslationCache memoizes up to size artist name translations for ttl, so repeated
+// artists are matched without calling the translator. A size of zero turns the cache off.
+func WithTranslationCache(size int, ttl time.Duration) RegistryOption {
+	return func(r *Registry) {
+		r.chainOptions = nil
+		if size > 0 {
+			r.chainOptions = append(r.chainOptions, translator.WithCache(size, ttl))
+		}
+	}
+}
+
+// WithoutArtistDictionary leaves out the built-in artist names as well as the names added
+// by the preceding options, so every name is translated by the translator.
+func WithoutArtistDictionary() RegistryOption {
+	return func(r *Registry) {
+		r.dictionary = nil
+	}
+}
+
+// WithMinConfidence sets the minimum confidence in [0, 1] of text search matches;
+// weaker matches are reported as EntityNotFoundError.
+func WithMinConfidence(confidence float64) RegistryOption {
+	return func(r *Registry) {
+		r.minConfidence = confidence
+	}
+}
+
+// WithCache caches fetch and search results in the store for ttl and remembers
+// EntityNotFoundError for notFoundTTL; a zero notFoundTTL disables negative caching.
+func WithCache(store Cache, ttl, notFoundTTL time.Duration) RegistryOption {
+	return func(r *Registry) {
+		r.cache = &registryCache{
+			store:       store,
+			ttl:         ttl,
+			notFoundTTL: notFoundTTL,
+		}
+	}
+}
+
+// WithRetryPolicy configures retries of idempotent requests failed with network errors,
+// 429 or 5xx statuses in every client. Delays grow exponentially from baseDelay up to maxDelay
+// unless the service sends Retry-After.
+func WithRetryPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) RegistryOption {
+	return withRetryPolicy(retry.Policy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   baseDelay,
+		MaxDelay:    maxDelay,
+	})
+}
+
+// WithRetryDisabled makes every client fail on the first unsuccessful request.
+func WithRetryDisabled() RegistryOption {
+	return withRetryPolicy(retry.Policy{MaxAttempts: 1})
+}
+
+func withRetryPolicy(policy retry.Policy) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.apple = append(r.clientOptions.apple, apple.WithRetryPolicy(policy))
+		r.clientOptions.spotify = append(r.clientOptions.spotify, spotify.WithRetryPolicy(policy))
+		r.clientOptions.yandex = append(r.clientOptions.yandex, yandex.WithRetryPolicy(policy))
+		r.clientOptions.youtube = append(r.clientOptions.youtube, youtube.WithRetryPolicy(policy))
+		r.clientOptions.deezer = append(r.clientOptions.deezer, deezer.WithRetryPolicy(policy))
+		r.clientOptions.soundcloud = append(r.clientOptions.soundcloud, soundcloud.WithRetryPolicy(policy))
+		r.clientOptions.tidal = append(r.clientOptions.tidal, tidal.WithRetryPolicy(policy))
+		r.clientOptions.bandcamp = append(r.clientOptions.bandcamp, bandcamp.WithRetryPolicy(policy))
+		r.clientOptions.amazon = append(r.clientOptions.amazon, amazon.WithRetryPolicy(policy))
+		r.clientOptions.vk = append(r.clientOptions.vk, vk.WithRetryPolicy(policy))
+		r.clientOptions.zvuk = append(r.clientOptions.zvuk, zvuk.WithRetryPolicy(policy))
+	}
+}
+
+// WithRateLimit limits requests to the provider to requestsPerSecond with bursts up to burst requests.
+func WithRateLimit(provider *Provider, requestsPerSecond float64, burst int) RegistryOption {
+	return func(r *Registry) {
+		r.limiters[provider.сode] = throttle.NewLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithQuota limits the units spent on the provider per day. Costs are keyed by API path,
+// every other endpoint costs one unit. The budget resets at midnight in resetLocation or UTC if nil.
+// It replaces the default YouTube Data API quota.
+func WithQuota(provider *Provider, dailyBudget int, costs map[string]int, resetLocation *time.Location) RegistryOption {
+	return func(r *Registry) {
+		r.quotas[provider.сode] = throttle.NewQuota(dailyBudget, costs, resetLocation)
+	}
+}
+
+// WithResolveMaxHops limits the number of redirects ResolveLink follows.
+func WithResolveMaxHops(hops int) RegistryOption {
+	return func(r *Registry) {
+		r.resolver.maxHops = hops
+	}
+}
+
+// WithBandcampCustomDomains detects links on custom domains of artists hosted by Bandcamp.
+// Domains are recognized by the links parsed by the registry only, not by the package ParseLink.
+func WithBandcampCustomDomains(domains ...string) RegistryOption {
+	return func(r *Registry) {
+		if r.bandcampDomains == nil {
+			r.bandcampDomains = bandcamp.Domains{}
+		}
+		r.bandcampDomains.Add(domains...)
+	}
+}
+
 func WithAppleWebPlayerURL(url string) RegistryOption {
 	return func(r *Registry) {
 		r.clientOptions.apple = append(r.clientOptions.apple, apple.WithWebPlayerURL(url))
@@ -67,6 +254,61 @@ func WithYoutubeAPIURL(url string) RegistryOption {
 	}
 }
 
+// WithYoutubeMusicURL sets the YouTube Music site used to resolve album browse IDs.
+func WithYoutubeMusicURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.youtubeMusic = append(r.clientOptions.youtubeMusic, youtube.WithMusicURL(url))
+	}
+}
+
+func WithDeezerAPIURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.deezer = append(r.clientOptions.deezer, deezer.WithAPIURL(url))
+	}
+}
+
+func WithSoundCloudAPIURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.soundcloud = append(r.clientOptions.soundcloud, soundcloud.WithAPIURL(url))
+	}
+}
+
+func WithTidalAuthURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.tidal = append(r.clientOptions.tidal, tidal.WithAuthURL(url))
+	}
+}
+
+func WithTidalAPIURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.tidal = append(r.clientOptions.tidal, tidal.WithAPIURL(url))
+	}
+}
+
+func WithAmazonAuthURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.amazon = append(r.clientOptions.amazon, amazon.WithAuthURL(url))
+	}
+}
+
+func WithAmazonAPIURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.amazon = append(r.clientOptions.amazon, amazon.WithAPIURL(url))
+	}
+}
+
+func WithVKAPIURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.vk = append(r.clientOptions.vk, vk.WithAPIURL(url))
+	}
+}
+
+func WithZvukAPIURL(url string) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.zvuk = append(r.clientOptions.zvuk, zvuk.WithAPIURL(url))
+	}
+}
+
 func WithAppleHTTPTransport(transport *http.Transport) RegistryOption {
 	return func(r *Registry) {
 		r.clientOptions.apple = append(r.clientOptions.apple, apple.WithHTTPTransport(transport))
@@ -90,3 +332,57 @@ func WithYoutubeHTTPTransport(transport *http.Transport) RegistryOption {
 		r.clientOptions.youtube = append(r.clientOptions.youtube, youtube.WithHTTPTransport(transport))
 	}
 }
+
+func WithDeezerHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.deezer = append(r.clientOptions.deezer, deezer.WithHTTPTransport(transport))
+	}
+}
+
+func WithSoundCloudHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.soundcloud = append(r.clientOptions.soundcloud, soundcloud.WithHTTPTransport(transport))
+	}
+}
+
+func WithTidalHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.tidal = append(r.clientOptions.tidal, tidal.WithHTTPTransport(transport))
+	}
+}
+
+func WithBandcampHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.bandcamp = append(r.clientOptions.bandcamp, bandcamp.WithHTTPTransport(transport))
+	}
+}
+
+func WithAmazonHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.amazon = append(r.clientOptions.amazon, amazon.WithHTTPTransport(transport))
+	}
+}
+
+func WithVKHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.vk = append(r.clientOptions.vk, vk.WithHTTPTransport(transport))
+	}
+}
+
+func WithZvukHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.zvuk = append(r.clientOptions.zvuk, zvuk.WithHTTPTransport(transport))
+	}
+}
+
+func WithYoutubeMusicHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.clientOptions.youtubeMusic = append(r.clientOptions.youtubeMusic, youtube.WithHTTPTransport(transport))
+	}
+}
+
+func WithResolveHTTPTransport(transport *http.Transport) RegistryOption {
+	return func(r *Registry) {
+		r.resolver.httpClient.Transport = transport
+	}
+}
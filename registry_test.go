@@ -8,10 +8,16 @@ import (
 )
 
 type adapterMock struct {
-	fetchTrack  map[string]*Entity
-	searchTrack map[string]map[string]*Entity
-	fetchAlbum  map[string]*Entity
-	searchAlbum map[string]map[string]*Entity
+	fetchTrack    map[string]*Entity
+	searchTrack   map[string]map[string]*Entity
+	fetchAlbum    map[string]*Entity
+	searchAlbum   map[string]map[string]*Entity
+	fetchArtist   map[string]*Entity
+	searchArtist  map[string]*Entity
+	fetchPlaylist map[string]*Entity
+
+	searchTrackByISRC map[string]*Entity
+	searchAlbumByUPC  map[string]*Entity
 }
 
 func (a *adapterMock) FetchTrack(_ context.Context, id string) (*Entity, error) {
@@ -53,6 +59,46 @@ func (a *adapterMock) SearchAlbum(_ context.Context, artistName, albumName strin
 	return nil, EntityNotFoundError
 }
 
+func (a *adapterMock) FetchArtist(_ context.Context, id string) (*Entity, error) {
+	entity, ok := a.fetchArtist[id]
+	if !ok {
+		return nil, EntityNotFoundError
+	}
+	return entity, nil
+}
+
+func (a *adapterMock) SearchArtist(_ context.Context, artistName string) (*Entity, error) {
+	entity, ok := a.searchArtist[artistName]
+	if !ok {
+		return nil, EntityNotFoundError
+	}
+	return entity, nil
+}
+
+func (a *adapterMock) FetchPlaylist(_ context.Context, id string) (*Entity, error) {
+	entity, ok := a.fetchPlaylist[id]
+	if !ok {
+		return nil, EntityNotFoundError
+	}
+	return entity, nil
+}
+
+func (a *adapterMock) SearchTrackByISRC(_ context.Context, isrc string) (*Entity, error) {
+	entity, ok := a.searchTrackByISRC[isrc]
+	if !ok {
+		return nil, EntityNotFoundError
+	}
+	return entity, nil
+}
+
+func (a *adapterMock) SearchAlbumByUPC(_ context.Context, upc string) (*Entity, error) {
+	entity, ok := a.searchAlbumByUPC[upc]
+	if !ok {
+		return nil, EntityNotFoundError
+	}
+	return entity, nil
+}
+
 func TestRegistry_Fetch(t *testing.T) {
 	sampleProvider := Apple
 
@@ -97,6 +143,34 @@ func TestRegistry_Fetch(t *testing.T) {
 			},
 			want: &Entity{ID: "1"},
 		},
+		{
+			name: "artist found",
+			args: args{
+				p:  sampleProvider,
+				et: Artist,
+				id: "1",
+			},
+			adapterMock: adapterMock{
+				fetchArtist: map[string]*Entity{
+					"1": {ID: "1"},
+				},
+			},
+			want: &Entity{ID: "1"},
+		},
+		{
+			name: "playlist found",
+			args: args{
+				p:  sampleProvider,
+				et: Playlist,
+				id: "1",
+			},
+			adapterMock: adapterMock{
+				fetchPlaylist: map[string]*Entity{
+					"1": {ID: "1"},
+				},
+			},
+			want: &Entity{ID: "1"},
+		},
 		{
 			name: "track not found",
 			args: args{
@@ -218,6 +292,22 @@ func TestRegistry_Search(t *testing.T) {
 			},
 			want: &Entity{ID: "1"},
 		},
+		{
+			name: "artist found",
+			args: args{
+				p:      sampleProvider,
+				et:     Artist,
+				artist: "artist",
+			},
+			adapterMock: adapterMock{
+				searchArtist: map[string]*Entity{
+					"artist": {
+						ID: "1",
+					},
+				},
+			},
+			want: &Entity{ID: "1"},
+		},
 		{
 			name: "track not found",
 			args: args{
@@ -287,3 +377,193 @@ func TestRegistry_Search(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_Match(t *testing.T) {
+	sampleProvider := Apple
+
+	tests := []struct {
+		name          string
+		source        *Entity
+		adapterMock   adapterMock
+		minConfidence float64
+		want          *Entity
+		wantErr       error
+	}{
+		{
+			name:   "track matched by isrc",
+			source: &Entity{Type: Track, ISRC: "USUM71703861", Artist: "artist", Title: "Intro"},
+			adapterMock: adapterMock{
+				searchTrackByISRC: map[string]*Entity{
+					"USUM71703861": {ID: "byISRC"},
+				},
+				searchTrack: map[string]map[string]*Entity{
+					"artist": {"Intro": {ID: "byText"}},
+				},
+			},
+			want: &Entity{ID: "byISRC", Confidence: 1},
+		},
+		{
+			name:   "album matched by upc",
+			source: &Entity{Type: Album, UPC: "00602557382549", Artist: "artist", Title: "name"},
+			adapterMock: adapterMock{
+				searchAlbumByUPC: map[string]*Entity{
+					"00602557382549": {ID: "byUPC"},
+				},
+			},
+			want: &Entity{ID: "byUPC", Confidence: 1},
+		},
+		{
+			name:   "track falls back to text search when isrc not found",
+			source: &Entity{Type: Track, ISRC: "USUM71703861", Artist: "artist", Title: "Intro"},
+			adapterMock: adapterMock{
+				searchTrack: map[string]map[string]*Entity{
+					"artist": {"Intro": {ID: "byText", Artist: "artist", Title: "Intro"}},
+				},
+			},
+			want: &Entity{ID: "byText", Artist: "artist", Title: "Intro", Confidence: 1},
+		},
+		{
+			name:   "track without isrc matched by text search",
+			source: &Entity{Type: Track, Artist: "artist", Title: "Intro"},
+			adapterMock: adapterMock{
+				searchTrack: map[string]map[string]*Entity{
+					"artist": {"Intro": {ID: "byText", Artist: "artist", Title: "Intro"}},
+				},
+			},
+			want: &Entity{ID: "byText", Artist: "artist", Title: "Intro", Confidence: 1},
+		},
+		{
+			name:   "text match below min confidence",
+			source: &Entity{Type: Track, Artist: "artist", Title: "Intro"},
+			adapterMock: adapterMock{
+				searchTrack: map[string]map[string]*Entity{
+					"artist": {"Intro": {ID: "byText", Artist: "another artist", Title: "Outro"}},
+				},
+			},
+			minConfidence: 0.8,
+			wantErr:       EntityNotFoundError,
+		},
+		{
+			name:    "not found",
+			source:  &Entity{Type: Track, ISRC: "USUM71703861", Artist: "artist", Title: "Intro"},
+			wantErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			registry, err := NewRegistry(
+				ctx,
+				Credentials{},
+				WithTranslator(&translatorMock{}),
+				WithProviderAdapter(sampleProvider, &tt.adapterMock),
+				WithProviderAdapter(Spotify, &adapterMock{}),
+				WithProviderAdapter(Yandex, &adapterMock{}),
+				WithProviderAdapter(Youtube, &adapterMock{}),
+				WithMinConfidence(tt.minConfidence),
+			)
+			require.NoError(t, err)
+
+			result, err := registry.Match(ctx, sampleProvider, tt.source)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestRegistry_ProvidersDisabled(t *testing.T) {
+	ctx := context.Background()
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithProviderAdapter(Youtube, &adapterMock{}),
+		WithProvidersDisabled(Youtube, YoutubeMusic),
+	)
+	require.NoError(t, err)
+
+	require.Contains(t, registry.Providers(), Youtube)
+	require.NotContains(t, registry.EnabledProviders(), Youtube)
+	require.NotContains(t, registry.EnabledProviders(), YoutubeMusic)
+	require.Contains(t, registry.EnabledProviders(), Spotify)
+	require.Equal(t, []*Provider{Apple, Spotify, Yandex, Deezer, SoundCloud, Tidal, Bandcamp, Amazon, VK, Zvuk}, registry.EnabledProviders())
+
+	require.Nil(t, registry.FindProviderByCode(Youtube.Code()))
+	require.Equal(t, Spotify, registry.FindProviderByCode(Spotify.Code()))
+
+	_, err = registry.ParseLink("https://www.youtube.com/watch?v=dQw4w9WgXcQ")
+	require.ErrorIs(t, err, UnknownLinkError)
+	link, err := ParseLink("https://www.youtube.com/watch?v=dQw4w9WgXcQ")
+	require.NoError(t, err)
+	require.Equal(t, Youtube, link.Provider)
+
+	links := registry.ExtractLinks("https://youtu.be/dQw4w9WgXcQ https://open.spotify.com/track/7uv632EkfwYhXoqf8rhYrg")
+	require.Len(t, links, 1)
+	require.Equal(t, Spotify, links[0].Provider)
+
+	_, err = registry.Fetch(ctx, Youtube, Track, "dQw4w9WgXcQ")
+	require.ErrorIs(t, err, InvalidProviderError)
+}
+
+func TestRegistry_ProvidersWithoutCredentials(t *testing.T) {
+	ctx := context.Background()
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{TidalClientID: "test_id"},
+		WithTranslator(&translatorMock{}),
+		WithProviderAdapter(VK, &adapterMock{}),
+		WithCredentialsRequired(),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []*Provider{Apple, Yandex, Deezer, Bandcamp, VK, Zvuk}, registry.EnabledProviders())
+	require.Equal(t, Providers, registry.Providers())
+
+	_, err = registry.ParseLink("https://tidal.com/browse/track/77646169")
+	require.ErrorIs(t, err, UnknownLinkError)
+	_, err = registry.Fetch(ctx, Tidal, Track, "77646169")
+	require.ErrorIs(t, err, InvalidProviderError)
+
+	link, err := registry.ParseLink("https://vk.com/audio-2001000000_1")
+	require.NoError(t, err)
+	require.Equal(t, VK, link.Provider)
+}
+
+func TestRegistry_BandcampCustomDomains(t *testing.T) {
+	ctx := context.Background()
+	registry, err := NewRegistry(
+		ctx,
+		Credentials{},
+		WithTranslator(&translatorMock{}),
+		WithProviderAdapter(Bandcamp, &adapterMock{}),
+		WithBandcampCustomDomains("Music.Example.com"),
+	)
+	require.NoError(t, err)
+
+	link, err := registry.ParseLink("https://music.example.com/album/awake")
+	require.NoError(t, err)
+	require.Equal(t, &Link{
+		URL:        "https://music.example.com/album/awake",
+		Provider:   Bandcamp,
+		EntityID:   "music.example.com/album/awake",
+		EntityType: Album,
+	}, link)
+
+	links := registry.ExtractLinks("new single https://music.example.com/track/awake")
+	require.Len(t, links, 1)
+	require.Equal(t, "music.example.com/track/awake", links[0].EntityID)
+
+	_, err = ParseLink("https://music.example.com/album/awake")
+	require.ErrorIs(t, err, UnknownLinkError)
+
+	other, err := NewRegistry(ctx, Credentials{}, WithTranslator(&translatorMock{}), WithProviderAdapter(Bandcamp, &adapterMock{}))
+	require.NoError(t, err)
+	_, err = other.ParseLink("https://music.example.com/album/awake")
+	require.ErrorIs(t, err, UnknownLinkError)
+}
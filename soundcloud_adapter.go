@@ -0,0 +1,219 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/soundcloud"
+)
+
+const soundCloudDateLen = len("2006-01-02")
+
+type SoundCloudAdapter struct {
+	client soundcloud.Client
+}
+
+func newSoundCloudAdapter(client soundcloud.Client) *SoundCloudAdapter {
+	return &SoundCloudAdapter{
+		client: client,
+	}
+}
+
+func (a *SoundCloudAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	track, err := a.client.FetchTrack(ctx, id)
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get track from soundcloud: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *SoundCloudAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	track, err := a.client.SearchTrack(ctx, entityFullTitle(artistName, trackName))
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track on soundcloud: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *SoundCloudAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := a.client.SearchTracks(ctx, entityFullTitle(artistName, trackName), limit)
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search tracks on soundcloud: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
+func (a *SoundCloudAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.FetchPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get album from soundcloud: %w", err)
+	}
+
+	return a.adaptAlbum(playlist), nil
+}
+
+func (a *SoundCloudAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	album, err := a.client.SearchAlbum(ctx, entityFullTitle(artistName, albumName))
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album on soundcloud: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *SoundCloudAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := a.client.SearchAlbums(ctx, entityFullTitle(artistName, albumName), limit)
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search albums on soundcloud: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album))
+	}
+	return candidates, nil
+}
+
+func (a *SoundCloudAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	user, err := a.client.FetchUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from soundcloud: %w", err)
+	}
+
+	return a.adaptArtist(user), nil
+}
+
+func (a *SoundCloudAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	user, err := a.client.SearchUser(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search artist on soundcloud: %w", err)
+	}
+
+	return a.adaptArtist(user), nil
+}
+
+func (a *SoundCloudAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.FetchPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, soundcloud.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from soundcloud: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist), nil
+}
+
+func (a *SoundCloudAdapter) adaptTrack(track *soundcloud.Track) *Entity {
+	entity := Entity{
+		ID:          track.Path(),
+		Title:       track.Title,
+		Artist:      track.Artist(),
+		URL:         track.URL(),
+		Provider:    SoundCloud,
+		Type:        Track,
+		Duration:    time.Duration(track.Duration) * time.Millisecond,
+		ReleaseDate: a.releaseDate(track.ReleaseDate, track.DisplayDate),
+		Artwork:     track.Artwork(),
+	}
+	if artist := track.Artist(); artist != "" {
+		entity.Artists = []string{artist}
+	}
+	if track.PublisherMetadata != nil {
+		entity.ISRC = track.PublisherMetadata.ISRC
+		entity.Album = track.PublisherMetadata.AlbumTitle
+		entity.Explicit = track.PublisherMetadata.Explicit
+	}
+	return &entity
+}
+
+func (a *SoundCloudAdapter) adaptAlbum(playlist *soundcloud.Playlist) *Entity {
+	entity := Entity{
+		ID:          playlist.Path(),
+		Title:       playlist.Title,
+		Artist:      playlist.Artist(),
+		URL:         playlist.URL(),
+		Provider:    SoundCloud,
+		Type:        Album,
+		ReleaseDate: a.releaseDate(playlist.ReleaseDate, playlist.DisplayDate),
+		Artwork:     playlist.Artwork(),
+	}
+	if artist := playlist.Artist(); artist != "" {
+		entity.Artists = []string{artist}
+	}
+	return &entity
+}
+
+func (a *SoundCloudAdapter) adaptArtist(user *soundcloud.User) *Entity {
+	return &Entity{
+		ID:       user.Path(),
+		Title:    user.Username,
+		Artist:   user.Username,
+		URL:      user.URL(),
+		Provider: SoundCloud,
+		Type:     Artist,
+	}
+}
+
+func (a *SoundCloudAdapter) adaptPlaylist(playlist *soundcloud.Playlist) *Entity {
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		tracks = append(tracks, a.adaptTrack(track))
+	}
+
+	return &Entity{
+		ID:       playlist.Path(),
+		Title:    playlist.Title,
+		Artist:   playlist.Artist(),
+		URL:      playlist.URL(),
+		Provider: SoundCloud,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
+}
+
+// releaseDate prefers the release date set by the label and falls back to the display date
+// of the upload, dropping the time of day.
+func (a *SoundCloudAdapter) releaseDate(releaseDate, displayDate string) string {
+	date := releaseDate
+	if date == "" {
+		date = displayDate
+	}
+	if len(date) < soundCloudDateLen {
+		return date
+	}
+	return date[:soundCloudDateLen]
+}
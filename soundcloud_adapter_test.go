@@ -0,0 +1,241 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/soundcloud"
+
+	"github.com/stretchr/testify/require"
+)
+
+type soundCloudClientMock struct {
+	fetchTrack    map[string]*soundcloud.Track
+	searchTracks  map[string][]*soundcloud.Track
+	fetchPlaylist map[string]*soundcloud.Playlist
+	searchAlbums  map[string][]*soundcloud.Playlist
+	fetchUser     map[string]*soundcloud.User
+	searchUser    map[string]*soundcloud.User
+}
+
+func (c *soundCloudClientMock) FetchTrack(_ context.Context, id string) (*soundcloud.Track, error) {
+	track, ok := c.fetchTrack[id]
+	if !ok {
+		return nil, soundcloud.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *soundCloudClientMock) SearchTrack(ctx context.Context, query string) (*soundcloud.Track, error) {
+	tracks, err := c.SearchTracks(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *soundCloudClientMock) SearchTracks(_ context.Context, query string, limit int) ([]*soundcloud.Track, error) {
+	tracks, ok := c.searchTracks[query]
+	if !ok {
+		return nil, soundcloud.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (c *soundCloudClientMock) FetchPlaylist(_ context.Context, id string) (*soundcloud.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[id]
+	if !ok {
+		return nil, soundcloud.NotFoundError
+	}
+	return playlist, nil
+}
+
+func (c *soundCloudClientMock) SearchAlbum(ctx context.Context, query string) (*soundcloud.Playlist, error) {
+	albums, err := c.SearchAlbums(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *soundCloudClientMock) SearchAlbums(_ context.Context, query string, limit int) ([]*soundcloud.Playlist, error) {
+	albums, ok := c.searchAlbums[query]
+	if !ok {
+		return nil, soundcloud.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *soundCloudClientMock) FetchUser(_ context.Context, id string) (*soundcloud.User, error) {
+	user, ok := c.fetchUser[id]
+	if !ok {
+		return nil, soundcloud.NotFoundError
+	}
+	return user, nil
+}
+
+func (c *soundCloudClientMock) SearchUser(_ context.Context, query string) (*soundcloud.User, error) {
+	user, ok := c.searchUser[query]
+	if !ok {
+		return nil, soundcloud.NotFoundError
+	}
+	return user, nil
+}
+
+var sampleSoundCloudTrack = &soundcloud.Track{
+	ID:           261325553,
+	Title:        "Never Be Like You feat. Kai",
+	PermalinkURL: "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+	Duration:     233000,
+	ArtworkURL:   "https://i1.sndcdn.com/artworks-sample-large.jpg",
+	DisplayDate:  "2016-01-14T18:00:00Z",
+	User:         &soundcloud.User{Username: "Flume"},
+	PublisherMetadata: &soundcloud.PublisherMetadata{
+		ISRC:       "AUFF01500360",
+		AlbumTitle: "Skin",
+	},
+}
+
+var sampleSoundCloudEntity = &Entity{
+	ID:          "flume/never-be-like-you-feat-kai",
+	Title:       "Never Be Like You feat. Kai",
+	Artist:      "Flume",
+	URL:         "https://soundcloud.com/flume/never-be-like-you-feat-kai",
+	Provider:    SoundCloud,
+	Type:        Track,
+	ISRC:        "AUFF01500360",
+	Artists:     []string{"Flume"},
+	Album:       "Skin",
+	Duration:    233 * time.Second,
+	ReleaseDate: "2016-01-14",
+	Artwork:     "https://i1.sndcdn.com/artworks-sample-t500x500.jpg",
+}
+
+func TestSoundCloudAdapter_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name:          "found ID",
+			id:            "flume/never-be-like-you-feat-kai",
+			expectedTrack: sampleSoundCloudEntity,
+		},
+		{
+			name:        "not found ID",
+			id:          "flume/unknown",
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newSoundCloudAdapter(&soundCloudClientMock{
+				fetchTrack: map[string]*soundcloud.Track{
+					"flume/never-be-like-you-feat-kai": sampleSoundCloudTrack,
+				},
+			})
+			result, err := a.FetchTrack(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestSoundCloudAdapter_SearchTrackCandidates(t *testing.T) {
+	a := newSoundCloudAdapter(&soundCloudClientMock{
+		searchTracks: map[string][]*soundcloud.Track{
+			"Flume – Never Be Like You": {sampleSoundCloudTrack},
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := a.SearchTrackCandidates(ctx, "Flume", "Never Be Like You", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{sampleSoundCloudEntity}, result)
+
+	_, err = a.SearchTrackCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestSoundCloudAdapter_FetchAlbum(t *testing.T) {
+	a := newSoundCloudAdapter(&soundCloudClientMock{
+		fetchPlaylist: map[string]*soundcloud.Playlist{
+			"flume/sets/skin": {
+				ID:           212406553,
+				Title:        "Skin",
+				PermalinkURL: "https://soundcloud.com/flume/sets/skin",
+				ReleaseDate:  "2016-05-27T00:00:00Z",
+				IsAlbum:      true,
+				User:         &soundcloud.User{Username: "Flume"},
+				Tracks:       []*soundcloud.Track{sampleSoundCloudTrack},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	album, err := a.FetchAlbum(ctx, "flume/sets/skin")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:          "flume/sets/skin",
+		Title:       "Skin",
+		Artist:      "Flume",
+		URL:         "https://soundcloud.com/flume/sets/skin",
+		Provider:    SoundCloud,
+		Type:        Album,
+		Artists:     []string{"Flume"},
+		ReleaseDate: "2016-05-27",
+	}, album)
+
+	playlist, err := a.FetchPlaylist(ctx, "flume/sets/skin")
+	require.NoError(t, err)
+	require.Equal(t, Playlist, playlist.Type)
+	require.Equal(t, []*Entity{sampleSoundCloudEntity}, playlist.Tracks)
+
+	_, err = a.FetchAlbum(ctx, "flume/sets/unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestSoundCloudAdapter_FetchArtist(t *testing.T) {
+	user := &soundcloud.User{ID: 2976616, Username: "Flume", PermalinkURL: "https://soundcloud.com/flume"}
+	a := newSoundCloudAdapter(&soundCloudClientMock{
+		fetchUser:  map[string]*soundcloud.User{"flume": user},
+		searchUser: map[string]*soundcloud.User{"Flume": user},
+	})
+	expected := &Entity{
+		ID:       "flume",
+		Title:    "Flume",
+		Artist:   "Flume",
+		URL:      "https://soundcloud.com/flume",
+		Provider: SoundCloud,
+		Type:     Artist,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := a.FetchArtist(ctx, "flume")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	result, err = a.SearchArtist(ctx, "Flume")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	_, err = a.SearchArtist(ctx, "unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
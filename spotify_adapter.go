@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/GeorgeGorbanev/streamnx/internal/spotify"
 )
@@ -42,6 +43,34 @@ func (a *SpotifyAdapter) SearchTrack(ctx context.Context, artistName, trackName
 	return a.adaptTrack(track), nil
 }
 
+func (a *SpotifyAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := a.client.SearchTracks(ctx, artistName, trackName, limit)
+	if err != nil {
+		if errors.Is(err, spotify.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search tracks on spotify: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
+func (a *SpotifyAdapter) SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error) {
+	track, err := a.client.SearchTrackByISRC(ctx, isrc)
+	if err != nil {
+		if errors.Is(err, spotify.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track by isrc on spotify: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
 func (a *SpotifyAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
 	album, err := a.client.FetchAlbum(ctx, id)
 	if err != nil {
@@ -66,24 +95,140 @@ func (a *SpotifyAdapter) SearchAlbum(ctx context.Context, artistName, albumName
 	return a.adaptAlbum(album), nil
 }
 
+func (a *SpotifyAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := a.client.SearchAlbums(ctx, artistName, albumName, limit)
+	if err != nil {
+		if errors.Is(err, spotify.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search albums on spotify: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album))
+	}
+	return candidates, nil
+}
+
+func (a *SpotifyAdapter) SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error) {
+	album, err := a.client.SearchAlbumByUPC(ctx, upc)
+	if err != nil {
+		if errors.Is(err, spotify.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album by upc on spotify: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *SpotifyAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	artist, err := a.client.FetchArtist(ctx, id)
+	if err != nil {
+		if errors.Is(err, spotify.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from spotify: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *SpotifyAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	artist, err := a.client.SearchArtist(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, spotify.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search artist on spotify: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *SpotifyAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.FetchPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, spotify.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from spotify: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist), nil
+}
+
 func (a *SpotifyAdapter) adaptTrack(track *spotify.Track) *Entity {
+	entity := Entity{
+		ID:          track.ID,
+		Title:       track.Name,
+		Artist:      track.Artists[0].Name,
+		URL:         track.URL(),
+		Provider:    Spotify,
+		Type:        Track,
+		ISRC:        track.ExternalIDs.ISRC,
+		Artists:     a.artistNames(track.Artists),
+		Duration:    time.Duration(track.DurationMS) * time.Millisecond,
+		Explicit:    track.Explicit,
+		TrackNumber: track.TrackNumber,
+		DiscNumber:  track.DiscNumber,
+	}
+	if track.Album != nil {
+		entity.Album = track.Album.Name
+		entity.ReleaseDate = track.Album.ReleaseDate
+		entity.Artwork = track.Album.ImageURL()
+	}
+	return &entity
+}
+
+func (a *SpotifyAdapter) adaptAlbum(album *spotify.Album) *Entity {
+	return &Entity{
+		ID:          album.ID,
+		Title:       album.Name,
+		Artist:      album.Artists[0].Name,
+		URL:         album.URL(),
+		Provider:    Spotify,
+		Type:        Album,
+		UPC:         album.ExternalIDs.UPC,
+		Artists:     a.artistNames(album.Artists),
+		ReleaseDate: album.ReleaseDate,
+		Artwork:     album.ImageURL(),
+	}
+}
+
+func (a *SpotifyAdapter) artistNames(artists []spotify.Artist) []string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return names
+}
+
+func (a *SpotifyAdapter) adaptArtist(artist *spotify.Artist) *Entity {
 	return &Entity{
-		ID:       track.ID,
-		Title:    track.Name,
-		Artist:   track.Artists[0].Name,
-		URL:      track.URL(),
+		ID:       artist.ID,
+		Title:    artist.Name,
+		Artist:   artist.Name,
+		URL:      artist.URL(),
 		Provider: Spotify,
-		Type:     Track,
+		Type:     Artist,
 	}
 }
 
-func (a *SpotifyAdapter) adaptAlbum(album *spotify.Album) *Entity {
+func (a *SpotifyAdapter) adaptPlaylist(playlist *spotify.Playlist) *Entity {
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		tracks = append(tracks, a.adaptTrack(track))
+	}
+
 	return &Entity{
-		ID:       album.ID,
-		Title:    album.Name,
-		Artist:   album.Artists[0].Name,
-		URL:      album.URL(),
+		ID:       playlist.ID,
+		Title:    playlist.Name,
+		Artist:   playlist.Owner.DisplayName,
+		URL:      playlist.URL(),
 		Provider: Spotify,
-		Type:     Album,
+		Type:     Playlist,
+		Tracks:   tracks,
 	}
 }
@@ -11,10 +11,18 @@ import (
 )
 
 type spotifyClientMock struct {
-	fetchTrack  map[string]*spotify.Track
-	fetchAlbum  map[string]*spotify.Album
-	searchTrack map[string]map[string]*spotify.Track
-	searchAlbum map[string]map[string]*spotify.Album
+	fetchTrack    map[string]*spotify.Track
+	fetchAlbum    map[string]*spotify.Album
+	searchTrack   map[string]map[string]*spotify.Track
+	searchAlbum   map[string]map[string]*spotify.Album
+	searchTracks  map[string]map[string][]*spotify.Track
+	searchAlbums  map[string]map[string][]*spotify.Album
+	fetchArtist   map[string]*spotify.Artist
+	searchArtist  map[string]*spotify.Artist
+	fetchPlaylist map[string]*spotify.Playlist
+
+	searchTrackByISRC map[string]*spotify.Track
+	searchAlbumByUPC  map[string]*spotify.Album
 }
 
 func (c *spotifyClientMock) FetchTrack(_ context.Context, id string) (*spotify.Track, error) {
@@ -36,6 +44,14 @@ func (c *spotifyClientMock) SearchTrack(_ context.Context, artistName, trackName
 	return nil, spotify.NotFoundError
 }
 
+func (c *spotifyClientMock) SearchTracks(_ context.Context, artistName, trackName string, limit int) ([]*spotify.Track, error) {
+	tracks, ok := c.searchTracks[artistName][trackName]
+	if !ok {
+		return nil, spotify.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
 func (c *spotifyClientMock) FetchAlbum(_ context.Context, id string) (*spotify.Album, error) {
 	album, ok := c.fetchAlbum[id]
 	if !ok {
@@ -55,6 +71,54 @@ func (c *spotifyClientMock) SearchAlbum(_ context.Context, artistName, albumName
 	return nil, spotify.NotFoundError
 }
 
+func (c *spotifyClientMock) SearchAlbums(_ context.Context, artistName, albumName string, limit int) ([]*spotify.Album, error) {
+	albums, ok := c.searchAlbums[artistName][albumName]
+	if !ok {
+		return nil, spotify.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *spotifyClientMock) FetchArtist(_ context.Context, id string) (*spotify.Artist, error) {
+	artist, ok := c.fetchArtist[id]
+	if !ok {
+		return nil, spotify.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *spotifyClientMock) SearchArtist(_ context.Context, artistName string) (*spotify.Artist, error) {
+	artist, ok := c.searchArtist[artistName]
+	if !ok {
+		return nil, spotify.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *spotifyClientMock) SearchTrackByISRC(_ context.Context, isrc string) (*spotify.Track, error) {
+	track, ok := c.searchTrackByISRC[isrc]
+	if !ok {
+		return nil, spotify.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *spotifyClientMock) SearchAlbumByUPC(_ context.Context, upc string) (*spotify.Album, error) {
+	album, ok := c.searchAlbumByUPC[upc]
+	if !ok {
+		return nil, spotify.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *spotifyClientMock) FetchPlaylist(_ context.Context, id string) (*spotify.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[id]
+	if !ok {
+		return nil, spotify.NotFoundError
+	}
+	return playlist, nil
+}
+
 func TestSpotifyAdapter_FetchTrack(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -86,6 +150,50 @@ func TestSpotifyAdapter_FetchTrack(t *testing.T) {
 				URL:      "https://open.spotify.com/track/sampleID",
 				Provider: Spotify,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+		{
+			name: "found ID with full metadata",
+			id:   "sampleID",
+			clientMock: &spotifyClientMock{
+				fetchTrack: map[string]*spotify.Track{
+					"sampleID": {
+						ID:   "sampleID",
+						Name: "sample name",
+						Artists: []spotify.Artist{
+							{Name: "sample artist"},
+							{Name: "featured artist"},
+						},
+						DurationMS:  215000,
+						Explicit:    true,
+						TrackNumber: 3,
+						DiscNumber:  1,
+						Album: &spotify.Album{
+							Name:        "sample album",
+							ReleaseDate: "2017-06-23",
+							Images: []spotify.Image{
+								{URL: "https://i.scdn.co/image/sample", Width: 640, Height: 640},
+							},
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "sampleID",
+				Title:       "sample name",
+				Artist:      "sample artist",
+				URL:         "https://open.spotify.com/track/sampleID",
+				Provider:    Spotify,
+				Type:        Track,
+				Artists:     []string{"sample artist", "featured artist"},
+				Album:       "sample album",
+				Duration:    215 * time.Second,
+				ReleaseDate: "2017-06-23",
+				Artwork:     "https://i.scdn.co/image/sample",
+				Explicit:    true,
+				TrackNumber: 3,
+				DiscNumber:  1,
 			},
 		},
 		{
@@ -149,6 +257,7 @@ func TestSpotifyAdapter_SearchTrack(t *testing.T) {
 				URL:      "https://open.spotify.com/track/sampleID",
 				Provider: Spotify,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -178,6 +287,49 @@ func TestSpotifyAdapter_SearchTrack(t *testing.T) {
 	}
 }
 
+func TestSpotifyAdapter_SearchTrackCandidates(t *testing.T) {
+	clientMock := &spotifyClientMock{
+		searchTracks: map[string]map[string][]*spotify.Track{
+			"sample artist": {
+				"sample name": {
+					{ID: "firstID", Name: "sample name - Live", Artists: []spotify.Artist{{Name: "sample artist"}}},
+					{ID: "secondID", Name: "sample name", Artists: []spotify.Artist{{Name: "sample artist"}}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newSpotifyAdapter(clientMock)
+	result, err := a.SearchTrackCandidates(ctx, "sample artist", "sample name", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:       "firstID",
+			Title:    "sample name - Live",
+			Artist:   "sample artist",
+			URL:      "https://open.spotify.com/track/firstID",
+			Provider: Spotify,
+			Type:     Track,
+			Artists:  []string{"sample artist"},
+		},
+		{
+			ID:       "secondID",
+			Title:    "sample name",
+			Artist:   "sample artist",
+			URL:      "https://open.spotify.com/track/secondID",
+			Provider: Spotify,
+			Type:     Track,
+			Artists:  []string{"sample artist"},
+		},
+	}, result)
+
+	_, err = a.SearchTrackCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
 func TestSpotifyAdapter_FetchAlbum(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -209,6 +361,7 @@ func TestSpotifyAdapter_FetchAlbum(t *testing.T) {
 				URL:      "https://open.spotify.com/album/sampleID",
 				Provider: Spotify,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -272,6 +425,7 @@ func TestSpotifyAdapter_SearchAlbum(t *testing.T) {
 				URL:      "https://open.spotify.com/album/sampleID",
 				Provider: Spotify,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -300,3 +454,307 @@ func TestSpotifyAdapter_SearchAlbum(t *testing.T) {
 		})
 	}
 }
+
+func TestSpotifyAdapter_FetchArtist(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		clientMock     *spotifyClientMock
+		expectedArtist *Entity
+		expectedErr    error
+	}{
+		{
+			name: "found ID",
+			id:   "sampleID",
+			clientMock: &spotifyClientMock{
+				fetchArtist: map[string]*spotify.Artist{
+					"sampleID": {
+						ID:   "sampleID",
+						Name: "sample artist",
+					},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "sampleID",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://open.spotify.com/artist/sampleID",
+				Provider: Spotify,
+				Type:     Artist,
+			},
+		},
+		{
+			name:           "not found ID",
+			id:             "notFoundID",
+			clientMock:     &spotifyClientMock{},
+			expectedArtist: nil,
+			expectedErr:    EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newSpotifyAdapter(tt.clientMock)
+			result, err := a.FetchArtist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestSpotifyAdapter_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name           string
+		artistName     string
+		clientMock     *spotifyClientMock
+		expectedArtist *Entity
+		expectedErr    error
+	}{
+		{
+			name:       "found query",
+			artistName: "sample artist",
+			clientMock: &spotifyClientMock{
+				searchArtist: map[string]*spotify.Artist{
+					"sample artist": {
+						ID:   "sampleID",
+						Name: "sample artist",
+					},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "sampleID",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://open.spotify.com/artist/sampleID",
+				Provider: Spotify,
+				Type:     Artist,
+			},
+		},
+		{
+			name:           "not found query",
+			artistName:     "not found artist",
+			clientMock:     &spotifyClientMock{},
+			expectedArtist: nil,
+			expectedErr:    EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newSpotifyAdapter(tt.clientMock)
+			result, err := a.SearchArtist(ctx, tt.artistName)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestSpotifyAdapter_FetchPlaylist(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		clientMock       *spotifyClientMock
+		expectedPlaylist *Entity
+		expectedErr      error
+	}{
+		{
+			name: "found ID",
+			id:   "samplePlaylistID",
+			clientMock: &spotifyClientMock{
+				fetchPlaylist: map[string]*spotify.Playlist{
+					"samplePlaylistID": {
+						ID:   "samplePlaylistID",
+						Name: "sample playlist",
+						Owner: spotify.Owner{
+							ID:          "sampleOwnerID",
+							DisplayName: "sample owner",
+						},
+						Tracks: []*spotify.Track{
+							{
+								ID:   "sampleTrackID",
+								Name: "sample name",
+								Artists: []spotify.Artist{
+									{Name: "sample artist"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPlaylist: &Entity{
+				ID:       "samplePlaylistID",
+				Title:    "sample playlist",
+				Artist:   "sample owner",
+				URL:      "https://open.spotify.com/playlist/samplePlaylistID",
+				Provider: Spotify,
+				Type:     Playlist,
+				Tracks: []*Entity{
+					{
+						ID:       "sampleTrackID",
+						Title:    "sample name",
+						Artist:   "sample artist",
+						URL:      "https://open.spotify.com/track/sampleTrackID",
+						Provider: Spotify,
+						Type:     Track,
+						Artists:  []string{"sample artist"},
+					},
+				},
+			},
+		},
+		{
+			name:             "not found ID",
+			id:               "notFoundID",
+			clientMock:       &spotifyClientMock{},
+			expectedPlaylist: nil,
+			expectedErr:      EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newSpotifyAdapter(tt.clientMock)
+			result, err := a.FetchPlaylist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPlaylist, result)
+			}
+		})
+	}
+}
+
+func TestSpotifyAdapter_SearchTrackByISRC(t *testing.T) {
+	tests := []struct {
+		name          string
+		isrc          string
+		clientMock    *spotifyClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ISRC",
+			isrc: "USUM71703861",
+			clientMock: &spotifyClientMock{
+				searchTrackByISRC: map[string]*spotify.Track{
+					"USUM71703861": {
+						ID:   "sampleID",
+						Name: "sample name",
+						Artists: []spotify.Artist{
+							{Name: "sample artist"},
+						},
+						ExternalIDs: spotify.ExternalIDs{ISRC: "USUM71703861"},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:       "sampleID",
+				Title:    "sample name",
+				Artist:   "sample artist",
+				URL:      "https://open.spotify.com/track/sampleID",
+				Provider: Spotify,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+				ISRC:     "USUM71703861",
+			},
+		},
+		{
+			name:        "not found ISRC",
+			isrc:        "notFoundISRC",
+			clientMock:  &spotifyClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newSpotifyAdapter(tt.clientMock)
+			result, err := a.SearchTrackByISRC(ctx, tt.isrc)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestSpotifyAdapter_SearchAlbumByUPC(t *testing.T) {
+	tests := []struct {
+		name          string
+		upc           string
+		clientMock    *spotifyClientMock
+		expectedAlbum *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found UPC",
+			upc:  "00602557382549",
+			clientMock: &spotifyClientMock{
+				searchAlbumByUPC: map[string]*spotify.Album{
+					"00602557382549": {
+						ID:   "sampleID",
+						Name: "sample name",
+						Artists: []spotify.Artist{
+							{Name: "sample artist"},
+						},
+						ExternalIDs: spotify.ExternalIDs{UPC: "00602557382549"},
+					},
+				},
+			},
+			expectedAlbum: &Entity{
+				ID:       "sampleID",
+				Title:    "sample name",
+				Artist:   "sample artist",
+				URL:      "https://open.spotify.com/album/sampleID",
+				Provider: Spotify,
+				Type:     Album,
+				Artists:  []string{"sample artist"},
+				UPC:      "00602557382549",
+			},
+		},
+		{
+			name:        "not found UPC",
+			upc:         "notFoundUPC",
+			clientMock:  &spotifyClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newSpotifyAdapter(tt.clientMock)
+			result, err := a.SearchAlbumByUPC(ctx, tt.upc)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAlbum, result)
+			}
+		})
+	}
+}
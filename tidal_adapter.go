@@ -0,0 +1,236 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/tidal"
+)
+
+type TidalAdapter struct {
+	client tidal.Client
+}
+
+func newTidalAdapter(client tidal.Client) *TidalAdapter {
+	return &TidalAdapter{
+		client: client,
+	}
+}
+
+func (a *TidalAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	track, err := a.client.FetchTrack(ctx, id)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get track from tidal: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *TidalAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	track, err := a.client.SearchTrack(ctx, artistName, trackName)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track on tidal: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *TidalAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := a.client.SearchTracks(ctx, artistName, trackName, limit)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search tracks on tidal: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
+func (a *TidalAdapter) SearchTrackByISRC(ctx context.Context, isrc string) (*Entity, error) {
+	track, err := a.client.SearchTrackByISRC(ctx, isrc)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search track by isrc on tidal: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *TidalAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	album, err := a.client.FetchAlbum(ctx, id)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get album from tidal: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *TidalAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	album, err := a.client.SearchAlbum(ctx, artistName, albumName)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album on tidal: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *TidalAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := a.client.SearchAlbums(ctx, artistName, albumName, limit)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search albums on tidal: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album))
+	}
+	return candidates, nil
+}
+
+func (a *TidalAdapter) SearchAlbumByUPC(ctx context.Context, upc string) (*Entity, error) {
+	album, err := a.client.SearchAlbumByUPC(ctx, upc)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search album by upc on tidal: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *TidalAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	artist, err := a.client.FetchArtist(ctx, id)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from tidal: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *TidalAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	artist, err := a.client.SearchArtist(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search artist on tidal: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *TidalAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.FetchPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, tidal.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from tidal: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist), nil
+}
+
+func (a *TidalAdapter) adaptTrack(track *tidal.Track) *Entity {
+	entity := Entity{
+		ID:       track.ID,
+		Title:    track.FullTitle(),
+		URL:      track.URL(),
+		Provider: Tidal,
+		Type:     Track,
+		ISRC:     track.ISRC,
+		Artists:  a.artistNames(track.Artists),
+		Duration: track.Duration,
+		Explicit: track.Explicit,
+	}
+	if len(track.Artists) > 0 {
+		entity.Artist = track.Artists[0].Name
+	}
+	if track.Album != nil {
+		entity.Album = track.Album.Title
+		entity.Artwork = track.Album.CoverURL
+		entity.ReleaseDate = track.Album.ReleaseDate
+	}
+	return &entity
+}
+
+func (a *TidalAdapter) adaptAlbum(album *tidal.Album) *Entity {
+	entity := Entity{
+		ID:          album.ID,
+		Title:       album.Title,
+		URL:         album.URL(),
+		Provider:    Tidal,
+		Type:        Album,
+		UPC:         album.UPC,
+		Artists:     a.artistNames(album.Artists),
+		ReleaseDate: album.ReleaseDate,
+		Artwork:     album.CoverURL,
+		Explicit:    album.Explicit,
+	}
+	if len(album.Artists) > 0 {
+		entity.Artist = album.Artists[0].Name
+	}
+	return &entity
+}
+
+func (a *TidalAdapter) artistNames(artists []*tidal.Artist) []string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return names
+}
+
+func (a *TidalAdapter) adaptArtist(artist *tidal.Artist) *Entity {
+	return &Entity{
+		ID:       artist.ID,
+		Title:    artist.Name,
+		Artist:   artist.Name,
+		URL:      artist.URL(),
+		Provider: Tidal,
+		Type:     Artist,
+	}
+}
+
+func (a *TidalAdapter) adaptPlaylist(playlist *tidal.Playlist) *Entity {
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		tracks = append(tracks, a.adaptTrack(track))
+	}
+
+	return &Entity{
+		ID:       playlist.ID,
+		Title:    playlist.Name,
+		URL:      playlist.URL(),
+		Provider: Tidal,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
+}
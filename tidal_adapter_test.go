@@ -0,0 +1,370 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/tidal"
+
+	"github.com/stretchr/testify/require"
+)
+
+type tidalClientMock struct {
+	fetchTrack    map[string]*tidal.Track
+	fetchAlbum    map[string]*tidal.Album
+	searchTracks  map[string]map[string][]*tidal.Track
+	searchAlbums  map[string]map[string][]*tidal.Album
+	fetchArtist   map[string]*tidal.Artist
+	searchArtist  map[string]*tidal.Artist
+	fetchPlaylist map[string]*tidal.Playlist
+
+	searchTrackByISRC map[string]*tidal.Track
+	searchAlbumByUPC  map[string]*tidal.Album
+}
+
+func (c *tidalClientMock) FetchTrack(_ context.Context, id string) (*tidal.Track, error) {
+	track, ok := c.fetchTrack[id]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *tidalClientMock) SearchTrack(ctx context.Context, artistName, trackName string) (*tidal.Track, error) {
+	tracks, err := c.SearchTracks(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *tidalClientMock) SearchTracks(_ context.Context, artistName, trackName string, limit int) ([]*tidal.Track, error) {
+	tracks, ok := c.searchTracks[artistName][trackName]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (c *tidalClientMock) SearchTrackByISRC(_ context.Context, isrc string) (*tidal.Track, error) {
+	track, ok := c.searchTrackByISRC[isrc]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *tidalClientMock) FetchAlbum(_ context.Context, id string) (*tidal.Album, error) {
+	album, ok := c.fetchAlbum[id]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *tidalClientMock) SearchAlbum(ctx context.Context, artistName, albumName string) (*tidal.Album, error) {
+	albums, err := c.SearchAlbums(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *tidalClientMock) SearchAlbums(_ context.Context, artistName, albumName string, limit int) ([]*tidal.Album, error) {
+	albums, ok := c.searchAlbums[artistName][albumName]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *tidalClientMock) SearchAlbumByUPC(_ context.Context, upc string) (*tidal.Album, error) {
+	album, ok := c.searchAlbumByUPC[upc]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *tidalClientMock) FetchArtist(_ context.Context, id string) (*tidal.Artist, error) {
+	artist, ok := c.fetchArtist[id]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *tidalClientMock) SearchArtist(_ context.Context, artistName string) (*tidal.Artist, error) {
+	artist, ok := c.searchArtist[artistName]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *tidalClientMock) FetchPlaylist(_ context.Context, id string) (*tidal.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[id]
+	if !ok {
+		return nil, tidal.NotFoundError
+	}
+	return playlist, nil
+}
+
+func TestTidalAdapter_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		clientMock    *tidalClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ID",
+			id:   "77646169",
+			clientMock: &tidalClientMock{
+				fetchTrack: map[string]*tidal.Track{
+					"77646169": {
+						ID:       "77646169",
+						Title:    "Karma Police",
+						Version:  "Remastered",
+						ISRC:     "GBAYE9700120",
+						Duration: 264 * time.Second,
+						Artists:  []*tidal.Artist{{ID: "8847", Name: "Radiohead"}},
+						Album: &tidal.Album{
+							ID:          "77646164",
+							Title:       "OK Computer",
+							ReleaseDate: "1997-05-21",
+							CoverURL:    "https://resources.tidal.com/images/sample/1280x1280.jpg",
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "77646169",
+				Title:       "Karma Police (Remastered)",
+				Artist:      "Radiohead",
+				URL:         "https://tidal.com/browse/track/77646169",
+				Provider:    Tidal,
+				Type:        Track,
+				ISRC:        "GBAYE9700120",
+				Artists:     []string{"Radiohead"},
+				Album:       "OK Computer",
+				Duration:    264 * time.Second,
+				ReleaseDate: "1997-05-21",
+				Artwork:     "https://resources.tidal.com/images/sample/1280x1280.jpg",
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "0",
+			clientMock:  &tidalClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newTidalAdapter(tt.clientMock)
+			result, err := a.FetchTrack(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestTidalAdapter_SearchTrackCandidates(t *testing.T) {
+	clientMock := &tidalClientMock{
+		searchTracks: map[string]map[string][]*tidal.Track{
+			"Radiohead": {
+				"Karma Police": {
+					{ID: "1", Title: "Karma Police", Version: "Live", Artists: []*tidal.Artist{{Name: "Radiohead"}}},
+					{ID: "2", Title: "Karma Police", Artists: []*tidal.Artist{{Name: "Radiohead"}}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newTidalAdapter(clientMock)
+	result, err := a.SearchTrackCandidates(ctx, "Radiohead", "Karma Police", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:       "1",
+			Title:    "Karma Police (Live)",
+			Artist:   "Radiohead",
+			URL:      "https://tidal.com/browse/track/1",
+			Provider: Tidal,
+			Type:     Track,
+			Artists:  []string{"Radiohead"},
+		},
+		{
+			ID:       "2",
+			Title:    "Karma Police",
+			Artist:   "Radiohead",
+			URL:      "https://tidal.com/browse/track/2",
+			Provider: Tidal,
+			Type:     Track,
+			Artists:  []string{"Radiohead"},
+		},
+	}, result)
+
+	_, err = a.SearchTrackCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestTidalAdapter_FetchAlbum(t *testing.T) {
+	clientMock := &tidalClientMock{
+		fetchAlbum: map[string]*tidal.Album{
+			"77646164": {
+				ID:          "77646164",
+				Title:       "OK Computer",
+				UPC:         "0634904078164",
+				ReleaseDate: "1997-05-21",
+				CoverURL:    "https://resources.tidal.com/images/sample/1280x1280.jpg",
+				Artists:     []*tidal.Artist{{ID: "8847", Name: "Radiohead"}},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newTidalAdapter(clientMock)
+	result, err := a.FetchAlbum(ctx, "77646164")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:          "77646164",
+		Title:       "OK Computer",
+		Artist:      "Radiohead",
+		URL:         "https://tidal.com/browse/album/77646164",
+		Provider:    Tidal,
+		Type:        Album,
+		UPC:         "0634904078164",
+		Artists:     []string{"Radiohead"},
+		ReleaseDate: "1997-05-21",
+		Artwork:     "https://resources.tidal.com/images/sample/1280x1280.jpg",
+	}, result)
+
+	_, err = a.FetchAlbum(ctx, "0")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestTidalAdapter_SearchByIdentifier(t *testing.T) {
+	clientMock := &tidalClientMock{
+		searchTrackByISRC: map[string]*tidal.Track{
+			"GBAYE9700120": {ID: "77646169", Title: "Karma Police", ISRC: "GBAYE9700120"},
+		},
+		searchAlbumByUPC: map[string]*tidal.Album{
+			"0634904078164": {ID: "77646164", Title: "OK Computer", UPC: "0634904078164"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newTidalAdapter(clientMock)
+
+	track, err := a.SearchTrackByISRC(ctx, "GBAYE9700120")
+	require.NoError(t, err)
+	require.Equal(t, "77646169", track.ID)
+	require.Equal(t, "GBAYE9700120", track.ISRC)
+
+	album, err := a.SearchAlbumByUPC(ctx, "0634904078164")
+	require.NoError(t, err)
+	require.Equal(t, "77646164", album.ID)
+	require.Equal(t, "0634904078164", album.UPC)
+
+	_, err = a.SearchTrackByISRC(ctx, "unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+	_, err = a.SearchAlbumByUPC(ctx, "unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestTidalAdapter_FetchArtist(t *testing.T) {
+	clientMock := &tidalClientMock{
+		fetchArtist: map[string]*tidal.Artist{
+			"8847": {ID: "8847", Name: "Radiohead"},
+		},
+		searchArtist: map[string]*tidal.Artist{
+			"Radiohead": {ID: "8847", Name: "Radiohead"},
+		},
+	}
+	expected := &Entity{
+		ID:       "8847",
+		Title:    "Radiohead",
+		Artist:   "Radiohead",
+		URL:      "https://tidal.com/browse/artist/8847",
+		Provider: Tidal,
+		Type:     Artist,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newTidalAdapter(clientMock)
+
+	result, err := a.FetchArtist(ctx, "8847")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	result, err = a.SearchArtist(ctx, "Radiohead")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	_, err = a.FetchArtist(ctx, "0")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestTidalAdapter_FetchPlaylist(t *testing.T) {
+	playlistID := "0f1e6d44-9a2c-4e4c-bb0d-0b1f3bb3c0a7"
+	clientMock := &tidalClientMock{
+		fetchPlaylist: map[string]*tidal.Playlist{
+			playlistID: {
+				ID:   playlistID,
+				Name: "sample playlist",
+				Tracks: []*tidal.Track{
+					{ID: "1", Title: "first", Artists: []*tidal.Artist{{Name: "sample artist"}}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newTidalAdapter(clientMock)
+	result, err := a.FetchPlaylist(ctx, playlistID)
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       playlistID,
+		Title:    "sample playlist",
+		URL:      "https://tidal.com/browse/playlist/" + playlistID,
+		Provider: Tidal,
+		Type:     Playlist,
+		Tracks: []*Entity{
+			{
+				ID:       "1",
+				Title:    "first",
+				Artist:   "sample artist",
+				URL:      "https://tidal.com/browse/track/1",
+				Provider: Tidal,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+	}, result)
+
+	_, err = a.FetchPlaylist(ctx, "0")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
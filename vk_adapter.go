@@ -0,0 +1,225 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/translator"
+	"github.com/GeorgeGorbanev/streamnx/internal/vk"
+)
+
+type VKAdapter struct {
+	client  vk.Client
+	matcher *artistMatcher
+}
+
+func newVKAdapter(client vk.Client, t translator.Translator) *VKAdapter {
+	return &VKAdapter{
+		client:  client,
+		matcher: newArtistMatcher(t),
+	}
+}
+
+func (a *VKAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	track, err := a.client.FetchTrack(ctx, id)
+	if err != nil {
+		if errors.Is(err, vk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get track from vk: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *VKAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	candidates, err := a.SearchTrackCandidates(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+func (a *VKAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := searchMatching(ctx, a.matcher, artistName, trackName, func(query string) ([]*vk.Track, error) {
+		tracks, err := a.client.SearchTracks(ctx, query, limit)
+		if errors.Is(err, vk.NotFoundError) {
+			return nil, nil
+		}
+		return tracks, err
+	}, func(track *vk.Track) string {
+		return track.ArtistNames()[0]
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tracks on vk: %w", err)
+	}
+	if len(tracks) == 0 {
+		return nil, EntityNotFoundError
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
+func (a *VKAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	album, err := a.client.FetchAlbum(ctx, id)
+	if err != nil {
+		if errors.Is(err, vk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get album from vk: %w", err)
+	}
+
+	return a.adaptAlbum(album), nil
+}
+
+func (a *VKAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	candidates, err := a.SearchAlbumCandidates(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+func (a *VKAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	albums, err := searchMatching(ctx, a.matcher, artistName, albumName, func(query string) ([]*vk.Playlist, error) {
+		albums, err := a.client.SearchAlbums(ctx, query, limit)
+		if errors.Is(err, vk.NotFoundError) {
+			return nil, nil
+		}
+		return albums, err
+	}, func(album *vk.Playlist) string {
+		return firstName(album.ArtistNames())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search albums on vk: %w", err)
+	}
+	if len(albums) == 0 {
+		return nil, EntityNotFoundError
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album))
+	}
+	return candidates, nil
+}
+
+func (a *VKAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	artist, err := a.client.FetchArtist(ctx, id)
+	if err != nil {
+		if errors.Is(err, vk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from vk: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *VKAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	queries := []string{artistName}
+	if !translator.HasCyrillic(artistName) {
+		queries = append(queries, translator.TranslitLatToCyr(artistName))
+	}
+
+	for _, query := range queries {
+		artist, err := a.client.SearchArtist(ctx, query)
+		if err != nil {
+			if errors.Is(err, vk.NotFoundError) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to search artist on vk: %w", err)
+		}
+
+		artistMatch, err := a.matcher.match(ctx, artist.Name, artistName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check artist match: %w", err)
+		}
+		if artistMatch {
+			return a.adaptArtist(artist), nil
+		}
+	}
+
+	return nil, EntityNotFoundError
+}
+
+func (a *VKAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.FetchPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, vk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from vk: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist), nil
+}
+
+func (a *VKAdapter) adaptTrack(track *vk.Track) *Entity {
+	artists := track.ArtistNames()
+	entity := Entity{
+		ID:       track.FullID(),
+		Title:    track.Title,
+		Artist:   artists[0],
+		URL:      track.URL(),
+		Provider: VK,
+		Type:     Track,
+		Artists:  artists,
+		Duration: time.Duration(track.Duration) * time.Second,
+		Artwork:  track.ImageURL(),
+		Explicit: track.IsExplicit,
+	}
+	if track.Album != nil {
+		entity.Album = track.Album.Title
+	}
+	return &entity
+}
+
+func (a *VKAdapter) adaptAlbum(album *vk.Playlist) *Entity {
+	entity := Entity{
+		ID:          album.FullID(),
+		Title:       album.Title,
+		URL:         album.URL(),
+		Provider:    VK,
+		Type:        Album,
+		Artists:     album.ArtistNames(),
+		ReleaseDate: album.ReleaseDate(),
+		Artwork:     album.ImageURL(),
+		Explicit:    album.IsExplicit,
+	}
+	entity.Artist = firstName(entity.Artists)
+	return &entity
+}
+
+func (a *VKAdapter) adaptArtist(artist *vk.Artist) *Entity {
+	return &Entity{
+		ID:       artist.Handle(),
+		Title:    artist.Name,
+		Artist:   artist.Name,
+		URL:      artist.URL(),
+		Provider: VK,
+		Type:     Artist,
+	}
+}
+
+func (a *VKAdapter) adaptPlaylist(playlist *vk.Playlist) *Entity {
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		tracks = append(tracks, a.adaptTrack(track))
+	}
+
+	return &Entity{
+		ID:       playlist.FullID(),
+		Title:    playlist.Title,
+		URL:      playlist.URL(),
+		Provider: VK,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
+}
@@ -0,0 +1,336 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/vk"
+
+	"github.com/stretchr/testify/require"
+)
+
+type vkClientMock struct {
+	fetchTrack    map[string]*vk.Track
+	fetchAlbum    map[string]*vk.Playlist
+	searchTracks  map[string][]*vk.Track
+	searchAlbums  map[string][]*vk.Playlist
+	fetchArtist   map[string]*vk.Artist
+	searchArtist  map[string]*vk.Artist
+	fetchPlaylist map[string]*vk.Playlist
+}
+
+func (c *vkClientMock) FetchTrack(_ context.Context, id string) (*vk.Track, error) {
+	track, ok := c.fetchTrack[id]
+	if !ok {
+		return nil, vk.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *vkClientMock) SearchTrack(ctx context.Context, query string) (*vk.Track, error) {
+	tracks, err := c.SearchTracks(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *vkClientMock) SearchTracks(_ context.Context, query string, limit int) ([]*vk.Track, error) {
+	tracks, ok := c.searchTracks[query]
+	if !ok {
+		return nil, vk.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (c *vkClientMock) FetchAlbum(_ context.Context, id string) (*vk.Playlist, error) {
+	album, ok := c.fetchAlbum[id]
+	if !ok {
+		return nil, vk.NotFoundError
+	}
+	return album, nil
+}
+
+func (c *vkClientMock) SearchAlbum(ctx context.Context, query string) (*vk.Playlist, error) {
+	albums, err := c.SearchAlbums(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+func (c *vkClientMock) SearchAlbums(_ context.Context, query string, limit int) ([]*vk.Playlist, error) {
+	albums, ok := c.searchAlbums[query]
+	if !ok {
+		return nil, vk.NotFoundError
+	}
+	return albums[:min(limit, len(albums))], nil
+}
+
+func (c *vkClientMock) FetchArtist(_ context.Context, id string) (*vk.Artist, error) {
+	artist, ok := c.fetchArtist[id]
+	if !ok {
+		return nil, vk.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *vkClientMock) SearchArtist(_ context.Context, query string) (*vk.Artist, error) {
+	artist, ok := c.searchArtist[query]
+	if !ok {
+		return nil, vk.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *vkClientMock) FetchPlaylist(_ context.Context, id string) (*vk.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[id]
+	if !ok {
+		return nil, vk.NotFoundError
+	}
+	return playlist, nil
+}
+
+func TestVKAdapter_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		clientMock    *vkClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ID",
+			id:   "-2001234567_456239017",
+			clientMock: &vkClientMock{
+				fetchTrack: map[string]*vk.Track{
+					"-2001234567_456239017": {
+						ID:          456239017,
+						OwnerID:     -2001234567,
+						AccessKey:   "4f1c2b7e",
+						Artist:      "Земфира",
+						Title:       "Хочешь?",
+						Duration:    213,
+						MainArtists: []vk.Artist{{Name: "Земфира", Domain: "zemfira"}},
+						Album: &vk.TrackAlbum{
+							Title: "Спасибо",
+							Thumb: &vk.Photo{Photo1200: "https://sun9-1.userapi.com/sample.jpg"},
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:       "-2001234567_456239017_4f1c2b7e",
+				Title:    "Хочешь?",
+				Artist:   "Земфира",
+				URL:      "https://vk.com/audio-2001234567_456239017",
+				Provider: VK,
+				Type:     Track,
+				Artists:  []string{"Земфира"},
+				Album:    "Спасибо",
+				Duration: 213 * time.Second,
+				Artwork:  "https://sun9-1.userapi.com/sample.jpg",
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "1_1",
+			clientMock:  &vkClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newVKAdapter(tt.clientMock, &translatorMock{})
+			result, err := a.FetchTrack(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestVKAdapter_SearchTrackCandidates(t *testing.T) {
+	tests := []struct {
+		name        string
+		artistName  string
+		trackName   string
+		clientMock  *vkClientMock
+		expectedIDs []string
+		expectedErr error
+	}{
+		{
+			name:       "keeps tracks of the matching artist",
+			artistName: "Zemfira",
+			trackName:  "Ariva",
+			clientMock: &vkClientMock{
+				searchTracks: map[string][]*vk.Track{
+					"Zemfira – Ariva": {
+						{ID: 1, OwnerID: 1, Artist: "Cover Band", Title: "Ariva"},
+						{ID: 2, OwnerID: 1, Artist: "Земфира", Title: "Ariva"},
+					},
+				},
+			},
+			expectedIDs: []string{"1_2"},
+		},
+		{
+			name:       "retries with transliterated artist for cyrillic title",
+			artistName: "Zemfira",
+			trackName:  "Хочешь?",
+			clientMock: &vkClientMock{
+				searchTracks: map[string][]*vk.Track{
+					"Zemfira – Хочешь?": {
+						{ID: 1, OwnerID: 1, Artist: "Cover Band", Title: "Хочешь?"},
+					},
+					"Земфира – Хочешь?": {
+						{ID: 3, OwnerID: 1, Artist: "Земфира", Title: "Хочешь?"},
+					},
+				},
+			},
+			expectedIDs: []string{"1_3"},
+		},
+		{
+			name:        "not found",
+			artistName:  "Zemfira",
+			trackName:   "Unknown",
+			clientMock:  &vkClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newVKAdapter(tt.clientMock, &translatorMock{})
+			result, err := a.SearchTrackCandidates(ctx, tt.artistName, tt.trackName, 5)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(result))
+			for _, entity := range result {
+				ids = append(ids, entity.ID)
+			}
+			require.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestVKAdapter_FetchAlbum(t *testing.T) {
+	clientMock := &vkClientMock{
+		fetchAlbum: map[string]*vk.Playlist{
+			"-2000413089_13089_c1e9a3f2": {
+				ID:          13089,
+				OwnerID:     -2000413089,
+				AccessKey:   "c1e9a3f2",
+				Type:        1,
+				Title:       "Спасибо",
+				Year:        2007,
+				MainArtists: []vk.Artist{{Name: "Земфира"}},
+				Photo:       &vk.Photo{Photo600: "https://sun9-1.userapi.com/album.jpg"},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newVKAdapter(clientMock, &translatorMock{})
+	result, err := a.FetchAlbum(ctx, "-2000413089_13089_c1e9a3f2")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:          "-2000413089_13089_c1e9a3f2",
+		Title:       "Спасибо",
+		Artist:      "Земфира",
+		URL:         "https://vk.com/music/album/-2000413089_13089_c1e9a3f2",
+		Provider:    VK,
+		Type:        Album,
+		Artists:     []string{"Земфира"},
+		ReleaseDate: "2007",
+		Artwork:     "https://sun9-1.userapi.com/album.jpg",
+	}, result)
+
+	_, err = a.FetchAlbum(ctx, "1_1")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestVKAdapter_SearchArtist(t *testing.T) {
+	clientMock := &vkClientMock{
+		searchArtist: map[string]*vk.Artist{
+			"Zemfira": {ID: "1", Name: "Zemfira Tribute", Domain: "zemfira_tribute"},
+			"Земфира": {ID: "2", Name: "Земфира", Domain: "zemfira"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newVKAdapter(clientMock, &translatorMock{})
+	result, err := a.SearchArtist(ctx, "Zemfira")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "zemfira",
+		Title:    "Земфира",
+		Artist:   "Земфира",
+		URL:      "https://vk.com/artist/zemfira",
+		Provider: VK,
+		Type:     Artist,
+	}, result)
+
+	_, err = a.SearchArtist(ctx, "Unknown")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestVKAdapter_FetchPlaylist(t *testing.T) {
+	clientMock := &vkClientMock{
+		fetchPlaylist: map[string]*vk.Playlist{
+			"1234_58": {
+				ID:      58,
+				OwnerID: 1234,
+				Title:   "sample playlist",
+				Tracks: []*vk.Track{
+					{ID: 1, OwnerID: 1, Artist: "sample artist", Title: "first"},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newVKAdapter(clientMock, &translatorMock{})
+	result, err := a.FetchPlaylist(ctx, "1234_58")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "1234_58",
+		Title:    "sample playlist",
+		URL:      "https://vk.com/music/playlist/1234_58",
+		Provider: VK,
+		Type:     Playlist,
+		Tracks: []*Entity{
+			{
+				ID:       "1_1",
+				Title:    "first",
+				Artist:   "sample artist",
+				URL:      "https://vk.com/audio1_1",
+				Provider: VK,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+	}, result)
+
+	_, err = a.FetchPlaylist(ctx, "1_1")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
@@ -6,20 +6,21 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/GeorgeGorbanev/streamnx/internal/translator"
 	"github.com/GeorgeGorbanev/streamnx/internal/yandex"
 )
 
 type YandexAdapter struct {
-	client     yandex.Client
-	translator translator.Translator
+	client  yandex.Client
+	matcher *artistMatcher
 }
 
 func newYandexAdapter(c yandex.Client, t translator.Translator) *YandexAdapter {
 	return &YandexAdapter{
-		client:     c,
-		translator: t,
+		client:  c,
+		matcher: newArtistMatcher(t),
 	}
 }
 
@@ -47,6 +48,22 @@ func (a *YandexAdapter) SearchTrack(ctx context.Context, artist, title string) (
 	return a.adaptTrack(foundTrack), nil
 }
 
+func (a *YandexAdapter) SearchTrackCandidates(ctx context.Context, artist, title string, limit int) ([]*Entity, error) {
+	tracks, err := a.findTracks(ctx, artist, title, limit)
+	if err != nil {
+		if errors.Is(err, yandex.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, err
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
 func (a *YandexAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
 	yandexAlbum, err := a.client.FetchAlbum(ctx, id)
 	if err != nil {
@@ -71,13 +88,70 @@ func (a *YandexAdapter) SearchAlbum(ctx context.Context, artist, title string) (
 	return a.adaptAlbum(foundAlbum), nil
 }
 
+func (a *YandexAdapter) SearchAlbumCandidates(ctx context.Context, artist, title string, limit int) ([]*Entity, error) {
+	albums, err := a.findAlbums(ctx, artist, title, limit)
+	if err != nil {
+		if errors.Is(err, yandex.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, err
+	}
+
+	candidates := make([]*Entity, 0, len(albums))
+	for _, album := range albums {
+		candidates = append(candidates, a.adaptAlbum(album))
+	}
+	return candidates, nil
+}
+
+func (a *YandexAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	yandexArtist, err := a.client.FetchArtist(ctx, id)
+	if err != nil {
+		if errors.Is(err, yandex.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from yandex music: %w", err)
+	}
+
+	return a.adaptArtist(yandexArtist), nil
+}
+
+func (a *YandexAdapter) SearchArtist(ctx context.Context, artist string) (*Entity, error) {
+	foundArtist, err := a.findArtist(ctx, artist)
+	if err != nil {
+		if errors.Is(err, yandex.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, err
+	}
+
+	return a.adaptArtist(foundArtist), nil
+}
+
+func (a *YandexAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	owner, kind, ok := strings.Cut(id, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid yandex playlist id: %s", id)
+	}
+
+	yandexPlaylist, err := a.client.FetchPlaylist(ctx, owner, kind)
+	if err != nil {
+		if errors.Is(err, yandex.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from yandex music: %w", err)
+	}
+
+	return a.adaptPlaylist(yandexPlaylist), nil
+}
+
 func (a *YandexAdapter) findTrack(ctx context.Context, artist, title string) (*yandex.Track, error) {
 	track, err := a.searchTrackRequest(ctx, artist, title)
 	if err != nil && !errors.Is(err, yandex.NotFoundError) {
 		return nil, fmt.Errorf("error searching track: %w", err)
 	}
 	if track != nil {
-		artistMatch, err := a.artistMatch(ctx, track.Artists[0].Name, artist)
+		artistMatch, err := a.matcher.match(ctx, track.Artists[0].Name, artist)
 		if err != nil {
 			return nil, fmt.Errorf("failed to check artist match: %w", err)
 		}
@@ -106,7 +180,7 @@ func (a *YandexAdapter) findAlbum(ctx context.Context, artist, title string) (*y
 		return nil, fmt.Errorf("error searching album: %w", err)
 	}
 	if album != nil {
-		artistMatch, err := a.artistMatch(ctx, album.Artists[0].Name, artist)
+		artistMatch, err := a.matcher.match(ctx, album.Artists[0].Name, artist)
 		if err != nil {
 			return nil, fmt.Errorf("failed to check artist match: %w", err)
 		}
@@ -129,6 +203,98 @@ func (a *YandexAdapter) findAlbum(ctx context.Context, artist, title string) (*y
 	return nil, yandex.NotFoundError
 }
 
+// findTracks keeps the search results of the queried artist, skipping tracks without an artist or album.
+func (a *YandexAdapter) findTracks(ctx context.Context, artist, title string, limit int) ([]*yandex.Track, error) {
+	tracks, err := searchMatching(ctx, a.matcher, artist, title, func(query string) ([]*yandex.Track, error) {
+		tracks, err := a.client.SearchTracks(ctx, strings.ToLower(query), limit)
+		if err != nil {
+			if errors.Is(err, yandex.NotFoundError) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("error searching tracks: %w", err)
+		}
+		complete := make([]*yandex.Track, 0, len(tracks))
+		for _, track := range tracks {
+			if len(track.Artists) > 0 && len(track.Albums) > 0 {
+				complete = append(complete, track)
+			}
+		}
+		return complete, nil
+	}, func(track *yandex.Track) string {
+		return track.Artists[0].Name
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tracks) == 0 {
+		return nil, yandex.NotFoundError
+	}
+	return tracks, nil
+}
+
+func (a *YandexAdapter) findAlbums(ctx context.Context, artist, title string, limit int) ([]*yandex.Album, error) {
+	albums, err := searchMatching(ctx, a.matcher, artist, title, func(query string) ([]*yandex.Album, error) {
+		albums, err := a.client.SearchAlbums(ctx, strings.ToLower(query), limit)
+		if err != nil {
+			if errors.Is(err, yandex.NotFoundError) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("error searching albums: %w", err)
+		}
+		complete := make([]*yandex.Album, 0, len(albums))
+		for _, album := range albums {
+			if len(album.Artists) > 0 {
+				complete = append(complete, album)
+			}
+		}
+		return complete, nil
+	}, func(album *yandex.Album) string {
+		return album.Artists[0].Name
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(albums) == 0 {
+		return nil, yandex.NotFoundError
+	}
+	return albums, nil
+}
+
+func (a *YandexAdapter) findArtist(ctx context.Context, artist string) (*yandex.Artist, error) {
+	found, err := a.client.SearchArtist(ctx, strings.ToLower(artist))
+	if err != nil && !errors.Is(err, yandex.NotFoundError) {
+		return nil, fmt.Errorf("error searching artist: %w", err)
+	}
+	if found != nil {
+		artistMatch, err := a.matcher.match(ctx, found.Name, artist)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check artist match: %w", err)
+		}
+		if artistMatch {
+			return found, nil
+		}
+	}
+
+	if !translator.HasCyrillic(artist) {
+		translited := translator.TranslitLatToCyr(artist)
+		found, err = a.client.SearchArtist(ctx, strings.ToLower(translited))
+		if err != nil && !errors.Is(err, yandex.NotFoundError) {
+			return nil, fmt.Errorf("error searching yandex artist: %w", err)
+		}
+		if found != nil {
+			artistMatch, err := a.matcher.match(ctx, found.Name, artist)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check artist match: %w", err)
+			}
+			if artistMatch {
+				return found, nil
+			}
+		}
+	}
+
+	return nil, yandex.NotFoundError
+}
+
 func (a *YandexAdapter) searchTrackRequest(ctx context.Context, artist, title string) (*yandex.Track, error) {
 	query := a.prepareQuery(artist, title)
 	return a.client.SearchTrack(ctx, query)
@@ -145,48 +311,93 @@ func (a *YandexAdapter) prepareQuery(artist, title string) string {
 }
 
 func (a *YandexAdapter) adaptTrack(yandexTrack *yandex.Track) *Entity {
-	return &Entity{
+	entity := Entity{
 		ID:       yandexTrack.IDString(),
 		Title:    yandexTrack.Title,
 		Artist:   yandexTrack.Artists[0].Name,
 		URL:      yandexTrack.URL(),
 		Provider: Yandex,
 		Type:     Track,
+		Artists:  a.artistNames(yandexTrack.Artists),
+		Duration: time.Duration(yandexTrack.DurationMS) * time.Millisecond,
+		Artwork:  yandexTrack.CoverURL(),
+		Explicit: yandexTrack.IsExplicit(),
+	}
+	if len(yandexTrack.Albums) > 0 {
+		album := yandexTrack.Albums[0]
+		entity.Album = album.Title
+		entity.ReleaseDate = a.releaseDate(&album)
+		if entity.Artwork == "" {
+			entity.Artwork = album.CoverURL()
+		}
+		if album.TrackPosition != nil {
+			entity.TrackNumber = album.TrackPosition.Index
+			entity.DiscNumber = album.TrackPosition.Volume
+		}
 	}
+	return &entity
 }
 
 func (a *YandexAdapter) adaptAlbum(yandexAlbum *yandex.Album) *Entity {
 	return &Entity{
-		ID:       strconv.Itoa(yandexAlbum.ID),
-		Title:    yandexAlbum.Title,
-		Artist:   yandexAlbum.Artists[0].Name,
-		URL:      yandexAlbum.URL(),
-		Provider: Yandex,
-		Type:     Album,
+		ID:          strconv.Itoa(yandexAlbum.ID),
+		Title:       yandexAlbum.Title,
+		Artist:      yandexAlbum.Artists[0].Name,
+		URL:         yandexAlbum.URL(),
+		Provider:    Yandex,
+		Type:        Album,
+		Artists:     a.artistNames(yandexAlbum.Artists),
+		ReleaseDate: a.releaseDate(yandexAlbum),
+		Artwork:     yandexAlbum.CoverURL(),
+		Explicit:    yandexAlbum.IsExplicit(),
 	}
 }
 
-func (a *YandexAdapter) artistMatch(ctx context.Context, found, query string) (bool, error) {
-	lcFound := strings.ToLower(found)
-	lcQuery := strings.ToLower(query)
-	if lcQuery == lcFound {
-		return true, nil
+func (a *YandexAdapter) artistNames(artists []yandex.Artist) []string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
 	}
+	return names
+}
 
-	translitedFoundArtist := translator.TranslitCyrToLat(lcFound)
-	if lcQuery == translitedFoundArtist {
-		return true, nil
+func (a *YandexAdapter) releaseDate(yandexAlbum *yandex.Album) string {
+	if len(yandexAlbum.ReleaseDate) >= len(time.DateOnly) {
+		return yandexAlbum.ReleaseDate[:len(time.DateOnly)]
 	}
+	if yandexAlbum.Year > 0 {
+		return strconv.Itoa(yandexAlbum.Year)
+	}
+	return ""
+}
 
-	if translator.HasCyrillic(found) {
-		translatedArtist, err := a.translator.TranslateEnToRu(ctx, lcQuery)
-		if err != nil {
-			return false, fmt.Errorf("failed to translate artist name: %w", err)
-		}
-		if translatedArtist == lcFound {
-			return true, nil
+func (a *YandexAdapter) adaptArtist(yandexArtist *yandex.Artist) *Entity {
+	return &Entity{
+		ID:       strconv.Itoa(yandexArtist.ID),
+		Title:    yandexArtist.Name,
+		Artist:   yandexArtist.Name,
+		URL:      yandexArtist.URL(),
+		Provider: Yandex,
+		Type:     Artist,
+	}
+}
+
+func (a *YandexAdapter) adaptPlaylist(yandexPlaylist *yandex.Playlist) *Entity {
+	tracks := make([]*Entity, 0, len(yandexPlaylist.Tracks))
+	for _, item := range yandexPlaylist.Tracks {
+		if len(item.Track.Albums) == 0 || len(item.Track.Artists) == 0 {
+			continue
 		}
+		tracks = append(tracks, a.adaptTrack(item.Track))
 	}
 
-	return false, nil
+	return &Entity{
+		ID:       fmt.Sprintf("%s:%d", yandexPlaylist.Owner.Login, yandexPlaylist.Kind),
+		Title:    yandexPlaylist.Title,
+		Artist:   yandexPlaylist.Owner.Name,
+		URL:      yandexPlaylist.URL(),
+		Provider: Yandex,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
 }
@@ -11,10 +11,15 @@ import (
 )
 
 type yandexClientMock struct {
-	fetchTrack  map[string]*yandex.Track
-	fetchAlbum  map[string]*yandex.Album
-	searchTrack map[string]*yandex.Track
-	searchAlbum map[string]*yandex.Album
+	fetchTrack    map[string]*yandex.Track
+	fetchAlbum    map[string]*yandex.Album
+	searchTrack   map[string]*yandex.Track
+	searchAlbum   map[string]*yandex.Album
+	searchTracks  map[string][]*yandex.Track
+	searchAlbums  map[string][]*yandex.Album
+	fetchArtist   map[string]*yandex.Artist
+	searchArtist  map[string]*yandex.Artist
+	fetchPlaylist map[string]*yandex.Playlist
 }
 
 func (c *yandexClientMock) FetchTrack(_ context.Context, id string) (*yandex.Track, error) {
@@ -32,6 +37,13 @@ func (c *yandexClientMock) SearchTrack(_ context.Context, query string) (*yandex
 	return nil, yandex.NotFoundError
 }
 
+func (c *yandexClientMock) SearchTracks(_ context.Context, query string, limit int) ([]*yandex.Track, error) {
+	if tracks, ok := c.searchTracks[query]; ok {
+		return tracks[:min(limit, len(tracks))], nil
+	}
+	return nil, yandex.NotFoundError
+}
+
 func (c *yandexClientMock) FetchAlbum(_ context.Context, id string) (*yandex.Album, error) {
 	album, ok := c.fetchAlbum[id]
 	if !ok {
@@ -47,11 +59,45 @@ func (c *yandexClientMock) SearchAlbum(_ context.Context, query string) (*yandex
 	return nil, yandex.NotFoundError
 }
 
+func (c *yandexClientMock) SearchAlbums(_ context.Context, query string, limit int) ([]*yandex.Album, error) {
+	if albums, ok := c.searchAlbums[query]; ok {
+		return albums[:min(limit, len(albums))], nil
+	}
+	return nil, yandex.NotFoundError
+}
+
+func (c *yandexClientMock) FetchArtist(_ context.Context, id string) (*yandex.Artist, error) {
+	artist, ok := c.fetchArtist[id]
+	if !ok {
+		return nil, yandex.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *yandexClientMock) SearchArtist(_ context.Context, query string) (*yandex.Artist, error) {
+	if artist, ok := c.searchArtist[query]; ok {
+		return artist, nil
+	}
+	return nil, yandex.NotFoundError
+}
+
+func (c *yandexClientMock) FetchPlaylist(_ context.Context, owner, kind string) (*yandex.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[owner+":"+kind]
+	if !ok {
+		return nil, yandex.NotFoundError
+	}
+	return playlist, nil
+}
+
 type translatorMock struct {
 	enToRu map[string]string
+	err    error
 }
 
 func (t *translatorMock) TranslateEnToRu(_ context.Context, text string) (string, error) {
+	if t.err != nil {
+		return "", t.err
+	}
 	return t.enToRu[text], nil
 }
 
@@ -91,6 +137,51 @@ func TestYandexAdapter_FetchTrack(t *testing.T) {
 				URL:      "https://music.yandex.com/album/41/track/42",
 				Provider: Yandex,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+		{
+			name: "found ID with full metadata",
+			id:   "42",
+			yandexClientMock: yandexClientMock{
+				fetchTrack: map[string]*yandex.Track{
+					"42": {
+						ID:    42,
+						Title: "sample name",
+						Artists: []yandex.Artist{
+							{Name: "sample artist"},
+							{Name: "featured artist"},
+						},
+						DurationMS:     215000,
+						ContentWarning: "explicit",
+						Albums: []yandex.Album{
+							{
+								ID:            41,
+								Title:         "sample album",
+								Year:          2017,
+								ReleaseDate:   "2017-06-23T00:00:00+03:00",
+								CoverURI:      "avatars.yandex.net/get-music-content/sample/%%",
+								TrackPosition: &yandex.TrackPosition{Volume: 1, Index: 3},
+							},
+						},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "42",
+				Title:       "sample name",
+				Artist:      "sample artist",
+				URL:         "https://music.yandex.com/album/41/track/42",
+				Provider:    Yandex,
+				Type:        Track,
+				Artists:     []string{"sample artist", "featured artist"},
+				Album:       "sample album",
+				Duration:    215 * time.Second,
+				ReleaseDate: "2017-06-23",
+				Artwork:     "https://avatars.yandex.net/get-music-content/sample/{w}x{h}",
+				Explicit:    true,
+				TrackNumber: 3,
+				DiscNumber:  1,
 			},
 		},
 		{
@@ -155,6 +246,7 @@ func TestYandexAdapter_SearchTrack(t *testing.T) {
 				URL:      "https://music.yandex.com/album/41/track/42",
 				Provider: Yandex,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -203,6 +295,7 @@ func TestYandexAdapter_SearchTrack(t *testing.T) {
 				URL:      "https://music.yandex.com/album/41/track/42",
 				Provider: Yandex,
 				Type:     Track,
+				Artists:  []string{"сампле артист матчинг транслит"},
 			},
 		},
 		{
@@ -230,6 +323,7 @@ func TestYandexAdapter_SearchTrack(t *testing.T) {
 				URL:      "https://music.yandex.com/album/41/track/42",
 				Provider: Yandex,
 				Type:     Track,
+				Artists:  []string{"сампле артист афтер транслит"},
 			},
 		},
 		{
@@ -262,6 +356,7 @@ func TestYandexAdapter_SearchTrack(t *testing.T) {
 				URL:      "https://music.yandex.com/album/41/track/42",
 				Provider: Yandex,
 				Type:     Track,
+				Artists:  []string{"переведенный артист"},
 			},
 		},
 		{
@@ -291,6 +386,73 @@ func TestYandexAdapter_SearchTrack(t *testing.T) {
 	}
 }
 
+func TestYandexAdapter_SearchTrackCandidates(t *testing.T) {
+	tests := []struct {
+		name             string
+		artistName       string
+		searchName       string
+		yandexClientMock yandexClientMock
+		expectedIDs      []string
+		expectedErr      error
+	}{
+		{
+			name:       "candidates with matching artist",
+			artistName: "sample artist",
+			searchName: "sample name",
+			yandexClientMock: yandexClientMock{
+				searchTracks: map[string][]*yandex.Track{
+					"sample artist – sample name": {
+						{ID: 1, Title: "sample name", Artists: []yandex.Artist{{Name: "another artist"}}, Albums: []yandex.Album{{ID: 41}}},
+						{ID: 5, Title: "sample name", Artists: []yandex.Artist{{Name: "sample artist"}}},
+						{ID: 2, Title: "sample name", Artists: []yandex.Artist{{Name: "sample artist"}}, Albums: []yandex.Album{{ID: 41}}},
+						{ID: 3, Title: "sample name (live)", Artists: []yandex.Artist{{Name: "Sample Artist"}}, Albums: []yandex.Album{{ID: 41}}},
+					},
+				},
+			},
+			expectedIDs: []string{"2", "3"},
+		},
+		{
+			name:       "cyrillic title with transliterated artist",
+			artistName: "Zemfira",
+			searchName: "Хочешь?",
+			yandexClientMock: yandexClientMock{
+				searchTracks: map[string][]*yandex.Track{
+					"земфира – хочешь?": {
+						{ID: 4, Title: "Хочешь?", Artists: []yandex.Artist{{Name: "Земфира"}}, Albums: []yandex.Album{{ID: 41}}},
+					},
+				},
+			},
+			expectedIDs: []string{"4"},
+		},
+		{
+			name:        "not found",
+			artistName:  "not found artist",
+			searchName:  "not found name",
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newYandexAdapter(&tt.yandexClientMock, &translatorMock{})
+			result, err := a.SearchTrackCandidates(ctx, tt.artistName, tt.searchName, 5)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			ids := make([]string, 0, len(result))
+			for _, entity := range result {
+				ids = append(ids, entity.ID)
+			}
+			require.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
 func TestYandexAdapter_FetchAlbum(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -320,6 +482,7 @@ func TestYandexAdapter_FetchAlbum(t *testing.T) {
 				URL:      "https://music.yandex.com/album/42",
 				Provider: Yandex,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -380,6 +543,7 @@ func TestYandexAdapter_SearchAlbum(t *testing.T) {
 				URL:      "https://music.yandex.com/album/42",
 				Provider: Yandex,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -422,6 +586,7 @@ func TestYandexAdapter_SearchAlbum(t *testing.T) {
 				URL:      "https://music.yandex.com/album/42",
 				Provider: Yandex,
 				Type:     Album,
+				Artists:  []string{"сампле артист матчинг транслит"},
 			},
 		},
 		{
@@ -446,6 +611,7 @@ func TestYandexAdapter_SearchAlbum(t *testing.T) {
 				URL:      "https://music.yandex.com/album/42",
 				Provider: Yandex,
 				Type:     Album,
+				Artists:  []string{"сампле артист афтер транслит"},
 			},
 		},
 		{
@@ -475,6 +641,7 @@ func TestYandexAdapter_SearchAlbum(t *testing.T) {
 				URL:      "https://music.yandex.com/album/42",
 				Provider: Yandex,
 				Type:     Album,
+				Artists:  []string{"переведенный артист"},
 			},
 		},
 		{
@@ -503,3 +670,218 @@ func TestYandexAdapter_SearchAlbum(t *testing.T) {
 		})
 	}
 }
+
+func TestYandexAdapter_FetchArtist(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		yandexClientMock yandexClientMock
+		expectedArtist   *Entity
+		expectedErr      error
+	}{
+		{
+			name: "found ID",
+			id:   "42",
+			yandexClientMock: yandexClientMock{
+				fetchArtist: map[string]*yandex.Artist{
+					"42": {ID: 42, Name: "sample artist"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "42",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://music.yandex.com/artist/42",
+				Provider: Yandex,
+				Type:     Artist,
+			},
+		},
+		{
+			name:             "not found ID",
+			id:               "notFoundID",
+			yandexClientMock: yandexClientMock{},
+			expectedArtist:   nil,
+			expectedErr:      EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newYandexAdapter(&tt.yandexClientMock, nil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := a.FetchArtist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestYandexAdapter_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name             string
+		artistName       string
+		yandexClientMock yandexClientMock
+		translatorMock   translatorMock
+		expectedArtist   *Entity
+		expectedErr      error
+	}{
+		{
+			name:       "found query",
+			artistName: "Sample Artist",
+			yandexClientMock: yandexClientMock{
+				searchArtist: map[string]*yandex.Artist{
+					"sample artist": {ID: 42, Name: "Sample Artist"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "42",
+				Title:    "Sample Artist",
+				Artist:   "Sample Artist",
+				URL:      "https://music.yandex.com/artist/42",
+				Provider: Yandex,
+				Type:     Artist,
+			},
+		},
+		{
+			name:       "found query by translited artist",
+			artistName: "Zemfira",
+			yandexClientMock: yandexClientMock{
+				searchArtist: map[string]*yandex.Artist{
+					"земфира": {ID: 42, Name: "Земфира"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "42",
+				Title:    "Земфира",
+				Artist:   "Земфира",
+				URL:      "https://music.yandex.com/artist/42",
+				Provider: Yandex,
+				Type:     Artist,
+			},
+		},
+		{
+			name:       "found query but artist not matching",
+			artistName: "sample artist",
+			yandexClientMock: yandexClientMock{
+				searchArtist: map[string]*yandex.Artist{
+					"sample artist": {ID: 42, Name: "not matching artist"},
+				},
+			},
+			expectedErr: EntityNotFoundError,
+		},
+		{
+			name:             "not found query",
+			artistName:       "not found artist",
+			yandexClientMock: yandexClientMock{},
+			expectedErr:      EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newYandexAdapter(&tt.yandexClientMock, &tt.translatorMock)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := a.SearchArtist(ctx, tt.artistName)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestYandexAdapter_FetchPlaylist(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		clientMock       *yandexClientMock
+		expectedPlaylist *Entity
+		expectedErr      error
+	}{
+		{
+			name: "found ID",
+			id:   "sample.user:1000",
+			clientMock: &yandexClientMock{
+				fetchPlaylist: map[string]*yandex.Playlist{
+					"sample.user:1000": {
+						Kind:  1000,
+						Title: "sample playlist",
+						Owner: yandex.Owner{
+							Login: "sample.user",
+							Name:  "sample user",
+						},
+						Tracks: []yandex.PlaylistTrack{
+							{
+								Track: &yandex.Track{
+									ID:      "42",
+									Title:   "sample name",
+									Albums:  []yandex.Album{{ID: 7}},
+									Artists: []yandex.Artist{{Name: "sample artist"}},
+								},
+							},
+							{
+								Track: &yandex.Track{
+									ID:    "43",
+									Title: "sample upload",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPlaylist: &Entity{
+				ID:       "sample.user:1000",
+				Title:    "sample playlist",
+				Artist:   "sample user",
+				URL:      "https://music.yandex.com/users/sample.user/playlists/1000",
+				Provider: Yandex,
+				Type:     Playlist,
+				Tracks: []*Entity{
+					{
+						ID:       "42",
+						Title:    "sample name",
+						Artist:   "sample artist",
+						URL:      "https://music.yandex.com/album/7/track/42",
+						Provider: Yandex,
+						Type:     Track,
+						Artists:  []string{"sample artist"},
+					},
+				},
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "sample.user:404",
+			clientMock:  &yandexClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newYandexAdapter(tt.clientMock, &translatorMock{})
+			result, err := a.FetchPlaylist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPlaylist, result)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/GeorgeGorbanev/streamnx/internal/youtube"
 )
@@ -54,6 +55,35 @@ func (a *YoutubeAdapter) SearchTrack(ctx context.Context, artistName, trackName
 	return a.adaptTrack(video), nil
 }
 
+func (a *YoutubeAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	query := entityFullTitle(artistName, trackName)
+	search, err := a.client.SearchVideos(ctx, query, limit)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search videos on youtube: %w", err)
+	}
+
+	ids := make([]string, 0, len(search.Items))
+	for _, item := range search.Items {
+		ids = append(ids, item.ID.VideoID)
+	}
+	videos, err := a.client.GetVideos(ctx, ids)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get videos from youtube: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(videos))
+	for i := range videos {
+		candidates = append(candidates, a.adaptTrack(&videos[i]))
+	}
+	return candidates, nil
+}
+
 func (a *YoutubeAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
 	album, err := a.client.GetPlaylist(ctx, id)
 	if err != nil {
@@ -84,18 +114,118 @@ func (a *YoutubeAdapter) SearchAlbum(ctx context.Context, artistName, albumName
 	return a.adaptAlbum(ctx, album)
 }
 
+func (a *YoutubeAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	query := entityFullTitle(artistName, albumName)
+	search, err := a.client.SearchPlaylists(ctx, query, limit)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search playlists on youtube: %w", err)
+	}
+
+	ids := make([]string, 0, len(search.Items))
+	for _, item := range search.Items {
+		ids = append(ids, item.ID.PlaylistID)
+	}
+	playlists, err := a.client.GetPlaylists(ctx, ids)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlists from youtube: %w", err)
+	}
+
+	candidates := make([]*Entity, 0, len(playlists))
+	for i := range playlists {
+		album, err := a.adaptAlbum(ctx, &playlists[i])
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, album)
+	}
+	return candidates, nil
+}
+
+func (a *YoutubeAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	channel, err := a.client.GetChannel(ctx, id)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get channel from youtube: %w", err)
+	}
+	return a.adaptArtist(channel), nil
+}
+
+func (a *YoutubeAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	channel, err := a.findArtistChannel(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search channel on youtube: %w", err)
+	}
+	return a.adaptArtist(channel), nil
+}
+
+func (a *YoutubeAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.GetPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from youtube: %w", err)
+	}
+
+	videos, err := a.client.GetPlaylistItems(ctx, playlist.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get playlist items from youtube: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist, videos), nil
+}
+
+// findArtistChannel prefers the auto-generated "- Topic" channel of the artist, which holds
+// the official releases, over the top channel found by the name.
+func (a *YoutubeAdapter) findArtistChannel(ctx context.Context, artistName string) (*youtube.Channel, error) {
+	channel, err := a.searchChannel(ctx, artistName+" - Topic")
+	if err != nil && !errors.Is(err, youtube.NotFoundError) {
+		return nil, err
+	}
+	if channel != nil && channel.IsAutogenerated() {
+		return channel, nil
+	}
+	return a.searchChannel(ctx, artistName)
+}
+
+func (a *YoutubeAdapter) searchChannel(ctx context.Context, query string) (*youtube.Channel, error) {
+	search, err := a.client.SearchChannel(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return a.client.GetChannel(ctx, search.Items[0].ID.ChannelID)
+}
+
 func (a *YoutubeAdapter) adaptTrack(video *youtube.Video) *Entity {
 	trackTitle := a.extractTrackTitle(video)
 	artist, track := a.cleanAndSplitTitle(trackTitle)
 
-	return &Entity{
-		ID:       video.ID,
-		Title:    track,
-		Artist:   artist,
-		URL:      video.URL(),
-		Provider: Youtube,
-		Type:     Track,
+	entity := Entity{
+		ID:          video.ID,
+		Title:       track,
+		Artist:      artist,
+		URL:         video.URL(),
+		Provider:    Youtube,
+		Type:        Track,
+		Duration:    video.Duration,
+		ReleaseDate: a.releaseDate(video.PublishedAt),
+		Artwork:     video.ThumbnailURL,
 	}
+	if artist != "" {
+		entity.Artists = []string{artist}
+	}
+	return &entity
 }
 
 func (a *YoutubeAdapter) extractTrackTitle(video *youtube.Video) string {
@@ -113,27 +243,30 @@ func (a *YoutubeAdapter) adaptAlbum(ctx context.Context, playlist *youtube.Playl
 
 	artist, album := a.cleanAndSplitTitle(albumTitle)
 
-	return &Entity{
+	entity := Entity{
 		ID:       playlist.ID,
 		Title:    album,
 		Artist:   artist,
 		URL:      playlist.URL(),
 		Provider: Youtube,
 		Type:     Album,
-	}, nil
+		Artwork:  playlist.ThumbnailURL,
+	}
+	if artist != "" {
+		entity.Artists = []string{artist}
+	}
+	return &entity, nil
 }
 
 func (a *YoutubeAdapter) extractAlbumTitle(ctx context.Context, playlist *youtube.Playlist) (string, error) {
 	if playlist.IsAutogenerated() {
-		videos, err := a.client.GetPlaylistItems(ctx, playlist.ID)
-		if err != nil {
-			return "", fmt.Errorf("failed to get playlist items from youtube: %w", err)
-		}
-		if len(videos) == 0 {
+		v, err := a.client.GetFirstPlaylistItem(ctx, playlist.ID)
+		if errors.Is(err, youtube.NotFoundError) {
 			return playlist.Title, nil
 		}
-
-		v := videos[0]
+		if err != nil {
+			return "", fmt.Errorf("failed to get playlist item from youtube: %w", err)
+		}
 		if !v.IsAutogenerated() {
 			return playlist.Title, nil
 		}
@@ -143,6 +276,42 @@ func (a *YoutubeAdapter) extractAlbumTitle(ctx context.Context, playlist *youtub
 	return playlist.Title, nil
 }
 
+func (a *YoutubeAdapter) adaptArtist(channel *youtube.Channel) *Entity {
+	artist := channel.Artist()
+	return &Entity{
+		ID:       channel.ID,
+		Title:    artist,
+		Artist:   artist,
+		URL:      channel.URL(),
+		Provider: Youtube,
+		Type:     Artist,
+	}
+}
+
+func (a *YoutubeAdapter) adaptPlaylist(playlist *youtube.Playlist, videos []youtube.Video) *Entity {
+	tracks := make([]*Entity, 0, len(videos))
+	for i := range videos {
+		tracks = append(tracks, a.adaptTrack(&videos[i]))
+	}
+
+	return &Entity{
+		ID:       playlist.ID,
+		Title:    playlist.Title,
+		Artist:   playlist.ChannelTitle,
+		URL:      playlist.URL(),
+		Provider: Youtube,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
+}
+
+func (a *YoutubeAdapter) releaseDate(publishedAt string) string {
+	if len(publishedAt) < len(time.DateOnly) {
+		return ""
+	}
+	return publishedAt[:len(time.DateOnly)]
+}
+
 func (a *YoutubeAdapter) cleanAndSplitTitle(title string) (artist, entity string) {
 	cleanTitle := nonTitleContentRe.ReplaceAllString(title, "")
 
@@ -15,7 +15,12 @@ type youtubeClientMock struct {
 	getPlaylist      map[string]*youtube.Playlist
 	searchVideo      map[string]*youtube.SearchResponse
 	searchPlaylist   map[string]*youtube.SearchResponse
+	searchVideos     map[string]*youtube.SearchResponse
+	searchPlaylists  map[string]*youtube.SearchResponse
 	getPlaylistItems map[string][]youtube.Video
+	getChannel       map[string]*youtube.Channel
+	searchChannel    map[string]*youtube.SearchResponse
+	getAlbumPlaylist map[string]string
 }
 
 func (c *youtubeClientMock) GetVideo(_ context.Context, id string) (*youtube.Video, error) {
@@ -34,6 +39,27 @@ func (c *youtubeClientMock) SearchVideo(_ context.Context, query string) (*youtu
 	return video, nil
 }
 
+func (c *youtubeClientMock) GetVideos(_ context.Context, ids []string) ([]youtube.Video, error) {
+	videos := make([]youtube.Video, 0, len(ids))
+	for _, id := range ids {
+		if video, ok := c.getVideo[id]; ok {
+			videos = append(videos, *video)
+		}
+	}
+	if len(videos) == 0 {
+		return nil, youtube.NotFoundError
+	}
+	return videos, nil
+}
+
+func (c *youtubeClientMock) SearchVideos(_ context.Context, query string, limit int) (*youtube.SearchResponse, error) {
+	search, ok := c.searchVideos[query]
+	if !ok {
+		return nil, youtube.NotFoundError
+	}
+	return &youtube.SearchResponse{Items: search.Items[:min(limit, len(search.Items))]}, nil
+}
+
 func (c *youtubeClientMock) GetPlaylist(_ context.Context, id string) (*youtube.Playlist, error) {
 	playlist, ok := c.getPlaylist[id]
 	if !ok {
@@ -50,10 +76,63 @@ func (c *youtubeClientMock) SearchPlaylist(_ context.Context, query string) (*yo
 	return playlist, nil
 }
 
+func (c *youtubeClientMock) GetPlaylists(_ context.Context, ids []string) ([]youtube.Playlist, error) {
+	playlists := make([]youtube.Playlist, 0, len(ids))
+	for _, id := range ids {
+		if playlist, ok := c.getPlaylist[id]; ok {
+			playlists = append(playlists, *playlist)
+		}
+	}
+	if len(playlists) == 0 {
+		return nil, youtube.NotFoundError
+	}
+	return playlists, nil
+}
+
+func (c *youtubeClientMock) SearchPlaylists(_ context.Context, query string, limit int) (*youtube.SearchResponse, error) {
+	search, ok := c.searchPlaylists[query]
+	if !ok {
+		return nil, youtube.NotFoundError
+	}
+	return &youtube.SearchResponse{Items: search.Items[:min(limit, len(search.Items))]}, nil
+}
+
 func (c *youtubeClientMock) GetPlaylistItems(_ context.Context, id string) ([]youtube.Video, error) {
 	return c.getPlaylistItems[id], nil
 }
 
+func (c *youtubeClientMock) GetFirstPlaylistItem(_ context.Context, id string) (*youtube.Video, error) {
+	videos := c.getPlaylistItems[id]
+	if len(videos) == 0 {
+		return nil, youtube.NotFoundError
+	}
+	return &videos[0], nil
+}
+
+func (c *youtubeClientMock) GetChannel(_ context.Context, id string) (*youtube.Channel, error) {
+	channel, ok := c.getChannel[id]
+	if !ok {
+		return nil, youtube.NotFoundError
+	}
+	return channel, nil
+}
+
+func (c *youtubeClientMock) SearchChannel(_ context.Context, query string) (*youtube.SearchResponse, error) {
+	channel, ok := c.searchChannel[query]
+	if !ok {
+		return nil, youtube.NotFoundError
+	}
+	return channel, nil
+}
+
+func (c *youtubeClientMock) GetAlbumPlaylistID(_ context.Context, browseID string) (string, error) {
+	id, ok := c.getAlbumPlaylist[browseID]
+	if !ok {
+		return "", youtube.NotFoundError
+	}
+	return id, nil
+}
+
 func TestYoutubeAdapter_FetchTrack(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -80,6 +159,34 @@ func TestYoutubeAdapter_FetchTrack(t *testing.T) {
 				URL:      "https://www.youtube.com/watch?v=sampleID",
 				Provider: Youtube,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+		{
+			name: "found ID with full metadata",
+			id:   "sampleID",
+			youtubeClientMock: youtubeClientMock{
+				getVideo: map[string]*youtube.Video{
+					"sampleID": {
+						ID:           "sampleID",
+						Title:        "sample artist – sample track",
+						Duration:     215 * time.Second,
+						PublishedAt:  "2017-06-23T10:00:00Z",
+						ThumbnailURL: "https://i.ytimg.com/vi/sampleID/hqdefault.jpg",
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "sampleID",
+				Title:       "sample track",
+				Artist:      "sample artist",
+				URL:         "https://www.youtube.com/watch?v=sampleID",
+				Provider:    Youtube,
+				Type:        Track,
+				Artists:     []string{"sample artist"},
+				Duration:    215 * time.Second,
+				ReleaseDate: "2017-06-23",
+				Artwork:     "https://i.ytimg.com/vi/sampleID/hqdefault.jpg",
 			},
 		},
 		{
@@ -102,6 +209,7 @@ func TestYoutubeAdapter_FetchTrack(t *testing.T) {
 				URL:      "https://www.youtube.com/watch?v=sampleID",
 				Provider: Youtube,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -170,6 +278,7 @@ func TestYoutubeAdapter_SearchTrack(t *testing.T) {
 				URL:      "https://www.youtube.com/watch?v=sampleID",
 				Provider: Youtube,
 				Type:     Track,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -200,6 +309,63 @@ func TestYoutubeAdapter_SearchTrack(t *testing.T) {
 	}
 }
 
+func TestYoutubeAdapter_SearchTrackCandidates(t *testing.T) {
+	clientMock := youtubeClientMock{
+		searchVideos: map[string]*youtube.SearchResponse{
+			"sample artist – sample track": {
+				Items: []youtube.SearchItem{
+					{ID: youtube.SearchID{VideoID: "firstID"}},
+					{ID: youtube.SearchID{VideoID: "secondID"}},
+				},
+			},
+		},
+		getVideo: map[string]*youtube.Video{
+			"firstID": {
+				ID:       "firstID",
+				Title:    "sample artist – sample track (live)",
+				Duration: 4 * time.Minute,
+			},
+			"secondID": {
+				ID:       "secondID",
+				Title:    "sample artist – sample track",
+				Duration: 3 * time.Minute,
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newYoutubeAdapter(&clientMock)
+	result, err := a.SearchTrackCandidates(ctx, "sample artist", "sample track", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:       "firstID",
+			Title:    "sample track",
+			Artist:   "sample artist",
+			URL:      "https://www.youtube.com/watch?v=firstID",
+			Provider: Youtube,
+			Type:     Track,
+			Artists:  []string{"sample artist"},
+			Duration: 4 * time.Minute,
+		},
+		{
+			ID:       "secondID",
+			Title:    "sample track",
+			Artist:   "sample artist",
+			URL:      "https://www.youtube.com/watch?v=secondID",
+			Provider: Youtube,
+			Type:     Track,
+			Artists:  []string{"sample artist"},
+			Duration: 3 * time.Minute,
+		},
+	}, result)
+
+	_, err = a.SearchTrackCandidates(ctx, "not found artist", "not found track", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
 func TestYoutubeAdapter_FetchAlbum(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -226,6 +392,7 @@ func TestYoutubeAdapter_FetchAlbum(t *testing.T) {
 				URL:      "https://www.youtube.com/playlist?list=sampleID",
 				Provider: Youtube,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -256,6 +423,7 @@ func TestYoutubeAdapter_FetchAlbum(t *testing.T) {
 				URL:      "https://www.youtube.com/playlist?list=sampleID",
 				Provider: Youtube,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -279,6 +447,7 @@ func TestYoutubeAdapter_FetchAlbum(t *testing.T) {
 				URL:      "https://www.youtube.com/playlist?list=sampleID",
 				Provider: Youtube,
 				Type:     Album,
+				Artists:  []string{"Album"},
 			},
 		},
 		{
@@ -309,6 +478,7 @@ func TestYoutubeAdapter_FetchAlbum(t *testing.T) {
 				URL:      "https://www.youtube.com/playlist?list=sampleID",
 				Provider: Youtube,
 				Type:     Album,
+				Artists:  []string{"Album"},
 			},
 		},
 		{
@@ -377,6 +547,7 @@ func TestYoutubeAdapter_SearchAlbum(t *testing.T) {
 				URL:      "https://www.youtube.com/playlist?list=sampleID",
 				Provider: Youtube,
 				Type:     Album,
+				Artists:  []string{"sample artist"},
 			},
 		},
 		{
@@ -465,3 +636,225 @@ func TestYoutubeAdapter_cleanAndSplitTitle(t *testing.T) {
 		require.Equal(t, test.expectedEntity, entity)
 	}
 }
+
+func TestYoutubeAdapter_FetchArtist(t *testing.T) {
+	tests := []struct {
+		name              string
+		id                string
+		youtubeClientMock youtubeClientMock
+		expectedArtist    *Entity
+		expectedErr       error
+	}{
+		{
+			name: "found topic channel",
+			id:   "sampleID",
+			youtubeClientMock: youtubeClientMock{
+				getChannel: map[string]*youtube.Channel{
+					"sampleID": {ID: "sampleID", Title: "sample artist - Topic"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "sampleID",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://www.youtube.com/channel/sampleID",
+				Provider: Youtube,
+				Type:     Artist,
+			},
+		},
+		{
+			name:              "not found ID",
+			id:                "notFoundID",
+			youtubeClientMock: youtubeClientMock{},
+			expectedErr:       EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newYoutubeAdapter(&tt.youtubeClientMock)
+			result, err := a.FetchArtist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestYoutubeAdapter_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name              string
+		artistName        string
+		youtubeClientMock youtubeClientMock
+		expectedArtist    *Entity
+		expectedErr       error
+	}{
+		{
+			name:       "found query",
+			artistName: "sample artist",
+			youtubeClientMock: youtubeClientMock{
+				searchChannel: map[string]*youtube.SearchResponse{
+					"sample artist": {
+						Items: []youtube.SearchItem{
+							{ID: youtube.SearchID{ChannelID: "sampleID"}},
+						},
+					},
+				},
+				getChannel: map[string]*youtube.Channel{
+					"sampleID": {ID: "sampleID", Title: "sample artist - Topic"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "sampleID",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://www.youtube.com/channel/sampleID",
+				Provider: Youtube,
+				Type:     Artist,
+			},
+		},
+		{
+			name:       "topic channel preferred",
+			artistName: "sample artist",
+			youtubeClientMock: youtubeClientMock{
+				searchChannel: map[string]*youtube.SearchResponse{
+					"sample artist - Topic": {
+						Items: []youtube.SearchItem{{ID: youtube.SearchID{ChannelID: "UCtopic"}}},
+					},
+					"sample artist": {
+						Items: []youtube.SearchItem{{ID: youtube.SearchID{ChannelID: "UCfan"}}},
+					},
+				},
+				getChannel: map[string]*youtube.Channel{
+					"UCtopic": {ID: "UCtopic", Title: "sample artist - Topic"},
+					"UCfan":   {ID: "UCfan", Title: "sample artist fans"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "UCtopic",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://www.youtube.com/channel/UCtopic",
+				Provider: Youtube,
+				Type:     Artist,
+			},
+		},
+		{
+			name:              "not found query",
+			artistName:        "not found artist",
+			youtubeClientMock: youtubeClientMock{},
+			expectedErr:       EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newYoutubeAdapter(&tt.youtubeClientMock)
+			result, err := a.SearchArtist(ctx, tt.artistName)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestYoutubeAdapter_FetchPlaylist(t *testing.T) {
+	tests := []struct {
+		name              string
+		id                string
+		youtubeClientMock youtubeClientMock
+		expectedPlaylist  *Entity
+		expectedErr       error
+	}{
+		{
+			name: "found ID",
+			id:   "samplePlaylistID",
+			youtubeClientMock: youtubeClientMock{
+				getPlaylist: map[string]*youtube.Playlist{
+					"samplePlaylistID": {
+						ID:           "samplePlaylistID",
+						Title:        "sample playlist",
+						ChannelTitle: "sample channel",
+					},
+				},
+				getPlaylistItems: map[string][]youtube.Video{
+					"samplePlaylistID": {
+						{
+							ID:           "sampleVideoID1",
+							Title:        "sample name",
+							ChannelTitle: "sample artist - Topic",
+							Description:  "Auto-generated by YouTube.",
+						},
+						{
+							ID:           "sampleVideoID2",
+							Title:        "another artist - another name (Official Video)",
+							ChannelTitle: "another artist",
+						},
+					},
+				},
+			},
+			expectedPlaylist: &Entity{
+				ID:       "samplePlaylistID",
+				Title:    "sample playlist",
+				Artist:   "sample channel",
+				URL:      "https://www.youtube.com/playlist?list=samplePlaylistID",
+				Provider: Youtube,
+				Type:     Playlist,
+				Tracks: []*Entity{
+					{
+						ID:       "sampleVideoID1",
+						Title:    "sample name",
+						Artist:   "sample artist",
+						URL:      "https://www.youtube.com/watch?v=sampleVideoID1",
+						Provider: Youtube,
+						Type:     Track,
+						Artists:  []string{"sample artist"},
+					},
+					{
+						ID:       "sampleVideoID2",
+						Title:    "another name",
+						Artist:   "another artist",
+						URL:      "https://www.youtube.com/watch?v=sampleVideoID2",
+						Provider: Youtube,
+						Type:     Track,
+						Artists:  []string{"another artist"},
+					},
+				},
+			},
+		},
+		{
+			name:              "not found ID",
+			id:                "notFoundID",
+			youtubeClientMock: youtubeClientMock{},
+			expectedErr:       EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newYoutubeAdapter(&tt.youtubeClientMock)
+			result, err := a.FetchPlaylist(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPlaylist, result)
+			}
+		})
+	}
+}
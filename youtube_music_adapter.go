@@ -0,0 +1,215 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/youtube"
+)
+
+const youtubeMusicSearchLimit = 5
+
+// YoutubeMusicAdapter shares the Youtube Data API with YoutubeAdapter, but links to
+// music.youtube.com and prefers auto-generated "Topic" content in search results.
+type YoutubeMusicAdapter struct {
+	client  youtube.Client
+	youtube *YoutubeAdapter
+}
+
+func newYoutubeMusicAdapter(client youtube.Client) *YoutubeMusicAdapter {
+	return &YoutubeMusicAdapter{
+		client:  client,
+		youtube: newYoutubeAdapter(client),
+	}
+}
+
+func (a *YoutubeMusicAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	video, err := a.client.GetVideo(ctx, id)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get video from youtube music: %w", err)
+	}
+	return a.adaptTrack(video), nil
+}
+
+func (a *YoutubeMusicAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	candidates, err := a.SearchTrackCandidates(ctx, artistName, trackName, youtubeMusicSearchLimit)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+func (a *YoutubeMusicAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	query := entityFullTitle(artistName, trackName)
+	search, err := a.client.SearchVideos(ctx, query, limit)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search videos on youtube music: %w", err)
+	}
+
+	ids := make([]string, 0, len(search.Items))
+	for _, item := range search.Items {
+		ids = append(ids, item.ID.VideoID)
+	}
+	videos, err := a.client.GetVideos(ctx, ids)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get videos from youtube music: %w", err)
+	}
+
+	sort.SliceStable(videos, func(i, j int) bool {
+		return videos[i].IsAutogenerated() && !videos[j].IsAutogenerated()
+	})
+	candidates := make([]*Entity, 0, len(videos))
+	for i := range videos {
+		candidates = append(candidates, a.adaptTrack(&videos[i]))
+	}
+	return candidates, nil
+}
+
+func (a *YoutubeMusicAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	if youtube.IsAlbumBrowseID(id) {
+		playlistID, err := a.client.GetAlbumPlaylistID(ctx, id)
+		if err != nil {
+			if errors.Is(err, youtube.NotFoundError) {
+				return nil, EntityNotFoundError
+			}
+			return nil, fmt.Errorf("failed to resolve album browse id on youtube music: %w", err)
+		}
+		id = playlistID
+	}
+
+	album, err := a.client.GetPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from youtube music: %w", err)
+	}
+	return a.adaptAlbum(ctx, album)
+}
+
+func (a *YoutubeMusicAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	candidates, err := a.SearchAlbumCandidates(ctx, artistName, albumName, youtubeMusicSearchLimit)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+func (a *YoutubeMusicAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	query := entityFullTitle(artistName, albumName)
+	search, err := a.client.SearchPlaylists(ctx, query, limit)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search playlists on youtube music: %w", err)
+	}
+
+	ids := make([]string, 0, len(search.Items))
+	for _, item := range search.Items {
+		ids = append(ids, item.ID.PlaylistID)
+	}
+	playlists, err := a.client.GetPlaylists(ctx, ids)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlists from youtube music: %w", err)
+	}
+
+	sort.SliceStable(playlists, func(i, j int) bool {
+		return playlists[i].IsAutogenerated() && !playlists[j].IsAutogenerated()
+	})
+	candidates := make([]*Entity, 0, len(playlists))
+	for i := range playlists {
+		album, err := a.adaptAlbum(ctx, &playlists[i])
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, album)
+	}
+	return candidates, nil
+}
+
+func (a *YoutubeMusicAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	channel, err := a.client.GetChannel(ctx, id)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get channel from youtube music: %w", err)
+	}
+	return a.adaptArtist(channel), nil
+}
+
+// SearchArtist looks for the auto-generated "<artist> - Topic" channel first and falls back
+// to the most relevant channel when the artist has none.
+func (a *YoutubeMusicAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	channel, err := a.youtube.findArtistChannel(ctx, artistName)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to search channel on youtube music: %w", err)
+	}
+	return a.adaptArtist(channel), nil
+}
+
+func (a *YoutubeMusicAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.GetPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, youtube.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from youtube music: %w", err)
+	}
+
+	videos, err := a.client.GetPlaylistItems(ctx, playlist.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get playlist items from youtube music: %w", err)
+	}
+
+	entity := a.youtube.adaptPlaylist(playlist, videos)
+	entity.Provider = YoutubeMusic
+	entity.URL = playlist.MusicURL()
+	for i, track := range entity.Tracks {
+		track.Provider = YoutubeMusic
+		track.URL = videos[i].MusicURL()
+	}
+	return entity, nil
+}
+
+func (a *YoutubeMusicAdapter) adaptTrack(video *youtube.Video) *Entity {
+	entity := a.youtube.adaptTrack(video)
+	entity.Provider = YoutubeMusic
+	entity.URL = video.MusicURL()
+	return entity
+}
+
+func (a *YoutubeMusicAdapter) adaptAlbum(ctx context.Context, playlist *youtube.Playlist) (*Entity, error) {
+	entity, err := a.youtube.adaptAlbum(ctx, playlist)
+	if err != nil {
+		return nil, err
+	}
+	entity.Provider = YoutubeMusic
+	entity.URL = playlist.MusicURL()
+	return entity, nil
+}
+
+func (a *YoutubeMusicAdapter) adaptArtist(channel *youtube.Channel) *Entity {
+	entity := a.youtube.adaptArtist(channel)
+	entity.Provider = YoutubeMusic
+	entity.URL = channel.MusicURL()
+	return entity
+}
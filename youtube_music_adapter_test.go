@@ -0,0 +1,376 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/youtube"
+
+	"github.com/stretchr/testify/require"
+)
+
+const autogeneratedDescription = "Provided to YouTube by Label. Auto-generated by YouTube."
+
+func TestYoutubeMusicAdapter_FetchTrack(t *testing.T) {
+	client := youtubeClientMock{
+		getVideo: map[string]*youtube.Video{
+			"sampleID": {
+				ID:           "sampleID",
+				Title:        "sample track",
+				Description:  autogeneratedDescription,
+				ChannelTitle: "sample artist - Topic",
+			},
+		},
+	}
+	a := newYoutubeMusicAdapter(&client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	track, err := a.FetchTrack(ctx, "sampleID")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "sampleID",
+		Title:    "sample track",
+		Artist:   "sample artist",
+		URL:      "https://music.youtube.com/watch?v=sampleID",
+		Provider: YoutubeMusic,
+		Type:     Track,
+		Artists:  []string{"sample artist"},
+	}, track)
+
+	_, err = a.FetchTrack(ctx, "notFoundID")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestYoutubeMusicAdapter_SearchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		client        youtubeClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "prefers auto-generated video",
+			client: youtubeClientMock{
+				searchVideos: map[string]*youtube.SearchResponse{
+					"sample artist – sample track": {
+						Items: []youtube.SearchItem{
+							{ID: youtube.SearchID{VideoID: "clipID"}},
+							{ID: youtube.SearchID{VideoID: "topicID"}},
+						},
+					},
+				},
+				getVideo: map[string]*youtube.Video{
+					"clipID": {
+						ID:    "clipID",
+						Title: "sample artist - sample track (Official Video)",
+					},
+					"topicID": {
+						ID:           "topicID",
+						Title:        "sample track",
+						Description:  autogeneratedDescription,
+						ChannelTitle: "sample artist - Topic",
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:       "topicID",
+				Title:    "sample track",
+				Artist:   "sample artist",
+				URL:      "https://music.youtube.com/watch?v=topicID",
+				Provider: YoutubeMusic,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+		{
+			name: "falls back to the most relevant video",
+			client: youtubeClientMock{
+				searchVideos: map[string]*youtube.SearchResponse{
+					"sample artist – sample track": {
+						Items: []youtube.SearchItem{{ID: youtube.SearchID{VideoID: "clipID"}}},
+					},
+				},
+				getVideo: map[string]*youtube.Video{
+					"clipID": {
+						ID:    "clipID",
+						Title: "sample artist - sample track (Official Video)",
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:       "clipID",
+				Title:    "sample track",
+				Artist:   "sample artist",
+				URL:      "https://music.youtube.com/watch?v=clipID",
+				Provider: YoutubeMusic,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+		{
+			name:        "not found",
+			client:      youtubeClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newYoutubeMusicAdapter(&tt.client)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := a.SearchTrack(ctx, "sample artist", "sample track")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestYoutubeMusicAdapter_FetchAlbum(t *testing.T) {
+	client := youtubeClientMock{
+		getAlbumPlaylist: map[string]string{
+			"MPREb_sampleID": "OLAK5uy_sampleID",
+		},
+		getPlaylist: map[string]*youtube.Playlist{
+			"OLAK5uy_sampleID": {
+				ID:    "OLAK5uy_sampleID",
+				Title: "Album - sample album",
+			},
+		},
+		getPlaylistItems: map[string][]youtube.Video{
+			"OLAK5uy_sampleID": {
+				{
+					ID:           "sampleVideoID",
+					Title:        "sample track",
+					Description:  autogeneratedDescription,
+					ChannelTitle: "sample artist - Topic",
+				},
+			},
+		},
+	}
+	expected := &Entity{
+		ID:       "OLAK5uy_sampleID",
+		Title:    "sample album",
+		Artist:   "sample artist",
+		URL:      "https://music.youtube.com/playlist?list=OLAK5uy_sampleID",
+		Provider: YoutubeMusic,
+		Type:     Album,
+		Artists:  []string{"sample artist"},
+	}
+
+	tests := []struct {
+		name          string
+		id            string
+		expectedAlbum *Entity
+		expectedErr   error
+	}{
+		{
+			name:          "browse ID",
+			id:            "MPREb_sampleID",
+			expectedAlbum: expected,
+		},
+		{
+			name:          "album playlist ID",
+			id:            "OLAK5uy_sampleID",
+			expectedAlbum: expected,
+		},
+		{
+			name:        "unknown browse ID",
+			id:          "MPREb_notFoundID",
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newYoutubeMusicAdapter(&client)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := a.FetchAlbum(ctx, tt.id)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAlbum, result)
+			}
+		})
+	}
+}
+
+func TestYoutubeMusicAdapter_SearchAlbum(t *testing.T) {
+	client := youtubeClientMock{
+		searchPlaylists: map[string]*youtube.SearchResponse{
+			"sample artist – sample album": {
+				Items: []youtube.SearchItem{
+					{ID: youtube.SearchID{PlaylistID: "PLsampleID"}},
+					{ID: youtube.SearchID{PlaylistID: "OLAK5uy_sampleID"}},
+				},
+			},
+		},
+		getPlaylist: map[string]*youtube.Playlist{
+			"PLsampleID": {
+				ID:    "PLsampleID",
+				Title: "sample artist - sample album (full album)",
+			},
+			"OLAK5uy_sampleID": {
+				ID:    "OLAK5uy_sampleID",
+				Title: "Album - sample album",
+			},
+		},
+		getPlaylistItems: map[string][]youtube.Video{
+			"OLAK5uy_sampleID": {
+				{
+					ID:           "sampleVideoID",
+					Title:        "sample track",
+					Description:  autogeneratedDescription,
+					ChannelTitle: "sample artist - Topic",
+				},
+			},
+		},
+	}
+	a := newYoutubeMusicAdapter(&client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := a.SearchAlbum(ctx, "sample artist", "sample album")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "OLAK5uy_sampleID",
+		Title:    "sample album",
+		Artist:   "sample artist",
+		URL:      "https://music.youtube.com/playlist?list=OLAK5uy_sampleID",
+		Provider: YoutubeMusic,
+		Type:     Album,
+		Artists:  []string{"sample artist"},
+	}, result)
+}
+
+func TestYoutubeMusicAdapter_SearchArtist(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         youtubeClientMock
+		expectedArtist *Entity
+		expectedErr    error
+	}{
+		{
+			name: "topic channel",
+			client: youtubeClientMock{
+				searchChannel: map[string]*youtube.SearchResponse{
+					"sample artist - Topic": {
+						Items: []youtube.SearchItem{{ID: youtube.SearchID{ChannelID: "UCtopic"}}},
+					},
+				},
+				getChannel: map[string]*youtube.Channel{
+					"UCtopic": {ID: "UCtopic", Title: "sample artist - Topic"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "UCtopic",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://music.youtube.com/channel/UCtopic",
+				Provider: YoutubeMusic,
+				Type:     Artist,
+			},
+		},
+		{
+			name: "no topic channel",
+			client: youtubeClientMock{
+				searchChannel: map[string]*youtube.SearchResponse{
+					"sample artist - Topic": {
+						Items: []youtube.SearchItem{{ID: youtube.SearchID{ChannelID: "UCfan"}}},
+					},
+					"sample artist": {
+						Items: []youtube.SearchItem{{ID: youtube.SearchID{ChannelID: "UCofficial"}}},
+					},
+				},
+				getChannel: map[string]*youtube.Channel{
+					"UCfan":      {ID: "UCfan", Title: "sample artist fans"},
+					"UCofficial": {ID: "UCofficial", Title: "sample artist"},
+				},
+			},
+			expectedArtist: &Entity{
+				ID:       "UCofficial",
+				Title:    "sample artist",
+				Artist:   "sample artist",
+				URL:      "https://music.youtube.com/channel/UCofficial",
+				Provider: YoutubeMusic,
+				Type:     Artist,
+			},
+		},
+		{
+			name:        "not found",
+			client:      youtubeClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newYoutubeMusicAdapter(&tt.client)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			result, err := a.SearchArtist(ctx, "sample artist")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedArtist, result)
+			}
+		})
+	}
+}
+
+func TestYoutubeMusicAdapter_FetchPlaylist(t *testing.T) {
+	client := youtubeClientMock{
+		getPlaylist: map[string]*youtube.Playlist{
+			"PLsampleID": {
+				ID:           "PLsampleID",
+				Title:        "sample playlist",
+				ChannelTitle: "sample curator",
+			},
+		},
+		getPlaylistItems: map[string][]youtube.Video{
+			"PLsampleID": {
+				{ID: "sampleVideoID", Title: "sample artist - sample track"},
+			},
+		},
+	}
+	a := newYoutubeMusicAdapter(&client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := a.FetchPlaylist(ctx, "PLsampleID")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "PLsampleID",
+		Title:    "sample playlist",
+		Artist:   "sample curator",
+		URL:      "https://music.youtube.com/playlist?list=PLsampleID",
+		Provider: YoutubeMusic,
+		Type:     Playlist,
+		Tracks: []*Entity{
+			{
+				ID:       "sampleVideoID",
+				Title:    "sample track",
+				Artist:   "sample artist",
+				URL:      "https://music.youtube.com/watch?v=sampleVideoID",
+				Provider: YoutubeMusic,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+	}, result)
+}
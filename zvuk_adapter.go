@@ -0,0 +1,222 @@
+package streamnx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/translator"
+	"github.com/GeorgeGorbanev/streamnx/internal/zvuk"
+)
+
+type ZvukAdapter struct {
+	client  zvuk.Client
+	matcher *artistMatcher
+}
+
+func newZvukAdapter(client zvuk.Client, t translator.Translator) *ZvukAdapter {
+	return &ZvukAdapter{
+		client:  client,
+		matcher: newArtistMatcher(t),
+	}
+}
+
+func (a *ZvukAdapter) FetchTrack(ctx context.Context, id string) (*Entity, error) {
+	track, err := a.client.FetchTrack(ctx, id)
+	if err != nil {
+		if errors.Is(err, zvuk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get track from zvuk: %w", err)
+	}
+
+	return a.adaptTrack(track), nil
+}
+
+func (a *ZvukAdapter) SearchTrack(ctx context.Context, artistName, trackName string) (*Entity, error) {
+	candidates, err := a.SearchTrackCandidates(ctx, artistName, trackName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+func (a *ZvukAdapter) SearchTrackCandidates(ctx context.Context, artistName, trackName string, limit int) ([]*Entity, error) {
+	tracks, err := searchMatching(ctx, a.matcher, artistName, trackName, func(query string) ([]*zvuk.Track, error) {
+		tracks, err := a.client.SearchTracks(ctx, query, limit)
+		if errors.Is(err, zvuk.NotFoundError) {
+			return nil, nil
+		}
+		return tracks, err
+	}, func(track *zvuk.Track) string {
+		return firstName(track.ArtistNames)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tracks on zvuk: %w", err)
+	}
+	if len(tracks) == 0 {
+		return nil, EntityNotFoundError
+	}
+
+	candidates := make([]*Entity, 0, len(tracks))
+	for _, track := range tracks {
+		candidates = append(candidates, a.adaptTrack(track))
+	}
+	return candidates, nil
+}
+
+func (a *ZvukAdapter) FetchAlbum(ctx context.Context, id string) (*Entity, error) {
+	release, err := a.client.FetchRelease(ctx, id)
+	if err != nil {
+		if errors.Is(err, zvuk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get release from zvuk: %w", err)
+	}
+
+	return a.adaptRelease(release), nil
+}
+
+func (a *ZvukAdapter) SearchAlbum(ctx context.Context, artistName, albumName string) (*Entity, error) {
+	candidates, err := a.SearchAlbumCandidates(ctx, artistName, albumName, 1)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+func (a *ZvukAdapter) SearchAlbumCandidates(ctx context.Context, artistName, albumName string, limit int) ([]*Entity, error) {
+	releases, err := searchMatching(ctx, a.matcher, artistName, albumName, func(query string) ([]*zvuk.Release, error) {
+		releases, err := a.client.SearchReleases(ctx, query, limit)
+		if errors.Is(err, zvuk.NotFoundError) {
+			return nil, nil
+		}
+		return releases, err
+	}, func(release *zvuk.Release) string {
+		return firstName(release.ArtistNames)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search releases on zvuk: %w", err)
+	}
+	if len(releases) == 0 {
+		return nil, EntityNotFoundError
+	}
+
+	candidates := make([]*Entity, 0, len(releases))
+	for _, release := range releases {
+		candidates = append(candidates, a.adaptRelease(release))
+	}
+	return candidates, nil
+}
+
+func (a *ZvukAdapter) FetchArtist(ctx context.Context, id string) (*Entity, error) {
+	artist, err := a.client.FetchArtist(ctx, id)
+	if err != nil {
+		if errors.Is(err, zvuk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get artist from zvuk: %w", err)
+	}
+
+	return a.adaptArtist(artist), nil
+}
+
+func (a *ZvukAdapter) SearchArtist(ctx context.Context, artistName string) (*Entity, error) {
+	queries := []string{artistName}
+	if !translator.HasCyrillic(artistName) {
+		queries = append(queries, translator.TranslitLatToCyr(artistName))
+	}
+
+	for _, query := range queries {
+		artist, err := a.client.SearchArtist(ctx, query)
+		if err != nil {
+			if errors.Is(err, zvuk.NotFoundError) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to search artist on zvuk: %w", err)
+		}
+
+		artistMatch, err := a.matcher.match(ctx, artist.Title, artistName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check artist match: %w", err)
+		}
+		if artistMatch {
+			return a.adaptArtist(artist), nil
+		}
+	}
+
+	return nil, EntityNotFoundError
+}
+
+func (a *ZvukAdapter) FetchPlaylist(ctx context.Context, id string) (*Entity, error) {
+	playlist, err := a.client.FetchPlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, zvuk.NotFoundError) {
+			return nil, EntityNotFoundError
+		}
+		return nil, fmt.Errorf("failed to get playlist from zvuk: %w", err)
+	}
+
+	return a.adaptPlaylist(playlist), nil
+}
+
+func (a *ZvukAdapter) adaptTrack(track *zvuk.Track) *Entity {
+	return &Entity{
+		ID:          strconv.Itoa(track.ID),
+		Title:       track.Title,
+		Artist:      firstName(track.ArtistNames),
+		URL:         track.URL(),
+		Provider:    Zvuk,
+		Type:        Track,
+		Artists:     track.ArtistNames,
+		Album:       track.ReleaseTitle,
+		Duration:    time.Duration(track.Duration) * time.Second,
+		Artwork:     track.Image.URL(),
+		Explicit:    track.Explicit,
+		TrackNumber: track.Position,
+	}
+}
+
+func (a *ZvukAdapter) adaptRelease(release *zvuk.Release) *Entity {
+	return &Entity{
+		ID:          strconv.Itoa(release.ID),
+		Title:       release.Title,
+		Artist:      firstName(release.ArtistNames),
+		URL:         release.URL(),
+		Provider:    Zvuk,
+		Type:        Album,
+		Artists:     release.ArtistNames,
+		ReleaseDate: release.ReleaseDate(),
+		Artwork:     release.Image.URL(),
+		Explicit:    release.Explicit,
+	}
+}
+
+func (a *ZvukAdapter) adaptArtist(artist *zvuk.Artist) *Entity {
+	return &Entity{
+		ID:       strconv.Itoa(artist.ID),
+		Title:    artist.Title,
+		Artist:   artist.Title,
+		URL:      artist.URL(),
+		Provider: Zvuk,
+		Type:     Artist,
+	}
+}
+
+func (a *ZvukAdapter) adaptPlaylist(playlist *zvuk.Playlist) *Entity {
+	tracks := make([]*Entity, 0, len(playlist.Tracks))
+	for _, track := range playlist.Tracks {
+		tracks = append(tracks, a.adaptTrack(track))
+	}
+
+	return &Entity{
+		ID:       strconv.Itoa(playlist.ID),
+		Title:    playlist.Title,
+		URL:      playlist.URL(),
+		Provider: Zvuk,
+		Type:     Playlist,
+		Tracks:   tracks,
+	}
+}
@@ -0,0 +1,259 @@
+package streamnx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GeorgeGorbanev/streamnx/internal/zvuk"
+
+	"github.com/stretchr/testify/require"
+)
+
+type zvukClientMock struct {
+	fetchTrack     map[string]*zvuk.Track
+	fetchRelease   map[string]*zvuk.Release
+	searchTracks   map[string][]*zvuk.Track
+	searchReleases map[string][]*zvuk.Release
+	fetchArtist    map[string]*zvuk.Artist
+	searchArtist   map[string]*zvuk.Artist
+	fetchPlaylist  map[string]*zvuk.Playlist
+}
+
+func (c *zvukClientMock) FetchTrack(_ context.Context, id string) (*zvuk.Track, error) {
+	track, ok := c.fetchTrack[id]
+	if !ok {
+		return nil, zvuk.NotFoundError
+	}
+	return track, nil
+}
+
+func (c *zvukClientMock) SearchTrack(ctx context.Context, query string) (*zvuk.Track, error) {
+	tracks, err := c.SearchTracks(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tracks[0], nil
+}
+
+func (c *zvukClientMock) SearchTracks(_ context.Context, query string, limit int) ([]*zvuk.Track, error) {
+	tracks, ok := c.searchTracks[query]
+	if !ok {
+		return nil, zvuk.NotFoundError
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (c *zvukClientMock) FetchRelease(_ context.Context, id string) (*zvuk.Release, error) {
+	release, ok := c.fetchRelease[id]
+	if !ok {
+		return nil, zvuk.NotFoundError
+	}
+	return release, nil
+}
+
+func (c *zvukClientMock) SearchRelease(ctx context.Context, query string) (*zvuk.Release, error) {
+	releases, err := c.SearchReleases(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return releases[0], nil
+}
+
+func (c *zvukClientMock) SearchReleases(_ context.Context, query string, limit int) ([]*zvuk.Release, error) {
+	releases, ok := c.searchReleases[query]
+	if !ok {
+		return nil, zvuk.NotFoundError
+	}
+	return releases[:min(limit, len(releases))], nil
+}
+
+func (c *zvukClientMock) FetchArtist(_ context.Context, id string) (*zvuk.Artist, error) {
+	artist, ok := c.fetchArtist[id]
+	if !ok {
+		return nil, zvuk.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *zvukClientMock) SearchArtist(_ context.Context, query string) (*zvuk.Artist, error) {
+	artist, ok := c.searchArtist[query]
+	if !ok {
+		return nil, zvuk.NotFoundError
+	}
+	return artist, nil
+}
+
+func (c *zvukClientMock) FetchPlaylist(_ context.Context, id string) (*zvuk.Playlist, error) {
+	playlist, ok := c.fetchPlaylist[id]
+	if !ok {
+		return nil, zvuk.NotFoundError
+	}
+	return playlist, nil
+}
+
+func TestZvukAdapter_FetchTrack(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		clientMock    *zvukClientMock
+		expectedTrack *Entity
+		expectedErr   error
+	}{
+		{
+			name: "found ID",
+			id:   "125683251",
+			clientMock: &zvukClientMock{
+				fetchTrack: map[string]*zvuk.Track{
+					"125683251": {
+						ID:           125683251,
+						Title:        "Хочешь?",
+						ArtistNames:  []string{"Земфира"},
+						ReleaseID:    27464102,
+						ReleaseTitle: "Спасибо",
+						Duration:     213,
+						Position:     3,
+						Image:        &zvuk.Image{Src: "https://cdn-image.zvuk.com/pic?id=27464102&size={size}"},
+					},
+				},
+			},
+			expectedTrack: &Entity{
+				ID:          "125683251",
+				Title:       "Хочешь?",
+				Artist:      "Земфира",
+				URL:         "https://zvuk.com/track/125683251",
+				Provider:    Zvuk,
+				Type:        Track,
+				Artists:     []string{"Земфира"},
+				Album:       "Спасибо",
+				Duration:    213 * time.Second,
+				Artwork:     "https://cdn-image.zvuk.com/pic?id=27464102&size={w}x{h}",
+				TrackNumber: 3,
+			},
+		},
+		{
+			name:        "not found ID",
+			id:          "0",
+			clientMock:  &zvukClientMock{},
+			expectedErr: EntityNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			a := newZvukAdapter(tt.clientMock, &translatorMock{})
+			result, err := a.FetchTrack(ctx, tt.id)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTrack, result)
+			}
+		})
+	}
+}
+
+func TestZvukAdapter_SearchAlbumCandidates(t *testing.T) {
+	clientMock := &zvukClientMock{
+		searchReleases: map[string][]*zvuk.Release{
+			"Leningrad – Рыба моей мечты": {
+				{ID: 1, Title: "Рыба моей мечты", ArtistNames: []string{"Кавер-группа"}},
+			},
+			"Ленинград – Рыба моей мечты": {
+				{ID: 2, Title: "Рыба моей мечты", ArtistNames: []string{"Ленинград"}, Date: 20160101},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newZvukAdapter(clientMock, &translatorMock{})
+	result, err := a.SearchAlbumCandidates(ctx, "Leningrad", "Рыба моей мечты", 5)
+	require.NoError(t, err)
+	require.Equal(t, []*Entity{
+		{
+			ID:          "2",
+			Title:       "Рыба моей мечты",
+			Artist:      "Ленинград",
+			URL:         "https://zvuk.com/release/2",
+			Provider:    Zvuk,
+			Type:        Album,
+			Artists:     []string{"Ленинград"},
+			ReleaseDate: "2016-01-01",
+		},
+	}, result)
+
+	_, err = a.SearchAlbumCandidates(ctx, "not found artist", "not found name", 5)
+	require.ErrorIs(t, err, EntityNotFoundError)
+}
+
+func TestZvukAdapter_SearchArtist(t *testing.T) {
+	clientMock := &zvukClientMock{
+		searchArtist: map[string]*zvuk.Artist{
+			"Leningrad Cord": {ID: 1, Title: "Ленинград"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newZvukAdapter(clientMock, &translatorMock{
+		enToRu: map[string]string{"leningrad cord": "ленинград"},
+	})
+	result, err := a.SearchArtist(ctx, "Leningrad Cord")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "1",
+		Title:    "Ленинград",
+		Artist:   "Ленинград",
+		URL:      "https://zvuk.com/artist/1",
+		Provider: Zvuk,
+		Type:     Artist,
+	}, result)
+}
+
+func TestZvukAdapter_FetchPlaylist(t *testing.T) {
+	clientMock := &zvukClientMock{
+		fetchPlaylist: map[string]*zvuk.Playlist{
+			"7429361": {
+				ID:    7429361,
+				Title: "sample playlist",
+				Tracks: []*zvuk.Track{
+					{ID: 1, Title: "first", ArtistNames: []string{"sample artist"}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	a := newZvukAdapter(clientMock, &translatorMock{})
+	result, err := a.FetchPlaylist(ctx, "7429361")
+	require.NoError(t, err)
+	require.Equal(t, &Entity{
+		ID:       "7429361",
+		Title:    "sample playlist",
+		URL:      "https://zvuk.com/playlist/7429361",
+		Provider: Zvuk,
+		Type:     Playlist,
+		Tracks: []*Entity{
+			{
+				ID:       "1",
+				Title:    "first",
+				Artist:   "sample artist",
+				URL:      "https://zvuk.com/track/1",
+				Provider: Zvuk,
+				Type:     Track,
+				Artists:  []string{"sample artist"},
+			},
+		},
+	}, result)
+
+	_, err = a.FetchPlaylist(ctx, "0")
+	require.ErrorIs(t, err, EntityNotFoundError)
+}